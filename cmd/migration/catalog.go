@@ -0,0 +1,81 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/golang-migrate/migrate/v4/source"
+)
+
+// migrationFile is one version in the migrations directory with its up and
+// down files.
+type migrationFile struct {
+	Version  uint
+	Name     string
+	UpPath   string
+	DownPath string
+}
+
+func (f migrationFile) upFileName() string {
+	return filepath.Base(f.UpPath)
+}
+
+// checksum hashes the up file, the part that has already run on a database
+// at or above this version.
+func (f migrationFile) checksum() (string, error) {
+	raw, err := os.ReadFile(f.UpPath)
+	if err != nil {
+		return "", fmt.Errorf("read %s: %w", f.upFileName(), err)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// loadMigrationFiles lists migrations sorted by version using the file name
+// scheme golang-migrate expects: <version>_<name>.<up|down>.sql.
+func loadMigrationFiles(dir string) ([]migrationFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations dir: %w", err)
+	}
+
+	byVersion := make(map[uint]*migrationFile)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		parsed, err := source.Parse(entry.Name())
+		if err != nil {
+			continue
+		}
+		item, ok := byVersion[parsed.Version]
+		if !ok {
+			item = &migrationFile{Version: parsed.Version, Name: parsed.Identifier}
+			byVersion[parsed.Version] = item
+		}
+		if item.Name != parsed.Identifier {
+			return nil, fmt.Errorf("version %d has files with different names: %s and %s", parsed.Version, item.Name, parsed.Identifier)
+		}
+		path := filepath.Join(dir, entry.Name())
+		switch parsed.Direction {
+		case source.Up:
+			item.UpPath = path
+		case source.Down:
+			item.DownPath = path
+		}
+	}
+
+	out := make([]migrationFile, 0, len(byVersion))
+	for _, item := range byVersion {
+		if item.UpPath == "" {
+			return nil, fmt.Errorf("version %d (%s) has no up file", item.Version, item.Name)
+		}
+		out = append(out, *item)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// checksumTable sits next to golang-migrate's schema_migrations and records
+// the up file checksum of every applied version.
+const checksumTable = "schema_migration_checksums"
+
+type appliedChecksum struct {
+	Version   uint
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+func ensureChecksumTable(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+checksumTable+` (
+    version BIGINT PRIMARY KEY,
+    name TEXT NOT NULL,
+    checksum TEXT NOT NULL,
+    applied_at timestamptz NOT NULL DEFAULT NOW()
+)`)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", checksumTable, err)
+	}
+	return nil
+}
+
+// loadChecksums returns recorded checksums by version. It does not create
+// the table, so read-only commands leave the database untouched.
+func loadChecksums(ctx context.Context, db *sql.DB) (map[uint]appliedChecksum, error) {
+	var exists bool
+	if err := db.QueryRowContext(ctx, `SELECT to_regclass($1) IS NOT NULL`, checksumTable).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("check %s: %w", checksumTable, err)
+	}
+	out := make(map[uint]appliedChecksum)
+	if !exists {
+		return out, nil
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT version, name, checksum, applied_at FROM `+checksumTable+` ORDER BY version`)
+	if err != nil {
+		return nil, fmt.Errorf("query %s: %w", checksumTable, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			item    appliedChecksum
+			version int64
+		)
+		if err := rows.Scan(&version, &item.Name, &item.Checksum, &item.AppliedAt); err != nil {
+			return nil, fmt.Errorf("scan %s: %w", checksumTable, err)
+		}
+		item.Version = uint(version)
+		out[item.Version] = item
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate %s: %w", checksumTable, err)
+	}
+	return out, nil
+}
+
+// syncChecksums records a checksum for every applied version that has none
+// and drops the rows of versions above current after a rollback. Existing
+// rows are never rewritten; verify compares against them.
+func syncChecksums(ctx context.Context, db *sql.DB, files []migrationFile, current uint) (int, error) {
+	if err := ensureChecksumTable(ctx, db); err != nil {
+		return 0, err
+	}
+	if _, err := db.ExecContext(ctx, `DELETE FROM `+checksumTable+` WHERE version > $1`, int64(current)); err != nil {
+		return 0, fmt.Errorf("prune %s: %w", checksumTable, err)
+	}
+
+	recorded := 0
+	for _, file := range files {
+		if file.Version > current {
+			break
+		}
+		sum, err := file.checksum()
+		if err != nil {
+			return recorded, err
+		}
+		result, err := db.ExecContext(ctx, `INSERT INTO `+checksumTable+` (version, name, checksum)
+VALUES ($1, $2, $3)
+ON CONFLICT (version) DO NOTHING`, int64(file.Version), file.Name, sum)
+		if err != nil {
+			return recorded, fmt.Errorf("record checksum for version %d: %w", file.Version, err)
+		}
+		if affected, err := result.RowsAffected(); err == nil && affected > 0 {
+			recorded++
+		}
+	}
+	return recorded, nil
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+)
+
+// schemaState is the version golang-migrate recorded. Version is zero when
+// no migration has been applied.
+type schemaState struct {
+	Version uint
+	Dirty   bool
+}
+
+func readSchemaState(m *migrate.Migrate) (schemaState, error) {
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return schemaState{}, nil
+	}
+	if err != nil {
+		return schemaState{}, err
+	}
+	return schemaState{Version: version, Dirty: dirty}, nil
+}
+
+// runStatus prints every migration file as applied, dirty or pending. Applied
+// versions whose up file no longer matches the recorded checksum are shown as
+// modified.
+func runStatus(ctx context.Context, w io.Writer, db *sql.DB, files []migrationFile, state schemaState) error {
+	checksums, err := loadChecksums(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tSTATE\tCREATED_AT\tAPPLIED_AT\tNAME")
+	pending := 0
+	for _, file := range files {
+		migrationState := "pending"
+		appliedAt := "-"
+		switch {
+		case file.Version > state.Version:
+			pending++
+		case file.Version == state.Version && state.Dirty:
+			migrationState = "dirty"
+		default:
+			migrationState = "applied"
+		}
+		if recorded, ok := checksums[file.Version]; ok && file.Version <= state.Version {
+			appliedAt = recorded.AppliedAt.UTC().Format(time.RFC3339)
+			if sum, err := file.checksum(); err == nil && sum != recorded.Checksum {
+				migrationState = "modified"
+			}
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", file.Version, migrationState, versionTime(file.Version), appliedAt, file.Name)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nversion: %s, dirty: %t, pending: %d\n", formatVersion(state.Version), state.Dirty, pending)
+	return nil
+}
+
+// planMigrations returns the files `up`, `down <steps>` or `goto <version>`
+// would run, in execution order, and whether they run down.
+func planMigrations(files []migrationFile, state schemaState, args []string) ([]migrationFile, bool, error) {
+	action := "up"
+	if len(args) > 0 {
+		action = strings.ToLower(strings.TrimSpace(args[0]))
+	}
+
+	switch action {
+	case "up":
+		return filesBetween(files, state.Version, ^uint(0)), false, nil
+	case "down":
+		steps, err := parseSteps(args[1:])
+		if err != nil {
+			return nil, false, err
+		}
+		applied := filesBetween(files, 0, state.Version)
+		if steps > len(applied) {
+			steps = len(applied)
+		}
+		return reverseFiles(applied[len(applied)-steps:]), true, nil
+	case "goto", "migrate":
+		if len(args) < 2 {
+			return nil, false, fmt.Errorf("plan goto requires a target version argument")
+		}
+		target, err := parseTarget(args[1])
+		if err != nil {
+			return nil, false, err
+		}
+		if target >= state.Version {
+			return filesBetween(files, state.Version, target), false, nil
+		}
+		return reverseFiles(filesBetween(files, target, state.Version)), true, nil
+	default:
+		return nil, false, fmt.Errorf("unknown plan action %q (use up, down or goto)", action)
+	}
+}
+
+func runPlan(w io.Writer, files []migrationFile, state schemaState, args []string) error {
+	if state.Dirty {
+		return fmt.Errorf("database is dirty at version %d; fix it and run force first", state.Version)
+	}
+	planned, down, err := planMigrations(files, state, args)
+	if err != nil {
+		return err
+	}
+	if len(planned) == 0 {
+		fmt.Fprintf(w, "-- nothing to run at version %s\n", formatVersion(state.Version))
+		return nil
+	}
+
+	for _, file := range planned {
+		path := file.UpPath
+		if down {
+			path = file.DownPath
+		}
+		if path == "" {
+			return fmt.Errorf("version %d (%s) has no down file", file.Version, file.Name)
+		}
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", filepath.Base(path), err)
+		}
+		fmt.Fprintf(w, "-- %s\n%s\n", filepath.Base(path), strings.TrimRight(string(raw), "\n"))
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// runVerify compares applied up files with the recorded checksums. Applied
+// versions without a row are recorded as the baseline on first run.
+func runVerify(ctx context.Context, w io.Writer, db *sql.DB, files []migrationFile, state schemaState) error {
+	checksums, err := loadChecksums(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	byVersion := make(map[uint]migrationFile, len(files))
+	for _, file := range files {
+		byVersion[file.Version] = file
+	}
+
+	var problems []string
+	for _, file := range files {
+		if file.Version > state.Version {
+			break
+		}
+		recorded, ok := checksums[file.Version]
+		if !ok {
+			continue
+		}
+		sum, err := file.checksum()
+		if err != nil {
+			return err
+		}
+		if sum != recorded.Checksum {
+			problems = append(problems, fmt.Sprintf("%s changed after it was applied", file.upFileName()))
+		}
+	}
+	for version, recorded := range checksums {
+		if _, ok := byVersion[version]; !ok && version <= state.Version {
+			problems = append(problems, fmt.Sprintf("version %d (%s) was applied but its file is missing", version, recorded.Name))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		for _, problem := range problems {
+			fmt.Fprintln(w, "FAIL", problem)
+		}
+		return fmt.Errorf("%d applied migration(s) do not match their files", len(problems))
+	}
+
+	recorded, err := syncChecksums(ctx, db, files, state.Version)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "ok: applied migrations up to %s match their files (%d new checksum(s) recorded)\n", formatVersion(state.Version), recorded)
+	return nil
+}
+
+var migrationNamePattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// runNew creates an empty up/down pair named <unix-seconds>_<name>. The
+// version is bumped past the latest file so new migrations always sort last.
+func runNew(w io.Writer, dir string, files []migrationFile, rawName string, now time.Time) error {
+	name := strings.Trim(migrationNamePattern.ReplaceAllString(strings.ToLower(rawName), "_"), "_")
+	if name == "" {
+		return fmt.Errorf("new requires a migration name, for example: new add_player_injuries")
+	}
+
+	version := uint(now.Unix())
+	if len(files) > 0 && files[len(files)-1].Version >= version {
+		version = files[len(files)-1].Version + 1
+	}
+
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%d_%s.%s.sql", version, name, direction))
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("create %s: %w", filepath.Base(path), err)
+		}
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("close %s: %w", filepath.Base(path), err)
+		}
+		fmt.Fprintf(w, "created %s\n", path)
+	}
+	return nil
+}
+
+func filesBetween(files []migrationFile, after, upTo uint) []migrationFile {
+	out := make([]migrationFile, 0)
+	for _, file := range files {
+		if file.Version > after && file.Version <= upTo {
+			out = append(out, file)
+		}
+	}
+	return out
+}
+
+func reverseFiles(files []migrationFile) []migrationFile {
+	out := make([]migrationFile, 0, len(files))
+	for i := len(files) - 1; i >= 0; i-- {
+		out = append(out, files[i])
+	}
+	return out
+}
+
+func formatVersion(version uint) string {
+	if version == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d", version)
+}
+
+// versionTime reads a version as the unix timestamp it was created from.
+func versionTime(version uint) string {
+	if version < 1_000_000_000 {
+		return "-"
+	}
+	return time.Unix(int64(version), 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeMigrationFixture(t *testing.T, dir string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("-- "+name+"\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+}
+
+func TestPlanMigrations(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeMigrationFixture(t, dir,
+		"100_create_a.up.sql", "100_create_a.down.sql",
+		"200_create_b.up.sql", "200_create_b.down.sql",
+		"300_create_c.up.sql", "300_create_c.down.sql",
+		"README.md",
+	)
+	files, err := loadMigrationFiles(dir)
+	if err != nil {
+		t.Fatalf("load files: %v", err)
+	}
+	if len(files) != 3 || files[0].Name != "create_a" {
+		t.Fatalf("unexpected files: %+v", files)
+	}
+
+	state := schemaState{Version: 200}
+	cases := []struct {
+		args     []string
+		down     bool
+		versions []uint
+	}{
+		{args: nil, versions: []uint{300}},
+		{args: []string{"down", "2"}, down: true, versions: []uint{200, 100}},
+		{args: []string{"goto", "100"}, down: true, versions: []uint{200}},
+		{args: []string{"goto", "300"}, versions: []uint{300}},
+	}
+	for _, tc := range cases {
+		planned, down, err := planMigrations(files, state, tc.args)
+		if err != nil {
+			t.Fatalf("plan %v: %v", tc.args, err)
+		}
+		if down != tc.down || len(planned) != len(tc.versions) {
+			t.Fatalf("plan %v: unexpected result %+v down=%t", tc.args, planned, down)
+		}
+		for idx, version := range tc.versions {
+			if planned[idx].Version != version {
+				t.Fatalf("plan %v: expected version %d at %d, got %d", tc.args, version, idx, planned[idx].Version)
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	if err := runPlan(&out, files, state, nil); err != nil {
+		t.Fatalf("run plan: %v", err)
+	}
+	if !strings.Contains(out.String(), "-- 300_create_c.up.sql") {
+		t.Fatalf("expected plan to print the pending file, got %q", out.String())
+	}
+	if err := runPlan(&out, files, schemaState{Version: 200, Dirty: true}, nil); err == nil {
+		t.Fatalf("expected dirty database to fail planning")
+	}
+}
+
+func TestRunNew(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeMigrationFixture(t, dir, "1900000000_create_a.up.sql", "1900000000_create_a.down.sql")
+	files, err := loadMigrationFiles(dir)
+	if err != nil {
+		t.Fatalf("load files: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := runNew(&out, dir, files, "Add Player-Injuries", time.Unix(1800000000, 0)); err != nil {
+		t.Fatalf("run new: %v", err)
+	}
+	for _, name := range []string{"1900000001_add_player_injuries.up.sql", "1900000001_add_player_injuries.down.sql"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Fatalf("expected %s to be created: %v", name, err)
+		}
+	}
+	if err := runNew(&out, dir, files, "  ", time.Now()); err == nil {
+		t.Fatalf("expected empty name to fail")
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/url"
@@ -8,10 +10,12 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	_ "github.com/lib/pq"
 	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
 )
 
@@ -27,6 +31,27 @@ func main() {
 		os.Exit(2)
 	}
 
+	migrationsDir, err := resolveMigrationsDir()
+	if err != nil {
+		fatal("resolve migrations dir failed", "error", err)
+	}
+	files, err := loadMigrationFiles(migrationsDir)
+	if err != nil {
+		fatal("load migration files failed", "error", err)
+	}
+
+	cmd := strings.ToLower(strings.TrimSpace(os.Args[1]))
+	if cmd == "new" {
+		name := ""
+		if len(os.Args) > 2 {
+			name = strings.Join(os.Args[2:], "_")
+		}
+		if err := runNew(os.Stdout, migrationsDir, files, name, time.Now()); err != nil {
+			fatal("create migration failed", "error", err)
+		}
+		return
+	}
+
 	dbURL := strings.TrimSpace(os.Getenv("DB_URL"))
 	if dbURL == "" {
 		fatal("DB_URL is required")
@@ -34,11 +59,6 @@ func main() {
 
 	dbURL = normalizeDBURL(dbURL)
 
-	migrationsDir, err := resolveMigrationsDir()
-	if err != nil {
-		fatal("resolve migrations dir failed", "error", err)
-	}
-
 	sourceURL := "file://" + filepath.ToSlash(migrationsDir)
 	m, err := migrate.New(sourceURL, dbURL)
 	if err != nil {
@@ -46,12 +66,19 @@ func main() {
 	}
 	defer closeMigrator(m)
 
-	cmd := strings.ToLower(strings.TrimSpace(os.Args[1]))
+	db, err := sql.Open("postgres", dbURL)
+	if err != nil {
+		fatal("open database failed", "error", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
 	switch cmd {
 	case "up":
 		err = m.Up()
 		handleMigrationErr(err)
 		logger.Info("migrations applied", "source", sourceURL)
+		recordChecksums(ctx, m, db, files)
 	case "down":
 		steps, parseErr := parseSteps(os.Args[2:])
 		if parseErr != nil {
@@ -60,6 +87,7 @@ func main() {
 		err = m.Steps(-steps)
 		handleMigrationErr(err)
 		logger.Info("migrations rolled back", "steps", steps)
+		recordChecksums(ctx, m, db, files)
 	case "version":
 		version, dirty, versionErr := m.Version()
 		if errors.Is(versionErr, migrate.ErrNilVersion) {
@@ -95,12 +123,51 @@ func main() {
 		err = m.Migrate(target)
 		handleMigrationErr(err)
 		logger.Info("migrated to version", "target", target)
+		recordChecksums(ctx, m, db, files)
+	case "status":
+		if err := runStatus(ctx, os.Stdout, db, files, mustSchemaState(m)); err != nil {
+			fatal("read migration status failed", "error", err)
+		}
+	case "plan":
+		if err := runPlan(os.Stdout, files, mustSchemaState(m), os.Args[2:]); err != nil {
+			fatal("plan migrations failed", "error", err)
+		}
+	case "verify":
+		if err := runVerify(ctx, os.Stdout, db, files, mustSchemaState(m)); err != nil {
+			fatal("verify migrations failed", "error", err)
+		}
 	default:
 		printUsage()
 		os.Exit(2)
 	}
 }
 
+// recordChecksums keeps schema_migration_checksums in step after a schema
+// change. A failure is logged only: the migration itself already succeeded.
+func recordChecksums(ctx context.Context, m *migrate.Migrate, db *sql.DB, files []migrationFile) {
+	state, err := readSchemaState(m)
+	if err != nil {
+		logger.Warn("read migration version for checksums failed", "error", err)
+		return
+	}
+	recorded, err := syncChecksums(ctx, db, files, state.Version)
+	if err != nil {
+		logger.Warn("record migration checksums failed", "error", err)
+		return
+	}
+	if recorded > 0 {
+		logger.Info("migration checksums recorded", "count", recorded)
+	}
+}
+
+func mustSchemaState(m *migrate.Migrate) schemaState {
+	state, err := readSchemaState(m)
+	if err != nil {
+		fatal("read migration version failed", "error", err)
+	}
+	return state
+}
+
 func parseSteps(args []string) (int, error) {
 	if len(args) == 0 {
 		return 1, nil
@@ -230,11 +297,15 @@ func envBool(key string) bool {
 }
 
 func printUsage() {
-	fmt.Fprintf(os.Stderr, "usage: %s <up|down|version|force|goto> [args]\n", filepath.Base(os.Args[0]))
+	fmt.Fprintf(os.Stderr, "usage: %s <up|down|version|force|goto|status|plan|verify|new> [args]\n", filepath.Base(os.Args[0]))
 	fmt.Fprintln(os.Stderr, "examples:")
 	fmt.Fprintf(os.Stderr, "  %s up\n", filepath.Base(os.Args[0]))
 	fmt.Fprintf(os.Stderr, "  %s down 1\n", filepath.Base(os.Args[0]))
 	fmt.Fprintf(os.Stderr, "  %s version\n", filepath.Base(os.Args[0]))
 	fmt.Fprintf(os.Stderr, "  %s force 1771776034\n", filepath.Base(os.Args[0]))
 	fmt.Fprintf(os.Stderr, "  %s goto 1771776034\n", filepath.Base(os.Args[0]))
+	fmt.Fprintf(os.Stderr, "  %s status\n", filepath.Base(os.Args[0]))
+	fmt.Fprintf(os.Stderr, "  %s plan [up | down 1 | goto 1771776034]\n", filepath.Base(os.Args[0]))
+	fmt.Fprintf(os.Stderr, "  %s verify\n", filepath.Base(os.Args[0]))
+	fmt.Fprintf(os.Stderr, "  %s new add_player_injuries\n", filepath.Base(os.Args[0]))
 }
@@ -0,0 +1,19 @@
+DROP INDEX IF EXISTS uq_fixtures_external_source_fixture_id;
+CREATE UNIQUE INDEX uq_fixtures_external_fixture_id
+    ON fixtures (external_fixture_id)
+    WHERE external_fixture_id IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_players_external_source_player_id;
+CREATE UNIQUE INDEX uq_players_external_player_id
+    ON players (external_player_id)
+    WHERE external_player_id IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_teams_external_source_team_id;
+CREATE UNIQUE INDEX uq_teams_external_team_id
+    ON teams (external_team_id)
+    WHERE external_team_id IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_leagues_external_source_league_id;
+CREATE UNIQUE INDEX uq_leagues_external_league_id
+    ON leagues (external_league_id)
+    WHERE external_league_id IS NOT NULL;
@@ -0,0 +1,27 @@
+DROP TABLE IF EXISTS season_rollovers;
+
+DROP INDEX IF EXISTS idx_custom_leagues_previous_active;
+ALTER TABLE custom_leagues
+    DROP COLUMN IF EXISTS previous_custom_league_public_id;
+
+DROP INDEX IF EXISTS uq_players_external_source_player_id;
+CREATE UNIQUE INDEX uq_players_external_source_player_id
+    ON players (external_source, external_player_id)
+    WHERE external_player_id IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_teams_external_source_team_id;
+CREATE UNIQUE INDEX uq_teams_external_source_team_id
+    ON teams (external_source, external_team_id)
+    WHERE external_team_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_leagues_previous_league_public_id;
+DROP INDEX IF EXISTS uq_leagues_external_source_league_id;
+CREATE UNIQUE INDEX uq_leagues_external_source_league_id
+    ON leagues (external_source, external_league_id)
+    WHERE external_league_id IS NOT NULL;
+
+ALTER TABLE leagues
+    DROP COLUMN IF EXISTS archived_at,
+    DROP COLUMN IF EXISTS previous_league_public_id,
+    DROP COLUMN IF EXISTS origin_league_public_id,
+    DROP COLUMN IF EXISTS external_season_id;
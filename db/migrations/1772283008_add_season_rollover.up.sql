@@ -0,0 +1,65 @@
+ALTER TABLE leagues
+    ADD COLUMN IF NOT EXISTS external_season_id BIGINT,
+    ADD COLUMN IF NOT EXISTS origin_league_public_id TEXT,
+    ADD COLUMN IF NOT EXISTS previous_league_public_id TEXT REFERENCES leagues(public_id),
+    ADD COLUMN IF NOT EXISTS archived_at timestamptz;
+
+-- A provider league keeps the same external id across seasons, so only the
+-- active season row has to be unique.
+DROP INDEX IF EXISTS uq_leagues_external_source_league_id;
+CREATE UNIQUE INDEX uq_leagues_external_source_league_id
+    ON leagues (external_source, external_league_id)
+    WHERE external_league_id IS NOT NULL
+      AND archived_at IS NULL
+      AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_leagues_previous_league_public_id
+    ON leagues (previous_league_public_id)
+    WHERE previous_league_public_id IS NOT NULL;
+
+-- Teams and players are re-synced per season league, so the provider id is
+-- only unique within a league.
+DROP INDEX IF EXISTS uq_teams_external_source_team_id;
+CREATE UNIQUE INDEX uq_teams_external_source_team_id
+    ON teams (league_public_id, external_source, external_team_id)
+    WHERE external_team_id IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_players_external_source_player_id;
+CREATE UNIQUE INDEX uq_players_external_source_player_id
+    ON players (league_public_id, external_source, external_player_id)
+    WHERE external_player_id IS NOT NULL;
+
+ALTER TABLE custom_leagues
+    ADD COLUMN IF NOT EXISTS previous_custom_league_public_id TEXT REFERENCES custom_leagues(public_id);
+
+CREATE INDEX IF NOT EXISTS idx_custom_leagues_previous_active
+    ON custom_leagues (previous_custom_league_public_id)
+    WHERE previous_custom_league_public_id IS NOT NULL
+      AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS season_rollovers (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    public_id TEXT NOT NULL UNIQUE,
+    from_league_public_id TEXT NOT NULL REFERENCES leagues(public_id),
+    to_league_public_id TEXT NOT NULL REFERENCES leagues(public_id),
+    from_season TEXT NOT NULL,
+    to_season TEXT NOT NULL,
+    external_season_id BIGINT,
+    archived_squads INTEGER NOT NULL DEFAULT 0,
+    archived_lineups INTEGER NOT NULL DEFAULT 0,
+    archived_points INTEGER NOT NULL DEFAULT 0,
+    archived_standings INTEGER NOT NULL DEFAULT 0,
+    carried_custom_leagues INTEGER NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_season_rollovers_from_league_active
+    ON season_rollovers (from_league_public_id)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_season_rollovers_touch_updated_at
+    BEFORE UPDATE ON season_rollovers
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
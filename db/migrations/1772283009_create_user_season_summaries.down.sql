@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_user_season_summaries_touch_updated_at ON user_season_summaries;
+DROP TABLE IF EXISTS user_season_summaries;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS user_season_summaries (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id),
+    season TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    squad_public_id TEXT,
+    squad_name TEXT NOT NULL DEFAULT '',
+    total_points INTEGER NOT NULL DEFAULT 0,
+    overall_rank INTEGER NOT NULL DEFAULT 0,
+    total_managers INTEGER NOT NULL DEFAULT 0,
+    gameweeks_played INTEGER NOT NULL DEFAULT 0,
+    best_gameweek INTEGER NOT NULL DEFAULT 0,
+    best_gameweek_points INTEGER NOT NULL DEFAULT 0,
+    final_picks JSONB NOT NULL DEFAULT '[]'::jsonb,
+    custom_leagues JSONB NOT NULL DEFAULT '[]'::jsonb,
+    computed_at timestamptz NOT NULL DEFAULT NOW(),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_season_summaries_league_user_active
+    ON user_season_summaries (league_public_id, user_id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_season_summaries_user_active
+    ON user_season_summaries (user_id, computed_at DESC, id)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_user_season_summaries_touch_updated_at
+    BEFORE UPDATE ON user_season_summaries
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
@@ -0,0 +1,2 @@
+-- Accent-insensitive player name search for the transfer market.
+CREATE EXTENSION IF NOT EXISTS unaccent;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_player_gameweek_ownership_touch_updated_at ON player_gameweek_ownership;
+DROP TABLE IF EXISTS player_gameweek_ownership;
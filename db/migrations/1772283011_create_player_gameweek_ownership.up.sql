@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS player_gameweek_ownership (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id) ON DELETE CASCADE,
+    gameweek INTEGER NOT NULL CHECK (gameweek > 0),
+    player_public_id TEXT NOT NULL REFERENCES players(public_id) ON DELETE CASCADE,
+    total_managers INTEGER NOT NULL DEFAULT 0,
+    selected_count INTEGER NOT NULL DEFAULT 0,
+    captain_count INTEGER NOT NULL DEFAULT 0,
+    starter_count INTEGER NOT NULL DEFAULT 0,
+    transfers_in INTEGER NOT NULL DEFAULT 0,
+    transfers_out INTEGER NOT NULL DEFAULT 0,
+    selected_percent NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    captain_percent NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    starter_percent NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    computed_at timestamptz NOT NULL DEFAULT NOW(),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_player_gameweek_ownership_league_gameweek_player_active
+    ON player_gameweek_ownership (league_public_id, gameweek, player_public_id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_player_gameweek_ownership_player_active
+    ON player_gameweek_ownership (player_public_id, gameweek DESC)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_player_gameweek_ownership_touch_updated_at
+    BEFORE UPDATE ON player_gameweek_ownership
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS trg_gameweek_dream_team_players_touch_updated_at ON gameweek_dream_team_players;
+DROP TABLE IF EXISTS gameweek_dream_team_players;
+
+DROP TRIGGER IF EXISTS trg_gameweek_awards_touch_updated_at ON gameweek_awards;
+DROP TABLE IF EXISTS gameweek_awards;
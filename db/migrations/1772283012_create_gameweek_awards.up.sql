@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS gameweek_awards (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id) ON DELETE CASCADE,
+    gameweek INTEGER NOT NULL CHECK (gameweek > 0),
+    top_player_public_id TEXT,
+    top_player_points INTEGER NOT NULL DEFAULT 0,
+    top_manager_user_id TEXT,
+    top_manager_points INTEGER NOT NULL DEFAULT 0,
+    computed_at timestamptz NOT NULL DEFAULT NOW(),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_gameweek_awards_league_gameweek_active
+    ON gameweek_awards (league_public_id, gameweek)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_gameweek_awards_touch_updated_at
+    BEFORE UPDATE ON gameweek_awards
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
+
+CREATE TABLE IF NOT EXISTS gameweek_dream_team_players (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id) ON DELETE CASCADE,
+    gameweek INTEGER NOT NULL CHECK (gameweek > 0),
+    scope TEXT NOT NULL CHECK (scope IN ('gameweek', 'season')),
+    slot INTEGER NOT NULL CHECK (slot > 0),
+    player_public_id TEXT NOT NULL REFERENCES players(public_id) ON DELETE CASCADE,
+    position TEXT NOT NULL CHECK (position IN ('GK', 'DEF', 'MID', 'FWD')),
+    points INTEGER NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_gameweek_dream_team_players_slot_active
+    ON gameweek_dream_team_players (league_public_id, gameweek, scope, slot)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_gameweek_dream_team_players_touch_updated_at
+    BEFORE UPDATE ON gameweek_dream_team_players
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_gameweek_summaries_touch_updated_at ON gameweek_summaries;
+DROP TABLE IF EXISTS gameweek_summaries;
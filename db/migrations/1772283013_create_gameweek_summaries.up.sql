@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS gameweek_summaries (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id) ON DELETE CASCADE,
+    gameweek INT NOT NULL CHECK (gameweek > 0),
+    managers INT NOT NULL DEFAULT 0,
+    average_points NUMERIC(8, 2) NOT NULL DEFAULT 0,
+    highest_points INT NOT NULL DEFAULT 0,
+    highest_user_id TEXT,
+    most_captained_player_public_id TEXT,
+    most_captained_count INT NOT NULL DEFAULT 0,
+    calculated_at BIGINT NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_gameweek_summaries_league_gameweek_active
+    ON gameweek_summaries (league_public_id, gameweek)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_gameweek_summaries_touch_updated_at
+    BEFORE UPDATE ON gameweek_summaries
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
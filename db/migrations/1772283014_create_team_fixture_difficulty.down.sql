@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_team_fixture_difficulty_touch_updated_at ON team_fixture_difficulty;
+DROP TABLE IF EXISTS team_fixture_difficulty;
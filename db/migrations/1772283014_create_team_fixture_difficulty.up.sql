@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS team_fixture_difficulty (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id) ON DELETE CASCADE,
+    team_public_id TEXT NOT NULL REFERENCES teams(public_id) ON DELETE CASCADE,
+    home_strength NUMERIC(5, 4) NOT NULL DEFAULT 0,
+    away_strength NUMERIC(5, 4) NOT NULL DEFAULT 0,
+    home_difficulty SMALLINT NOT NULL CHECK (home_difficulty BETWEEN 1 AND 5),
+    away_difficulty SMALLINT NOT NULL CHECK (away_difficulty BETWEEN 1 AND 5),
+    based_on_gameweek INTEGER NOT NULL DEFAULT 0,
+    computed_at timestamptz NOT NULL DEFAULT NOW(),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_team_fixture_difficulty_league_team_active
+    ON team_fixture_difficulty (league_public_id, team_public_id)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_team_fixture_difficulty_touch_updated_at
+    BEFORE UPDATE ON team_fixture_difficulty
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
@@ -0,0 +1,2 @@
+ALTER TABLE player_fixture_stats
+    DROP COLUMN IF EXISTS bonus_points;
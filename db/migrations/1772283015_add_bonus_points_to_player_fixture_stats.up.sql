@@ -0,0 +1,2 @@
+ALTER TABLE player_fixture_stats
+    ADD COLUMN bonus_points INT NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS notification_deliveries;
+DROP TRIGGER IF EXISTS trg_user_notification_preferences_touch_updated_at ON user_notification_preferences;
+DROP TABLE IF EXISTS user_notification_preferences;
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS user_notification_preferences (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    email TEXT,
+    email_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    push_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    push_endpoint TEXT,
+    push_p256dh TEXT,
+    push_auth TEXT,
+    webhook_url TEXT,
+    webhook_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    muted_events TEXT[] NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_notification_preferences_user_active
+    ON user_notification_preferences (user_id)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_user_notification_preferences_touch_updated_at
+    BEFORE UPDATE ON user_notification_preferences
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
+
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    dedup_key TEXT NOT NULL,
+    event TEXT NOT NULL,
+    channels TEXT[] NOT NULL DEFAULT '{}',
+    sent_at timestamptz NOT NULL DEFAULT NOW(),
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_notification_deliveries_user_dedup
+    ON notification_deliveries (user_id, dedup_key);
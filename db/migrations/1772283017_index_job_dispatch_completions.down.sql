@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_job_dispatches_job_league_completed_active;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_job_dispatches_job_league_completed_active
+    ON job_dispatches (job_name, league_public_id, completed_at DESC)
+    WHERE deleted_at IS NULL AND completed_at IS NOT NULL;
@@ -0,0 +1,2 @@
+-- audit_logs is dropped by 1772283021_make_audit_logs_append_only.
+SELECT 1;
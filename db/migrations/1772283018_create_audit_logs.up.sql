@@ -0,0 +1,4 @@
+-- audit_logs is created together with its append-only trigger by
+-- 1772283021_make_audit_logs_append_only. This version stays so databases
+-- that already ran it keep a migration file for it.
+SELECT 1;
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS trg_player_overrides_touch_updated_at ON player_overrides;
+DROP TABLE IF EXISTS player_overrides;
+DROP TRIGGER IF EXISTS trg_team_overrides_touch_updated_at ON team_overrides;
+DROP TABLE IF EXISTS team_overrides;
+
+ALTER TABLE players
+    DROP COLUMN IF EXISTS is_hidden;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS is_hidden;
@@ -0,0 +1,63 @@
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE players
+    ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Overrides are kept apart from the provider rows so a resync can rebuild
+-- teams and players and apply them again on top.
+CREATE TABLE IF NOT EXISTS team_overrides (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id) ON DELETE CASCADE,
+    team_public_id TEXT NOT NULL REFERENCES teams(public_id) ON DELETE CASCADE,
+    is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    note TEXT NOT NULL DEFAULT '',
+    updated_by TEXT NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_team_overrides_team_active
+    ON team_overrides (team_public_id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_team_overrides_league_active
+    ON team_overrides (league_public_id)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_team_overrides_touch_updated_at
+    BEFORE UPDATE ON team_overrides
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
+
+-- NULL columns keep the provider value.
+CREATE TABLE IF NOT EXISTS player_overrides (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id) ON DELETE CASCADE,
+    player_public_id TEXT NOT NULL REFERENCES players(public_id) ON DELETE CASCADE,
+    position TEXT,
+    price BIGINT,
+    is_active BOOLEAN,
+    is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    note TEXT NOT NULL DEFAULT '',
+    updated_by TEXT NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz,
+    CONSTRAINT player_overrides_position_check CHECK (position IS NULL OR position IN ('GK', 'DEF', 'MID', 'FWD')),
+    CONSTRAINT player_overrides_price_positive CHECK (price IS NULL OR price > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_player_overrides_player_active
+    ON player_overrides (player_public_id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_player_overrides_league_active
+    ON player_overrides (league_public_id)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_player_overrides_touch_updated_at
+    BEFORE UPDATE ON player_overrides
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
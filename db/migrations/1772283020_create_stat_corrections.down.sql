@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS stat_correction_user_points;
+DROP TRIGGER IF EXISTS trg_player_fixture_stat_overrides_touch_updated_at ON player_fixture_stat_overrides;
+DROP TABLE IF EXISTS player_fixture_stat_overrides;
+DROP TABLE IF EXISTS stat_corrections;
@@ -0,0 +1,63 @@
+-- One row per applied correction request. players holds the before and after
+-- stats of every corrected player.
+CREATE TABLE IF NOT EXISTS stat_corrections (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    league_public_id TEXT NOT NULL REFERENCES leagues(public_id) ON DELETE CASCADE,
+    fixture_public_id TEXT NOT NULL REFERENCES fixtures(public_id) ON DELETE CASCADE,
+    gameweek INT NOT NULL,
+    reason TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    trace_id TEXT,
+    players JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stat_corrections_fixture
+    ON stat_corrections (league_public_id, fixture_public_id, created_at DESC);
+
+-- The corrected values of a player in a fixture. Provider upserts of
+-- player_fixture_stats apply these again so a resync never undoes them.
+CREATE TABLE IF NOT EXISTS player_fixture_stat_overrides (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    correction_id BIGINT NOT NULL REFERENCES stat_corrections(id) ON DELETE CASCADE,
+    fixture_public_id TEXT NOT NULL REFERENCES fixtures(public_id) ON DELETE CASCADE,
+    player_public_id TEXT NOT NULL REFERENCES players(public_id) ON DELETE CASCADE,
+    team_public_id TEXT NOT NULL REFERENCES teams(public_id) ON DELETE CASCADE,
+    minutes_played INT NOT NULL,
+    goals INT NOT NULL,
+    assists INT NOT NULL,
+    clean_sheet BOOLEAN NOT NULL,
+    yellow_cards INT NOT NULL,
+    red_cards INT NOT NULL,
+    saves INT NOT NULL,
+    bonus_points INT NOT NULL,
+    fantasy_points INT NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_player_fixture_stat_overrides_active
+    ON player_fixture_stat_overrides (fixture_public_id, player_public_id)
+    WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trg_player_fixture_stat_overrides_touch_updated_at
+    BEFORE UPDATE ON player_fixture_stat_overrides
+    FOR EACH ROW
+    EXECUTE FUNCTION touch_updated_at();
+
+-- Points of each user before and after the rescoring a correction caused.
+CREATE TABLE IF NOT EXISTS stat_correction_user_points (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    correction_id BIGINT NOT NULL REFERENCES stat_corrections(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    points_before INT NOT NULL,
+    points_after INT NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_stat_correction_user_points
+    ON stat_correction_user_points (correction_id, user_id);
+
+CREATE INDEX IF NOT EXISTS idx_stat_correction_user_points_user
+    ON stat_correction_user_points (user_id, created_at DESC);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_audit_logs_created;
+DROP TRIGGER IF EXISTS trg_audit_logs_append_only ON audit_logs;
+DROP FUNCTION IF EXISTS reject_audit_log_change();
+DROP TABLE IF EXISTS audit_logs;
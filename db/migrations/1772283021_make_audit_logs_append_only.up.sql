@@ -0,0 +1,35 @@
+-- The audit log of every mutating usecase. Entries are only ever appended;
+-- the trigger below rejects updates and deletes.
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    actor_user_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    entity_type TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    league_public_id TEXT NOT NULL DEFAULT '',
+    trace_id TEXT NOT NULL DEFAULT '',
+    before_data JSONB,
+    after_data JSONB,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_entity_created
+    ON audit_logs (entity_type, entity_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_created
+    ON audit_logs (actor_user_id, created_at DESC);
+
+CREATE OR REPLACE FUNCTION reject_audit_log_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_logs_append_only
+    BEFORE UPDATE OR DELETE ON audit_logs
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_audit_log_change();
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created
+    ON audit_logs (created_at DESC);
@@ -0,0 +1,22 @@
+-- Responses of mutating requests sent with an Idempotency-Key header. A row
+-- with status_code 0 is still being handled; expired rows are taken over by
+-- the next request using the same key.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    idempotency_key TEXT NOT NULL,
+    method TEXT NOT NULL,
+    path TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    status_code INT NOT NULL DEFAULT 0,
+    content_type TEXT NOT NULL DEFAULT '',
+    response_body BYTEA,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    expires_at timestamptz NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_idempotency_keys_user_key
+    ON idempotency_keys (user_id, idempotency_key);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires
+    ON idempotency_keys (expires_at);
@@ -0,0 +1,19 @@
+-- Account deletion sets fantasy.audit_log_anonymize for its own transaction
+-- to rewrite the user id out of existing entries. Every other change, and
+-- any rewrite of what an entry describes, is still rejected.
+CREATE OR REPLACE FUNCTION reject_audit_log_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND current_setting('fantasy.audit_log_anonymize', true) = 'on'
+        AND NEW.id = OLD.id
+        AND NEW.action = OLD.action
+        AND NEW.entity_type = OLD.entity_type
+        AND NEW.league_public_id = OLD.league_public_id
+        AND NEW.trace_id = OLD.trace_id
+        AND NEW.created_at = OLD.created_at THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
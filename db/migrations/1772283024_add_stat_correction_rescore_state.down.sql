@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_stat_corrections_pending;
+
+ALTER TABLE stat_corrections
+    DROP COLUMN IF EXISTS rescored_at,
+    DROP COLUMN IF EXISTS points_before;
@@ -0,0 +1,14 @@
+-- points_before keeps the gameweek points a correction was applied against so
+-- an interrupted rescore can be finished later. rescored_at stays NULL until
+-- the user points of the correction are stored.
+ALTER TABLE stat_corrections
+    ADD COLUMN IF NOT EXISTS points_before JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD COLUMN IF NOT EXISTS rescored_at timestamptz;
+
+UPDATE stat_corrections
+SET rescored_at = created_at
+WHERE rescored_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_stat_corrections_pending
+    ON stat_corrections (league_public_id, id)
+    WHERE rescored_at IS NULL;
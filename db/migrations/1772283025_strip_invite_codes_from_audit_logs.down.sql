@@ -0,0 +1,2 @@
+-- Stripped invite codes cannot be restored.
+SELECT 1;
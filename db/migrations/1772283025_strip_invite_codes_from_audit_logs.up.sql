@@ -0,0 +1,15 @@
+-- Invite codes grant access to a custom league and no longer go into the
+-- audit log. Earlier entries lose them through the same switch account
+-- deletion uses, which only holds for this transaction.
+BEGIN;
+
+SELECT set_config('fantasy.audit_log_anonymize', 'on', true);
+
+UPDATE audit_logs
+SET
+    before_data = before_data - 'invite_code',
+    after_data = after_data - 'invite_code'
+WHERE entity_type = 'custom_league'
+  AND (jsonb_exists(before_data, 'invite_code') OR jsonb_exists(after_data, 'invite_code'));
+
+COMMIT;
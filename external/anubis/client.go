@@ -60,6 +60,14 @@ func NewClient(
 	}
 }
 
+// CircuitState reports the breaker guarding the introspection endpoint.
+func (c *Client) CircuitState() resilience.CircuitState {
+	if !c.circuitEnabled {
+		return resilience.CircuitStateDisabled
+	}
+	return c.breaker.State()
+}
+
 func withTracingTransport(client *http.Client) *http.Client {
 	if client == nil {
 		client = &http.Client{}
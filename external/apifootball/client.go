@@ -0,0 +1,1194 @@
+package apifootball
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	crerr "github.com/cockroachdb/errors"
+	"github.com/riskibarqy/fantasy-league/internal/domain/rawdata"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+	"github.com/riskibarqy/fantasy-league/internal/platform/metrics"
+	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	defaultBaseURL         = "https://v3.football.api-sports.io"
+	apiKeyHeader           = "x-apisports-key"
+	fixtureDetailChunkSize = 20
+	fixtureDetailMaxIDs    = 80
+)
+
+var digitsRegex = regexp.MustCompile(`\d+`)
+var errAPIFootballTransient = crerr.New("api-football transient failure")
+
+// topScorerPaths maps the top scorer type ids used by the top scorer
+// repository onto the API-Football ranking endpoints.
+var topScorerPaths = map[int]string{
+	208: "/players/topscorers",
+	209: "/players/topassists",
+	83:  "/players/topredcards",
+	84:  "/players/topyellowcards",
+}
+
+type ClientConfig struct {
+	HTTPClient *http.Client
+	BaseURL    string
+	Token      string
+	// LeagueID is the API-Football league id. Season-scoped endpoints need it
+	// because API-Football identifies a season by league and year.
+	LeagueID       int64
+	Timeout        time.Duration
+	MaxRetries     int
+	Logger         *logging.Logger
+	CircuitBreaker resilience.CircuitBreakerConfig
+}
+
+// Client implements usecase.SportDataSyncProvider against the API-Football v3 API.
+// Season ids passed to it are season years (for example 2025).
+type Client struct {
+	httpClient     *http.Client
+	baseURL        string
+	token          string
+	leagueID       int64
+	maxRetries     int
+	logger         *logging.Logger
+	breaker        *resilience.CircuitBreaker
+	circuitEnabled bool
+	flight         resilience.SingleFlight
+	now            func() time.Time
+}
+
+func NewClient(cfg ClientConfig) *Client {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = logging.Default()
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+	if httpClient.Timeout <= 0 {
+		httpClient.Timeout = 20 * time.Second
+	}
+	httpClient = withTracingTransport(httpClient)
+
+	baseURL := strings.TrimRight(strings.TrimSpace(cfg.BaseURL), "/")
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+	breakerCfg := resilience.NormalizeCircuitBreakerConfig(cfg.CircuitBreaker)
+
+	return &Client{
+		httpClient:     httpClient,
+		baseURL:        baseURL,
+		token:          strings.TrimSpace(cfg.Token),
+		leagueID:       cfg.LeagueID,
+		maxRetries:     maxInt(cfg.MaxRetries, 0),
+		logger:         logger,
+		breaker:        resilience.NewCircuitBreaker(breakerCfg.FailureThreshold, breakerCfg.OpenTimeout, breakerCfg.HalfOpenMaxReq),
+		circuitEnabled: breakerCfg.Enabled,
+		now:            time.Now,
+	}
+}
+
+func withTracingTransport(client *http.Client) *http.Client {
+	if client == nil {
+		client = &http.Client{}
+	}
+	copyClient := *client
+	baseTransport := copyClient.Transport
+	if baseTransport == nil {
+		baseTransport = http.DefaultTransport
+	}
+	copyClient.Transport = otelhttp.NewTransport(baseTransport)
+	return &copyClient
+}
+
+// CircuitState reports the breaker guarding API-Football requests.
+func (c *Client) CircuitState() resilience.CircuitState {
+	if !c.circuitEnabled {
+		return resilience.CircuitStateDisabled
+	}
+	return c.breaker.State()
+}
+
+func (c *Client) FetchFixtureBundleBySeason(ctx context.Context, seasonID int64) (usecase.ExternalFixtureBundle, error) {
+	if seasonID <= 0 {
+		return usecase.ExternalFixtureBundle{}, fmt.Errorf("season id must be greater than zero")
+	}
+	if c.leagueID <= 0 {
+		return usecase.ExternalFixtureBundle{}, fmt.Errorf("api-football league id is not configured")
+	}
+
+	payloads := make([]rawdata.Payload, 0, 8)
+	byID := make(map[int64]usecase.ExternalFixture, 128)
+	teamsByID := make(map[int64]usecase.ExternalTeam, 64)
+
+	path := "/fixtures"
+	query := map[string]string{
+		"league": strconv.FormatInt(c.leagueID, 10),
+		"season": strconv.FormatInt(seasonID, 10),
+	}
+	var schedule envelope[fixtureItem]
+	raw, err := c.doJSON(ctx, path, query, &schedule)
+	if err != nil {
+		return usecase.ExternalFixtureBundle{}, fmt.Errorf("fetch fixtures league_id=%d season=%d: %w", c.leagueID, seasonID, err)
+	}
+	payloads = append(payloads, buildAPIPayload(path, query, raw))
+
+	for _, item := range schedule.Response {
+		if item.Fixture.ID <= 0 {
+			continue
+		}
+		upsertExternalTeam(teamsByID, mapTeamRef(item.Teams.Home))
+		upsertExternalTeam(teamsByID, mapTeamRef(item.Teams.Away))
+		byID[item.Fixture.ID] = mapFixture(item)
+	}
+
+	playersByID := make(map[int64]usecase.ExternalPlayer, 1024)
+	teamStats := make([]usecase.ExternalTeamFixtureStat, 0, 64)
+	playerStats := make([]usecase.ExternalPlayerFixtureStat, 0, 1024)
+	events := make([]usecase.ExternalFixtureEvent, 0, 512)
+
+	fixtureIDs := selectFixtureIDsForDetailHydration(byID, c.now().UTC())
+	for start := 0; start < len(fixtureIDs); start += fixtureDetailChunkSize {
+		end := start + fixtureDetailChunkSize
+		if end > len(fixtureIDs) {
+			end = len(fixtureIDs)
+		}
+
+		idValues := make([]string, 0, end-start)
+		for _, fixtureID := range fixtureIDs[start:end] {
+			idValues = append(idValues, strconv.FormatInt(fixtureID, 10))
+		}
+		detailQuery := map[string]string{
+			"ids": strings.Join(idValues, "-"),
+		}
+
+		var details envelope[fixtureItem]
+		raw, err := c.doJSON(ctx, path, detailQuery, &details)
+		if err != nil {
+			if ctx.Err() != nil {
+				return usecase.ExternalFixtureBundle{}, ctx.Err()
+			}
+			c.logger.WarnContext(
+				ctx,
+				"fetch api-football fixture details failed, continuing with schedule-only rows",
+				"league_id", c.leagueID,
+				"season", seasonID,
+				"chunk_size", end-start,
+				"error", err,
+			)
+			continue
+		}
+		payloads = append(payloads, buildAPIPayload(path, detailQuery, raw))
+
+		for _, item := range details.Response {
+			if item.Fixture.ID <= 0 {
+				continue
+			}
+			byID[item.Fixture.ID] = mapFixture(item)
+			upsertExternalTeam(teamsByID, mapTeamRef(item.Teams.Home))
+			upsertExternalTeam(teamsByID, mapTeamRef(item.Teams.Away))
+
+			fixtureEvents := mapFixtureEvents(item)
+			events = append(events, fixtureEvents...)
+			teamStats = append(teamStats, mapTeamFixtureStats(item)...)
+
+			stats, players := mapPlayerFixtureStats(item, fixtureEvents)
+			playerStats = append(playerStats, stats...)
+			for _, candidate := range players {
+				upsertExternalPlayer(playersByID, candidate)
+			}
+		}
+	}
+
+	return usecase.ExternalFixtureBundle{
+		Fixtures:    sortedFixtures(byID),
+		Teams:       sortedTeams(teamsByID),
+		Players:     sortedPlayers(playersByID),
+		TeamStats:   teamStats,
+		PlayerStats: playerStats,
+		Events:      events,
+		RawPayloads: payloads,
+	}, nil
+}
+
+func (c *Client) FetchFixturesBySeason(ctx context.Context, seasonID int64) ([]usecase.ExternalFixture, []rawdata.Payload, error) {
+	bundle, err := c.FetchFixtureBundleBySeason(ctx, seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bundle.Fixtures, bundle.RawPayloads, nil
+}
+
+func (c *Client) FetchStandingsBySeason(ctx context.Context, seasonID int64) ([]usecase.ExternalStanding, []rawdata.Payload, error) {
+	if seasonID <= 0 {
+		return nil, nil, fmt.Errorf("season id must be greater than zero")
+	}
+	if c.leagueID <= 0 {
+		return nil, nil, fmt.Errorf("api-football league id is not configured")
+	}
+	return c.fetchStandings(ctx, c.leagueID, seasonID)
+}
+
+// FetchLiveStandingsByLeague resolves the current season of the league first,
+// API-Football has no dedicated live standings endpoint and refreshes the
+// regular table while matches are played.
+func (c *Client) FetchLiveStandingsByLeague(ctx context.Context, leagueRefID int64) ([]usecase.ExternalStanding, []rawdata.Payload, error) {
+	if leagueRefID <= 0 {
+		return nil, nil, fmt.Errorf("league reference id must be greater than zero")
+	}
+
+	path := "/leagues"
+	query := map[string]string{
+		"id":      strconv.FormatInt(leagueRefID, 10),
+		"current": "true",
+	}
+	var leagues envelope[leagueSeasonsItem]
+	raw, err := c.doJSON(ctx, path, query, &leagues)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetch current season league_ref_id=%d: %w", leagueRefID, err)
+	}
+	season := currentSeasonYear(leagues.Response)
+	if season <= 0 {
+		return nil, nil, fmt.Errorf("current season not found for league_ref_id=%d", leagueRefID)
+	}
+
+	items, payloads, err := c.fetchStandings(ctx, leagueRefID, int64(season))
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, append([]rawdata.Payload{buildAPIPayload(path, query, raw)}, payloads...), nil
+}
+
+func (c *Client) fetchStandings(ctx context.Context, leagueID, season int64) ([]usecase.ExternalStanding, []rawdata.Payload, error) {
+	path := "/standings"
+	query := map[string]string{
+		"league": strconv.FormatInt(leagueID, 10),
+		"season": strconv.FormatInt(season, 10),
+	}
+
+	var standings envelope[standingsItem]
+	raw, err := c.doJSON(ctx, path, query, &standings)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetch standings league_id=%d season=%d: %w", leagueID, season, err)
+	}
+
+	payloads := []rawdata.Payload{
+		buildAPIPayload(path, query, raw),
+	}
+	return parseStandings(standings.Response), payloads, nil
+}
+
+// FetchStatisticTypes returns no rows: API-Football does not publish a numbered
+// stat type catalog, so stat values stay fixture-scoped in AdvancedStats.
+func (c *Client) FetchStatisticTypes(_ context.Context) ([]usecase.ExternalStatType, []rawdata.Payload, error) {
+	return nil, nil, nil
+}
+
+func (c *Client) FetchTeamStatisticsBySeason(_ context.Context, seasonID int64) ([]usecase.ExternalTeamStatValue, []rawdata.Payload, error) {
+	if seasonID <= 0 {
+		return nil, nil, fmt.Errorf("season id must be greater than zero")
+	}
+	return nil, nil, nil
+}
+
+func (c *Client) FetchPlayerStatisticsBySeason(_ context.Context, seasonID int64) ([]usecase.ExternalPlayerStatValue, []rawdata.Payload, error) {
+	if seasonID <= 0 {
+		return nil, nil, fmt.Errorf("season id must be greater than zero")
+	}
+	return nil, nil, nil
+}
+
+// FetchTopScorersBySeasonID reads the API-Football ranking for one top scorer
+// type. Rankings are returned in a single page of at most 20 rows.
+func (c *Client) FetchTopScorersBySeasonID(ctx context.Context, seasonID, page, typeId int) ([]usecase.ExternalTopScorers, bool, error) {
+	if seasonID <= 0 {
+		return nil, false, fmt.Errorf("season id must be greater than zero")
+	}
+	if c.leagueID <= 0 {
+		return nil, false, fmt.Errorf("api-football league id is not configured")
+	}
+	path, ok := topScorerPaths[typeId]
+	if !ok {
+		return nil, false, fmt.Errorf("unsupported top scorer type id=%d", typeId)
+	}
+	if page > 1 {
+		return nil, false, nil
+	}
+
+	query := map[string]string{
+		"league": strconv.FormatInt(c.leagueID, 10),
+		"season": strconv.Itoa(seasonID),
+	}
+	var ranking envelope[topPlayerItem]
+	if _, err := c.doJSON(ctx, path, query, &ranking); err != nil {
+		return nil, false, fmt.Errorf("fetch top scorers season=%d type_id=%d: %w", seasonID, typeId, err)
+	}
+
+	return parseTopScorers(ranking.Response, c.leagueID, seasonID, typeId), false, nil
+}
+
+func (c *Client) doJSON(ctx context.Context, path string, query map[string]string, target any) ([]byte, error) {
+	if c.circuitEnabled {
+		if err := c.breaker.Allow(); err != nil {
+			c.logger.WarnContext(ctx, "api-football circuit breaker rejected request", "state", c.breaker.State())
+			metrics.ProviderRequests.WithLabelValues("api-football", metrics.EndpointLabel(path), metrics.OutcomeCircuitOpen).Inc()
+			return nil, fmt.Errorf("%w: sport data provider is temporarily unavailable", usecase.ErrDependencyUnavailable)
+		}
+	}
+
+	values := url.Values{}
+	for key, value := range query {
+		values.Set(key, value)
+	}
+
+	fullURL := c.baseURL + path
+	if encoded := values.Encode(); encoded != "" {
+		fullURL += "?" + encoded
+	}
+
+	key := path + "?" + values.Encode()
+	out, err, _ := c.flight.Do(key, func() (any, error) {
+		raw, reqErr := c.executeRequest(ctx, fullURL)
+		if reqErr == nil {
+			reqErr = checkResponseErrors(raw)
+		}
+		metrics.ObserveProviderRequest("api-football", path, reqErr)
+		if c.circuitEnabled {
+			if reqErr != nil && stderrors.Is(reqErr, errAPIFootballTransient) {
+				c.breaker.RecordFailure()
+			} else {
+				c.breaker.RecordSuccess()
+			}
+		}
+		return raw, reqErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	raw, ok := out.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response payload type %T", out)
+	}
+
+	if err := sonic.Unmarshal(raw, target); err != nil {
+		return nil, fmt.Errorf("decode provider payload: %w", err)
+	}
+
+	return raw, nil
+}
+
+func (c *Client) executeRequest(ctx context.Context, fullURL string) ([]byte, error) {
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("build request: %w", err)
+		}
+		req.Header.Set("accept", "application/json")
+		req.Header.Set(apiKeyHeader, c.token)
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = fmt.Errorf("%w: send request: %s", errAPIFootballTransient, sanitizeSensitiveText(err.Error(), c.token))
+		} else {
+			raw, readErr := io.ReadAll(io.LimitReader(resp.Body, 6<<20))
+			_ = resp.Body.Close()
+			if readErr != nil {
+				lastErr = fmt.Errorf("%w: read response body: %v", errAPIFootballTransient, readErr)
+			} else if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+				return raw, nil
+			} else if isRetryableStatus(resp.StatusCode) {
+				lastErr = fmt.Errorf("%w: provider status=%d body=%s", errAPIFootballTransient, resp.StatusCode, abbreviateBody(raw))
+			} else {
+				return nil, fmt.Errorf("provider status=%d body=%s", resp.StatusCode, abbreviateBody(raw))
+			}
+		}
+
+		if attempt == c.maxRetries {
+			break
+		}
+		backoff := time.Duration(attempt+1) * time.Second
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if lastErr == nil {
+		lastErr = fmt.Errorf("provider request failed")
+	}
+	c.logger.WarnContext(ctx, "api-football request failed", "url", fullURL, "error", lastErr)
+	return nil, lastErr
+}
+
+// checkResponseErrors surfaces the errors object API-Football returns with a
+// 200 status. Rate limit errors are transient so they count for the breaker.
+func checkResponseErrors(raw []byte) error {
+	var head struct {
+		Errors apiErrors `json:"errors"`
+	}
+	if err := sonic.Unmarshal(raw, &head); err != nil {
+		return fmt.Errorf("decode provider errors: %w", err)
+	}
+	if len(head.Errors) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(head.Errors))
+	for key := range head.Errors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	transient := false
+	for _, key := range keys {
+		parts = append(parts, key+"="+head.Errors[key])
+		switch strings.ToLower(key) {
+		case "ratelimit", "requests":
+			transient = true
+		}
+	}
+	if transient {
+		return fmt.Errorf("%w: provider errors: %s", errAPIFootballTransient, strings.Join(parts, "; "))
+	}
+	return fmt.Errorf("provider errors: %s", strings.Join(parts, "; "))
+}
+
+func mapFixture(item fixtureItem) usecase.ExternalFixture {
+	status := mapFixtureStatus(item.Fixture.Status.Short)
+	kickoffAt := parseKickoff(item.Fixture.Date, item.Fixture.Timestamp)
+
+	out := usecase.ExternalFixture{
+		ExternalID:         item.Fixture.ID,
+		Gameweek:           parseGameweek(item.League.Round, 1),
+		HomeTeamName:       strings.TrimSpace(item.Teams.Home.Name),
+		AwayTeamName:       strings.TrimSpace(item.Teams.Away.Name),
+		HomeTeamExternalID: item.Teams.Home.ID,
+		AwayTeamExternalID: item.Teams.Away.ID,
+		KickoffAt:          kickoffAt,
+		Venue:              strings.TrimSpace(item.Fixture.Venue.Name),
+		Status:             status,
+		HomeScore:          cloneIntPtr(item.Goals.Home),
+		AwayScore:          cloneIntPtr(item.Goals.Away),
+	}
+	switch {
+	case item.Teams.Home.Winner != nil && *item.Teams.Home.Winner:
+		out.WinnerTeamExternalID = item.Teams.Home.ID
+	case item.Teams.Away.Winner != nil && *item.Teams.Away.Winner:
+		out.WinnerTeamExternalID = item.Teams.Away.ID
+	}
+	if status == "FINISHED" && !kickoffAt.IsZero() {
+		elapsed := 90
+		if item.Fixture.Status.Elapsed != nil && *item.Fixture.Status.Elapsed > elapsed {
+			elapsed = *item.Fixture.Status.Elapsed
+		}
+		// Add the half-time break on top of the played minutes.
+		finishedAt := kickoffAt.Add(time.Duration(elapsed+15) * time.Minute)
+		out.FinishedAt = &finishedAt
+	}
+	return out
+}
+
+func mapFixtureStatus(short string) string {
+	switch strings.ToUpper(strings.TrimSpace(short)) {
+	case "1H", "HT", "2H", "ET", "BT", "P", "SUSP", "INT", "LIVE":
+		return "LIVE"
+	case "FT", "AET", "PEN", "AWD", "WO":
+		return "FINISHED"
+	case "PST":
+		return "POSTPONED"
+	case "CANC", "ABD":
+		return "CANCELLED"
+	default:
+		return "SCHEDULED"
+	}
+}
+
+func parseKickoff(raw string, timestamp int64) time.Time {
+	if parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(raw)); err == nil {
+		return parsed.UTC()
+	}
+	if timestamp > 0 {
+		return time.Unix(timestamp, 0).UTC()
+	}
+	return time.Time{}
+}
+
+func parseGameweek(raw string, fallback int) int {
+	matches := digitsRegex.FindAllString(strings.TrimSpace(raw), -1)
+	if len(matches) == 0 {
+		return fallback
+	}
+	value, err := strconv.Atoi(matches[len(matches)-1])
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+func mapTeamRef(source teamRef) usecase.ExternalTeam {
+	return usecase.ExternalTeam{
+		ExternalID: source.ID,
+		Name:       strings.TrimSpace(source.Name),
+		ImageURL:   strings.TrimSpace(source.Logo),
+	}
+}
+
+func upsertExternalTeam(items map[int64]usecase.ExternalTeam, candidate usecase.ExternalTeam) {
+	if items == nil || candidate.ExternalID <= 0 {
+		return
+	}
+	current := items[candidate.ExternalID]
+	current.ExternalID = candidate.ExternalID
+	current.Name = firstNonEmpty(current.Name, candidate.Name)
+	current.Short = firstNonEmpty(current.Short, candidate.Short)
+	current.ImageURL = firstNonEmpty(current.ImageURL, candidate.ImageURL)
+	items[candidate.ExternalID] = current
+}
+
+func upsertExternalPlayer(items map[int64]usecase.ExternalPlayer, candidate usecase.ExternalPlayer) {
+	if items == nil || candidate.ExternalID <= 0 {
+		return
+	}
+	current := items[candidate.ExternalID]
+	current.ExternalID = candidate.ExternalID
+	current.TeamExternalID = pickID(current.TeamExternalID, candidate.TeamExternalID)
+	current.Name = firstNonEmpty(current.Name, candidate.Name)
+	current.Position = firstNonEmpty(current.Position, candidate.Position)
+	current.ImageURL = firstNonEmpty(current.ImageURL, candidate.ImageURL)
+	items[candidate.ExternalID] = current
+}
+
+func mapFixtureEvents(item fixtureItem) []usecase.ExternalFixtureEvent {
+	if len(item.Events) == 0 {
+		return nil
+	}
+
+	out := make([]usecase.ExternalFixtureEvent, 0, len(item.Events))
+	for idx, source := range item.Events {
+		minute := 0
+		if source.Time.Elapsed != nil && *source.Time.Elapsed > 0 {
+			minute = *source.Time.Elapsed
+		}
+		extraMinute := 0
+		if source.Time.Extra != nil && *source.Time.Extra > 0 {
+			extraMinute = *source.Time.Extra
+		}
+
+		eventType := mapEventType(source.Type, source.Detail)
+		out = append(out, usecase.ExternalFixtureEvent{
+			FixtureExternalID:      item.Fixture.ID,
+			TeamExternalID:         source.Team.ID,
+			PlayerExternalID:       source.Player.ID,
+			AssistPlayerExternalID: source.Assist.ID,
+			EventType:              eventType,
+			Detail:                 strings.TrimSpace(source.Detail),
+			Minute:                 minute,
+			ExtraMinute:            extraMinute,
+			Metadata: map[string]any{
+				"raw_type":     strings.TrimSpace(source.Type),
+				"raw_detail":   strings.TrimSpace(source.Detail),
+				"comments":     strings.TrimSpace(source.Comments),
+				"player_name":  strings.TrimSpace(source.Player.Name),
+				"assist_name":  strings.TrimSpace(source.Assist.Name),
+				"sort_order":   idx,
+				"minute":       minute,
+				"extra_minute": extraMinute,
+			},
+		})
+	}
+	return out
+}
+
+// mapEventType aligns API-Football event names with the developer names used
+// by SportMonks so downstream consumers see one vocabulary.
+func mapEventType(eventType, detail string) string {
+	kind := strings.ToLower(strings.TrimSpace(eventType))
+	value := strings.ToLower(strings.TrimSpace(detail))
+	switch kind {
+	case "goal":
+		switch value {
+		case "own goal":
+			return "OWNGOAL"
+		case "penalty":
+			return "PENALTY"
+		case "missed penalty":
+			return "MISSED_PENALTY"
+		default:
+			return "GOAL"
+		}
+	case "card":
+		switch {
+		case strings.Contains(value, "second yellow"):
+			return "YELLOWREDCARD"
+		case strings.Contains(value, "red"):
+			return "REDCARD"
+		default:
+			return "YELLOWCARD"
+		}
+	case "subst":
+		return "SUBSTITUTION"
+	case "var":
+		return "VAR"
+	case "":
+		return "unknown"
+	default:
+		return strings.ToUpper(strings.ReplaceAll(kind, " ", "_"))
+	}
+}
+
+func mapTeamFixtureStats(item fixtureItem) []usecase.ExternalTeamFixtureStat {
+	if len(item.Statistics) == 0 {
+		return nil
+	}
+
+	out := make([]usecase.ExternalTeamFixtureStat, 0, len(item.Statistics))
+	for _, source := range item.Statistics {
+		if source.Team.ID <= 0 {
+			continue
+		}
+		stat := usecase.ExternalTeamFixtureStat{
+			FixtureExternalID: item.Fixture.ID,
+			TeamExternalID:    source.Team.ID,
+			TeamName:          strings.TrimSpace(source.Team.Name),
+			AdvancedStats:     make(map[string]any, len(source.Statistics)),
+		}
+		for _, value := range source.Statistics {
+			key := normalizeStatKey(value.Type)
+			if key == "" {
+				continue
+			}
+			number, ok := statNumber(value.Value)
+			if !ok {
+				continue
+			}
+			stat.AdvancedStats[key] = number
+			switch key {
+			case "ball_possession":
+				stat.PossessionPct = number
+			case "total_shots":
+				stat.Shots = int(number)
+			case "shots_on_goal":
+				stat.ShotsOnTarget = int(number)
+			case "corner_kicks":
+				stat.Corners = int(number)
+			case "fouls":
+				stat.Fouls = int(number)
+			case "offsides":
+				stat.Offsides = int(number)
+			}
+		}
+		out = append(out, stat)
+	}
+	return out
+}
+
+func mapPlayerFixtureStats(item fixtureItem, events []usecase.ExternalFixtureEvent) ([]usecase.ExternalPlayerFixtureStat, []usecase.ExternalPlayer) {
+	if len(item.Players) == 0 {
+		return nil, nil
+	}
+
+	ownGoals := make(map[int64]int)
+	for _, event := range events {
+		if event.EventType == "OWNGOAL" && event.PlayerExternalID > 0 {
+			ownGoals[event.PlayerExternalID]++
+		}
+	}
+
+	concededByTeam := map[int64]*int{
+		item.Teams.Home.ID: item.Goals.Away,
+		item.Teams.Away.ID: item.Goals.Home,
+	}
+
+	stats := make(map[string]usecase.ExternalPlayerFixtureStat, 48)
+	players := make([]usecase.ExternalPlayer, 0, 48)
+	for _, teamItem := range item.Players {
+		for _, entry := range teamItem.Players {
+			if entry.Player.ID <= 0 || len(entry.Statistics) == 0 {
+				continue
+			}
+			detail := entry.Statistics[0]
+			position := normalizePosition(detail.Games.Position)
+			players = append(players, usecase.ExternalPlayer{
+				ExternalID:     entry.Player.ID,
+				TeamExternalID: teamItem.Team.ID,
+				Name:           strings.TrimSpace(entry.Player.Name),
+				Position:       position,
+				ImageURL:       strings.TrimSpace(entry.Player.Photo),
+			})
+
+			minutes := detail.Games.Minutes.Int()
+			conceded := 0
+			concededKnown := false
+			if value := concededByTeam[teamItem.Team.ID]; value != nil {
+				conceded = *value
+				concededKnown = true
+			}
+			if position == "GK" && detail.Goals.Conceded.Valid {
+				conceded = detail.Goals.Conceded.Int()
+				concededKnown = true
+			}
+
+			stat := usecase.ExternalPlayerFixtureStat{
+				FixtureExternalID: item.Fixture.ID,
+				PlayerExternalID:  entry.Player.ID,
+				TeamExternalID:    teamItem.Team.ID,
+				Position:          position,
+				MinutesPlayed:     minutes,
+				Goals:             detail.Goals.Total.Int(),
+				Assists:           detail.Goals.Assists.Int(),
+				CleanSheet:        minutes > 0 && concededKnown && conceded == 0,
+				OwnGoals:          ownGoals[entry.Player.ID],
+				PenaltiesSaved:    detail.Penalty.Saved.Int(),
+				PenaltiesMissed:   detail.Penalty.Missed.Int(),
+				YellowCards:       detail.Cards.Yellow.Int(),
+				RedCards:          detail.Cards.Red.Int(),
+				Saves:             detail.Goals.Saves.Int(),
+				AdvancedStats:     playerAdvancedStats(detail),
+			}
+			if minutes > 0 {
+				stat.GoalsConceded = conceded
+			}
+			// API-Football has no bonus point system; the match rating ranks
+			// players for bonus the same way BPS does on SportMonks.
+			if detail.Games.Rating.Valid {
+				stat.BPS = int(math.Round(detail.Games.Rating.Value * 10))
+			}
+			stats[fmt.Sprintf("%d:%d", item.Fixture.ID, entry.Player.ID)] = stat
+		}
+	}
+
+	applyBonusByBPS(item.Fixture.ID, stats)
+
+	keys := make([]string, 0, len(stats))
+	for key := range stats {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	out := make([]usecase.ExternalPlayerFixtureStat, 0, len(keys))
+	for _, key := range keys {
+		stat := stats[key]
+		stat.FantasyPoints = usecase.EstimateFantasyPoints(stat)
+		out = append(out, stat)
+	}
+	return out, players
+}
+
+func playerAdvancedStats(detail playerMatchDetail) map[string]any {
+	out := map[string]any{
+		"captain":    detail.Games.Captain,
+		"substitute": detail.Games.Substitute,
+	}
+	values := map[string]flexFloat{
+		"rating":            detail.Games.Rating,
+		"shots_total":       detail.Shots.Total,
+		"shots_on_target":   detail.Shots.On,
+		"passes_total":      detail.Passes.Total,
+		"key_passes":        detail.Passes.Key,
+		"pass_accuracy":     detail.Passes.Accuracy,
+		"tackles":           detail.Tackles.Total,
+		"blocks":            detail.Tackles.Blocks,
+		"interceptions":     detail.Tackles.Interceptions,
+		"duels_total":       detail.Duels.Total,
+		"duels_won":         detail.Duels.Won,
+		"dribbles_attempts": detail.Dribbles.Attempts,
+		"dribbles_success":  detail.Dribbles.Success,
+		"fouls_drawn":       detail.Fouls.Drawn,
+		"fouls_committed":   detail.Fouls.Committed,
+		"offsides":          detail.Offsides,
+		"penalties_won":     detail.Penalty.Won,
+		"penalties_scored":  detail.Penalty.Scored,
+	}
+	for key, value := range values {
+		if value.Valid {
+			out[key] = value.Value
+		}
+	}
+	return out
+}
+
+func normalizePosition(value string) string {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "g", "goalkeeper":
+		return "GK"
+	case "d", "defender":
+		return "DEF"
+	case "m", "midfielder":
+		return "MID"
+	case "f", "attacker", "forward":
+		return "FWD"
+	default:
+		return ""
+	}
+}
+
+func applyBonusByBPS(fixtureExternalID int64, stats map[string]usecase.ExternalPlayerFixtureStat) {
+	type row struct {
+		key string
+		bps int
+	}
+	rows := make([]row, 0, len(stats))
+	prefix := fmt.Sprintf("%d:", fixtureExternalID)
+	for key, item := range stats {
+		if !strings.HasPrefix(key, prefix) || item.BPS <= 0 || item.MinutesPlayed <= 0 {
+			continue
+		}
+		rows = append(rows, row{key: key, bps: item.BPS})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].bps != rows[j].bps {
+			return rows[i].bps > rows[j].bps
+		}
+		return rows[i].key < rows[j].key
+	})
+
+	position := 1
+	for i := 0; i < len(rows) && position <= 3; {
+		j := i + 1
+		for j < len(rows) && rows[j].bps == rows[i].bps {
+			j++
+		}
+		bonus := 4 - position
+		for _, item := range rows[i:j] {
+			value := stats[item.key]
+			value.BonusPoints = bonus
+			stats[item.key] = value
+		}
+		position += j - i
+		i = j
+	}
+}
+
+func parseStandings(items []standingsItem) []usecase.ExternalStanding {
+	out := make([]usecase.ExternalStanding, 0, 32)
+	seen := make(map[int64]struct{}, 32)
+	for _, item := range items {
+		for _, group := range item.League.Standings {
+			for _, row := range group {
+				if row.Team.ID <= 0 || row.Rank <= 0 {
+					continue
+				}
+				if _, ok := seen[row.Team.ID]; ok {
+					continue
+				}
+				seen[row.Team.ID] = struct{}{}
+
+				standing := usecase.ExternalStanding{
+					TeamExternalID: row.Team.ID,
+					TeamName:       strings.TrimSpace(row.Team.Name),
+					Position:       row.Rank,
+					Played:         row.All.Played,
+					Won:            row.All.Win,
+					Draw:           row.All.Draw,
+					Lost:           row.All.Lose,
+					GoalsFor:       row.All.Goals.For,
+					GoalsAgainst:   row.All.Goals.Against,
+					GoalDifference: row.GoalsDiff,
+					Points:         row.Points,
+					Form:           strings.TrimSpace(row.Form),
+				}
+				if parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(row.Update)); err == nil {
+					updatedAt := parsed.UTC()
+					standing.SourceUpdatedAt = &updatedAt
+				}
+				out = append(out, standing)
+			}
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Position < out[j].Position
+	})
+	return out
+}
+
+func currentSeasonYear(items []leagueSeasonsItem) int {
+	for _, item := range items {
+		for _, season := range item.Seasons {
+			if season.Current {
+				return season.Year
+			}
+		}
+	}
+	return 0
+}
+
+func parseTopScorers(items []topPlayerItem, leagueID int64, seasonID, typeID int) []usecase.ExternalTopScorers {
+	typeName := map[int]string{
+		208: "Goal Topscorer",
+		209: "Assist Topscorer",
+		83:  "Redcards",
+		84:  "Yellowcards",
+	}[typeID]
+
+	out := make([]usecase.ExternalTopScorers, 0, len(items))
+	for idx, item := range items {
+		if item.Player.ID <= 0 || len(item.Statistics) == 0 {
+			continue
+		}
+		stats := item.Statistics[0]
+		total := 0
+		switch typeID {
+		case 208:
+			total = stats.Goals.Total.Int()
+		case 209:
+			total = stats.Goals.Assists.Int()
+		case 83:
+			total = stats.Cards.Red.Int()
+		case 84:
+			total = stats.Cards.Yellow.Int()
+		}
+		out = append(out, usecase.ExternalTopScorers{
+			TypeID:           int64(typeID),
+			TypeName:         typeName,
+			Rank:             idx + 1,
+			Total:            total,
+			LeagueID:         leagueID,
+			PlayerID:         item.Player.ID,
+			Season:           strconv.Itoa(seasonID),
+			ParticipantID:    stats.Team.ID,
+			PlayerName:       strings.TrimSpace(item.Player.Name),
+			ImagePlayer:      strings.TrimSpace(item.Player.Photo),
+			Nationality:      strings.TrimSpace(item.Player.Nationality),
+			ParticipantName:  strings.TrimSpace(stats.Team.Name),
+			ImageParticipant: strings.TrimSpace(stats.Team.Logo),
+			PositionName:     strings.ToLower(strings.TrimSpace(stats.Games.Position)),
+		})
+	}
+	return out
+}
+
+// selectFixtureIDsForDetailHydration picks the gameweek closest to now and its
+// neighbours, mirroring the SportMonks adapter so both providers load the
+// same live window.
+func selectFixtureIDsForDetailHydration(fixtures map[int64]usecase.ExternalFixture, now time.Time) []int64 {
+	type candidate struct {
+		id       int64
+		kickoff  time.Time
+		gameweek int
+	}
+
+	candidates := make([]candidate, 0, len(fixtures))
+	for id, item := range fixtures {
+		if id <= 0 || item.KickoffAt.IsZero() || item.Gameweek <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{id: id, kickoff: item.KickoffAt.UTC(), gameweek: item.Gameweek})
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	closest := candidates[0]
+	closestDiff := absDuration(now.Sub(closest.kickoff))
+	for _, item := range candidates[1:] {
+		diff := absDuration(now.Sub(item.kickoff))
+		if diff < closestDiff || (diff == closestDiff && item.kickoff.Before(closest.kickoff)) {
+			closest = item
+			closestDiff = diff
+		}
+	}
+
+	selected := make([]candidate, 0, len(candidates))
+	for _, item := range candidates {
+		if item.gameweek < closest.gameweek-1 || item.gameweek > closest.gameweek+1 {
+			continue
+		}
+		selected = append(selected, item)
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		if selected[i].gameweek != selected[j].gameweek {
+			return selected[i].gameweek < selected[j].gameweek
+		}
+		if !selected[i].kickoff.Equal(selected[j].kickoff) {
+			return selected[i].kickoff.Before(selected[j].kickoff)
+		}
+		return selected[i].id < selected[j].id
+	})
+	if len(selected) > fixtureDetailMaxIDs {
+		selected = selected[:fixtureDetailMaxIDs]
+	}
+
+	out := make([]int64, 0, len(selected))
+	for _, item := range selected {
+		out = append(out, item.id)
+	}
+	return out
+}
+
+func sortedFixtures(items map[int64]usecase.ExternalFixture) []usecase.ExternalFixture {
+	out := make([]usecase.ExternalFixture, 0, len(items))
+	for _, item := range items {
+		out = append(out, item)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Gameweek != out[j].Gameweek {
+			return out[i].Gameweek < out[j].Gameweek
+		}
+		if !out[i].KickoffAt.Equal(out[j].KickoffAt) {
+			return out[i].KickoffAt.Before(out[j].KickoffAt)
+		}
+		return out[i].ExternalID < out[j].ExternalID
+	})
+	return out
+}
+
+func sortedTeams(items map[int64]usecase.ExternalTeam) []usecase.ExternalTeam {
+	out := make([]usecase.ExternalTeam, 0, len(items))
+	for _, item := range items {
+		out = append(out, item)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].ExternalID < out[j].ExternalID })
+	return out
+}
+
+func sortedPlayers(items map[int64]usecase.ExternalPlayer) []usecase.ExternalPlayer {
+	out := make([]usecase.ExternalPlayer, 0, len(items))
+	for _, item := range items {
+		out = append(out, item)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].ExternalID < out[j].ExternalID })
+	return out
+}
+
+func normalizeStatKey(raw string) string {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	if value == "" {
+		return ""
+	}
+	var builder strings.Builder
+	lastUnderscore := false
+	for _, r := range value {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			lastUnderscore = false
+			continue
+		}
+		if !lastUnderscore {
+			builder.WriteByte('_')
+			lastUnderscore = true
+		}
+	}
+	return strings.Trim(builder.String(), "_")
+}
+
+func statNumber(value any) (float64, bool) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, true
+	case int:
+		return float64(typed), true
+	case int64:
+		return float64(typed), true
+	case string:
+		text := strings.TrimSuffix(strings.TrimSpace(typed), "%")
+		parsed, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return 0, false
+		}
+		return parsed, true
+	default:
+		return 0, false
+	}
+}
+
+func buildAPIPayload(path string, query map[string]string, raw []byte) rawdata.Payload {
+	values := url.Values{}
+	for key, value := range query {
+		values.Set(key, value)
+	}
+
+	entityKey := strings.TrimSpace(path)
+	if encoded := values.Encode(); encoded != "" {
+		entityKey += "?" + encoded
+	}
+	return rawdata.Payload{
+		EntityType:  "api_response",
+		EntityKey:   entityKey,
+		PayloadJSON: string(raw),
+	}
+}
+
+func sanitizeSensitiveText(value, token string) string {
+	value = strings.TrimSpace(value)
+	if token != "" {
+		value = strings.ReplaceAll(value, token, "REDACTED")
+	}
+	return value
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+func abbreviateBody(body []byte) string {
+	text := strings.TrimSpace(string(body))
+	if len(text) <= 240 {
+		return text
+	}
+	return text[:240] + "..."
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, item := range values {
+		if strings.TrimSpace(item) != "" {
+			return strings.TrimSpace(item)
+		}
+	}
+	return ""
+}
+
+func pickID(current, candidate int64) int64 {
+	if current > 0 {
+		return current
+	}
+	if candidate > 0 {
+		return candidate
+	}
+	return 0
+}
+
+func cloneIntPtr(value *int) *int {
+	if value == nil {
+		return nil
+	}
+	v := *value
+	return &v
+}
+
+func absDuration(value time.Duration) time.Duration {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+func maxInt(left, right int) int {
+	if left > right {
+		return left
+	}
+	return right
+}
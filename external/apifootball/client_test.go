@@ -0,0 +1,277 @@
+package apifootball
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+// newRecordedServer serves recorded API-Football responses keyed by path and
+// the query parameter that distinguishes calls on the same path.
+func newRecordedServer(t *testing.T, routes map[string]string) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get(apiKeyHeader); got != "test-key" {
+			t.Errorf("unexpected %s header: %q", apiKeyHeader, got)
+		}
+
+		key := r.URL.Path
+		if ids := r.URL.Query().Get("ids"); ids != "" {
+			key += "?ids"
+		}
+		file, ok := routes[key]
+		if !ok {
+			t.Errorf("unexpected request: %s", r.URL.String())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		raw, err := os.ReadFile(filepath.Join("testdata", file))
+		if err != nil {
+			t.Errorf("read recorded fixture %s: %v", file, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(raw)
+	}))
+}
+
+func newTestClient(srv *httptest.Server) *Client {
+	client := NewClient(ClientConfig{
+		HTTPClient:     srv.Client(),
+		BaseURL:        srv.URL,
+		Token:          "test-key",
+		LeagueID:       274,
+		Logger:         logging.NewNop(),
+		CircuitBreaker: resilience.CircuitBreakerConfig{Enabled: false},
+	})
+	client.now = func() time.Time {
+		return time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC)
+	}
+	return client
+}
+
+func TestClientFetchFixtureBundleBySeason_MapsRecordedFixtures(t *testing.T) {
+	t.Parallel()
+
+	srv := newRecordedServer(t, map[string]string{
+		"/fixtures":     "fixtures_season.json",
+		"/fixtures?ids": "fixtures_detail.json",
+	})
+	defer srv.Close()
+
+	bundle, err := newTestClient(srv).FetchFixtureBundleBySeason(context.Background(), 2025)
+	if err != nil {
+		t.Fatalf("fetch fixture bundle: %v", err)
+	}
+
+	if len(bundle.Fixtures) != 2 {
+		t.Fatalf("unexpected fixture count: got=%d want=2", len(bundle.Fixtures))
+	}
+	finished := bundle.Fixtures[0]
+	if finished.ExternalID != 1208001 || finished.Gameweek != 1 || finished.Status != "FINISHED" {
+		t.Fatalf("unexpected finished fixture: %+v", finished)
+	}
+	if finished.HomeScore == nil || *finished.HomeScore != 2 || finished.AwayScore == nil || *finished.AwayScore != 0 {
+		t.Fatalf("unexpected finished fixture score: %+v", finished)
+	}
+	if finished.WinnerTeamExternalID != 2070 || finished.FinishedAt == nil {
+		t.Fatalf("unexpected winner or finished time: %+v", finished)
+	}
+	if upcoming := bundle.Fixtures[1]; upcoming.Gameweek != 2 || upcoming.Status != "SCHEDULED" || upcoming.HomeScore != nil {
+		t.Fatalf("unexpected upcoming fixture: %+v", upcoming)
+	}
+
+	if len(bundle.Teams) != 2 || bundle.Teams[0].Name != "Persija Jakarta" {
+		t.Fatalf("unexpected teams: %+v", bundle.Teams)
+	}
+	if len(bundle.Players) != 5 {
+		t.Fatalf("unexpected player count: got=%d want=5", len(bundle.Players))
+	}
+	if len(bundle.RawPayloads) != 2 {
+		t.Fatalf("unexpected raw payload count: got=%d want=2", len(bundle.RawPayloads))
+	}
+
+	if len(bundle.TeamStats) != 2 {
+		t.Fatalf("unexpected team stat count: got=%d want=2", len(bundle.TeamStats))
+	}
+	home := bundle.TeamStats[0]
+	if home.PossessionPct != 58 || home.Shots != 14 || home.ShotsOnTarget != 6 || home.Corners != 5 {
+		t.Fatalf("unexpected home team stats: %+v", home)
+	}
+	if home.AdvancedStats["expected_goals"] != 1.84 {
+		t.Fatalf("expected expected_goals advanced stat, got %+v", home.AdvancedStats)
+	}
+
+	events := make(map[string]usecase.ExternalFixtureEvent, len(bundle.Events))
+	for _, item := range bundle.Events {
+		events[item.EventType] = item
+	}
+	if goal := events["GOAL"]; goal.PlayerExternalID != 50001 || goal.AssistPlayerExternalID != 50002 || goal.Minute != 23 {
+		t.Fatalf("unexpected goal event: %+v", goal)
+	}
+	if ownGoal := events["OWNGOAL"]; ownGoal.Minute != 90 || ownGoal.ExtraMinute != 3 {
+		t.Fatalf("unexpected own goal event: %+v", ownGoal)
+	}
+	if _, ok := events["YELLOWCARD"]; !ok {
+		t.Fatalf("expected yellow card event, got %+v", bundle.Events)
+	}
+
+	stats := make(map[int64]usecase.ExternalPlayerFixtureStat, len(bundle.PlayerStats))
+	for _, item := range bundle.PlayerStats {
+		stats[item.PlayerExternalID] = item
+	}
+
+	keeper := stats[50000]
+	if keeper.Position != "GK" || !keeper.CleanSheet || keeper.Saves != 4 || keeper.GoalsConceded != 0 {
+		t.Fatalf("unexpected keeper stats: %+v", keeper)
+	}
+	striker := stats[50001]
+	// FWD: goal 4 + minutes 2 + bonus 3 (highest rating) = 9
+	if striker.Goals != 1 || striker.BonusPoints != 3 || striker.FantasyPoints != 9 {
+		t.Fatalf("unexpected striker stats: %+v", striker)
+	}
+	defender := stats[60001]
+	// DEF: minutes 2 - conceded 2/2 - own goal 2 = -1
+	if defender.OwnGoals != 1 || defender.GoalsConceded != 2 || defender.CleanSheet || defender.FantasyPoints != -1 {
+		t.Fatalf("unexpected defender stats: %+v", defender)
+	}
+	bench := stats[60002]
+	if bench.MinutesPlayed != 0 || bench.YellowCards != 1 || bench.BonusPoints != 0 {
+		t.Fatalf("unexpected unused substitute stats: %+v", bench)
+	}
+}
+
+func TestClientFetchStandingsBySeason_MapsRecordedTable(t *testing.T) {
+	t.Parallel()
+
+	srv := newRecordedServer(t, map[string]string{
+		"/standings": "standings.json",
+	})
+	defer srv.Close()
+
+	items, payloads, err := newTestClient(srv).FetchStandingsBySeason(context.Background(), 2025)
+	if err != nil {
+		t.Fatalf("fetch standings: %v", err)
+	}
+	if len(payloads) != 1 {
+		t.Fatalf("unexpected payload count: got=%d want=1", len(payloads))
+	}
+	if len(items) != 2 {
+		t.Fatalf("unexpected standing count: got=%d want=2", len(items))
+	}
+
+	top := items[0]
+	if top.TeamExternalID != 2070 || top.Position != 1 || top.Points != 3 || top.GoalDifference != 2 || top.Form != "W" {
+		t.Fatalf("unexpected top standing row: %+v", top)
+	}
+	if top.Played != 1 || top.Won != 1 || top.GoalsFor != 2 || top.SourceUpdatedAt == nil {
+		t.Fatalf("unexpected top standing totals: %+v", top)
+	}
+}
+
+func TestClientFetchLiveStandingsByLeague_ResolvesCurrentSeason(t *testing.T) {
+	t.Parallel()
+
+	srv := newRecordedServer(t, map[string]string{
+		"/leagues":   "leagues_current.json",
+		"/standings": "standings.json",
+	})
+	defer srv.Close()
+
+	items, payloads, err := newTestClient(srv).FetchLiveStandingsByLeague(context.Background(), 274)
+	if err != nil {
+		t.Fatalf("fetch live standings: %v", err)
+	}
+	if len(items) != 2 || len(payloads) != 2 {
+		t.Fatalf("unexpected live standings result: items=%d payloads=%d", len(items), len(payloads))
+	}
+}
+
+func TestClientFetchTopScorersBySeasonID_MapsRanking(t *testing.T) {
+	t.Parallel()
+
+	srv := newRecordedServer(t, map[string]string{
+		"/players/topscorers": "topscorers.json",
+	})
+	defer srv.Close()
+
+	client := newTestClient(srv)
+	items, hasMore, err := client.FetchTopScorersBySeasonID(context.Background(), 2025, 1, 208)
+	if err != nil {
+		t.Fatalf("fetch top scorers: %v", err)
+	}
+	if hasMore {
+		t.Fatalf("expected single page ranking")
+	}
+	if len(items) != 2 {
+		t.Fatalf("unexpected top scorer count: got=%d want=2", len(items))
+	}
+	if items[0].PlayerID != 50001 || items[0].Rank != 1 || items[0].Total != 1 || items[0].TypeID != 208 {
+		t.Fatalf("unexpected first top scorer: %+v", items[0])
+	}
+
+	next, hasMore, err := client.FetchTopScorersBySeasonID(context.Background(), 2025, 2, 208)
+	if err != nil || hasMore || len(next) != 0 {
+		t.Fatalf("expected empty second page, got items=%d hasMore=%v err=%v", len(next), hasMore, err)
+	}
+}
+
+func TestClientDoJSON_ReturnsProviderErrorsFromOKResponse(t *testing.T) {
+	t.Parallel()
+
+	srv := newRecordedServer(t, map[string]string{
+		"/standings": "errors_token.json",
+	})
+	defer srv.Close()
+
+	_, _, err := newTestClient(srv).FetchStandingsBySeason(context.Background(), 2025)
+	if err == nil {
+		t.Fatalf("expected provider error")
+	}
+	if errors.Is(err, errAPIFootballTransient) {
+		t.Fatalf("token error must not be transient: %v", err)
+	}
+}
+
+func TestClientDoJSON_CircuitOpensOnTransientFailures(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := NewClient(ClientConfig{
+		HTTPClient: srv.Client(),
+		BaseURL:    srv.URL,
+		Token:      "test-key",
+		LeagueID:   274,
+		Logger:     logging.NewNop(),
+		CircuitBreaker: resilience.CircuitBreakerConfig{
+			Enabled:          true,
+			FailureThreshold: 1,
+			OpenTimeout:      time.Minute,
+			HalfOpenMaxReq:   1,
+		},
+	})
+
+	if _, _, err := client.FetchStandingsBySeason(context.Background(), 2025); err == nil {
+		t.Fatalf("expected first request to fail")
+	}
+	_, _, err := client.FetchStandingsBySeason(context.Background(), 2025)
+	if !errors.Is(err, usecase.ErrDependencyUnavailable) {
+		t.Fatalf("expected open circuit error, got %v", err)
+	}
+}
@@ -0,0 +1,301 @@
+package apifootball
+
+import (
+	"strconv"
+	"strings"
+
+	sonic "github.com/bytedance/sonic"
+)
+
+// envelope is the common API-Football v3 response wrapper.
+type envelope[T any] struct {
+	Get      string    `json:"get"`
+	Errors   apiErrors `json:"errors"`
+	Results  int       `json:"results"`
+	Paging   paging    `json:"paging"`
+	Response []T       `json:"response"`
+}
+
+type paging struct {
+	Current int `json:"current"`
+	Total   int `json:"total"`
+}
+
+// apiErrors accepts both shapes returned by the API: an empty array when the
+// request succeeded and an object keyed by field when it did not.
+type apiErrors map[string]string
+
+func (e *apiErrors) UnmarshalJSON(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if text == "" || text == "null" || strings.HasPrefix(text, "[") {
+		var items []string
+		if strings.HasPrefix(text, "[") {
+			if err := sonic.Unmarshal(data, &items); err != nil {
+				return err
+			}
+		}
+		out := make(apiErrors, len(items))
+		for idx, item := range items {
+			out[strconv.Itoa(idx)] = item
+		}
+		*e = out
+		return nil
+	}
+
+	raw := make(map[string]any)
+	if err := sonic.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	out := make(apiErrors, len(raw))
+	for key, value := range raw {
+		if text, ok := value.(string); ok {
+			out[key] = text
+			continue
+		}
+		encoded, _ := sonic.Marshal(value)
+		out[key] = string(encoded)
+	}
+	*e = out
+	return nil
+}
+
+// flexFloat decodes numeric values that the API sends either as numbers,
+// numeric strings ("7.3", "55%") or null.
+type flexFloat struct {
+	Value float64
+	Valid bool
+}
+
+func (f *flexFloat) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(strings.TrimSpace(string(data)), `"`)
+	text = strings.TrimSuffix(strings.TrimSpace(text), "%")
+	if text == "" || text == "null" {
+		*f = flexFloat{}
+		return nil
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		*f = flexFloat{}
+		return nil
+	}
+	*f = flexFloat{Value: value, Valid: true}
+	return nil
+}
+
+func (f flexFloat) Int() int {
+	if !f.Valid {
+		return 0
+	}
+	return int(f.Value)
+}
+
+type fixtureItem struct {
+	Fixture    fixtureInfo          `json:"fixture"`
+	League     leagueRef            `json:"league"`
+	Teams      fixtureTeams         `json:"teams"`
+	Goals      fixtureGoals         `json:"goals"`
+	Events     []fixtureEventItem   `json:"events"`
+	Statistics []teamStatisticsItem `json:"statistics"`
+	Players    []teamPlayersItem    `json:"players"`
+}
+
+type fixtureInfo struct {
+	ID        int64         `json:"id"`
+	Referee   string        `json:"referee"`
+	Date      string        `json:"date"`
+	Timestamp int64         `json:"timestamp"`
+	Venue     fixtureVenue  `json:"venue"`
+	Status    fixtureStatus `json:"status"`
+}
+
+type fixtureVenue struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	City string `json:"city"`
+}
+
+type fixtureStatus struct {
+	Long    string `json:"long"`
+	Short   string `json:"short"`
+	Elapsed *int   `json:"elapsed"`
+}
+
+type leagueRef struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Country string `json:"country"`
+	Season  int    `json:"season"`
+	Round   string `json:"round"`
+}
+
+type fixtureTeams struct {
+	Home teamRef `json:"home"`
+	Away teamRef `json:"away"`
+}
+
+type teamRef struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Logo   string `json:"logo"`
+	Winner *bool  `json:"winner"`
+}
+
+type fixtureGoals struct {
+	Home *int `json:"home"`
+	Away *int `json:"away"`
+}
+
+type fixtureEventItem struct {
+	Time struct {
+		Elapsed *int `json:"elapsed"`
+		Extra   *int `json:"extra"`
+	} `json:"time"`
+	Team     teamRef   `json:"team"`
+	Player   personRef `json:"player"`
+	Assist   personRef `json:"assist"`
+	Type     string    `json:"type"`
+	Detail   string    `json:"detail"`
+	Comments string    `json:"comments"`
+}
+
+type personRef struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Photo string `json:"photo"`
+}
+
+type teamStatisticsItem struct {
+	Team       teamRef               `json:"team"`
+	Statistics []teamStatisticsValue `json:"statistics"`
+}
+
+type teamStatisticsValue struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+type teamPlayersItem struct {
+	Team    teamRef            `json:"team"`
+	Players []playerStatsEntry `json:"players"`
+}
+
+type playerStatsEntry struct {
+	Player     personRef           `json:"player"`
+	Statistics []playerMatchDetail `json:"statistics"`
+}
+
+type playerMatchDetail struct {
+	Games struct {
+		Minutes    flexFloat `json:"minutes"`
+		Number     flexFloat `json:"number"`
+		Position   string    `json:"position"`
+		Rating     flexFloat `json:"rating"`
+		Captain    bool      `json:"captain"`
+		Substitute bool      `json:"substitute"`
+	} `json:"games"`
+	Offsides flexFloat `json:"offsides"`
+	Shots    struct {
+		Total flexFloat `json:"total"`
+		On    flexFloat `json:"on"`
+	} `json:"shots"`
+	Goals struct {
+		Total    flexFloat `json:"total"`
+		Conceded flexFloat `json:"conceded"`
+		Assists  flexFloat `json:"assists"`
+		Saves    flexFloat `json:"saves"`
+	} `json:"goals"`
+	Passes struct {
+		Total    flexFloat `json:"total"`
+		Key      flexFloat `json:"key"`
+		Accuracy flexFloat `json:"accuracy"`
+	} `json:"passes"`
+	Tackles struct {
+		Total         flexFloat `json:"total"`
+		Blocks        flexFloat `json:"blocks"`
+		Interceptions flexFloat `json:"interceptions"`
+	} `json:"tackles"`
+	Duels struct {
+		Total flexFloat `json:"total"`
+		Won   flexFloat `json:"won"`
+	} `json:"duels"`
+	Dribbles struct {
+		Attempts flexFloat `json:"attempts"`
+		Success  flexFloat `json:"success"`
+	} `json:"dribbles"`
+	Fouls struct {
+		Drawn     flexFloat `json:"drawn"`
+		Committed flexFloat `json:"committed"`
+	} `json:"fouls"`
+	Cards struct {
+		Yellow flexFloat `json:"yellow"`
+		Red    flexFloat `json:"red"`
+	} `json:"cards"`
+	Penalty struct {
+		Won      flexFloat `json:"won"`
+		Commited flexFloat `json:"commited"`
+		Scored   flexFloat `json:"scored"`
+		Missed   flexFloat `json:"missed"`
+		Saved    flexFloat `json:"saved"`
+	} `json:"penalty"`
+}
+
+type standingsItem struct {
+	League struct {
+		ID        int64           `json:"id"`
+		Season    int             `json:"season"`
+		Standings [][]standingRow `json:"standings"`
+	} `json:"league"`
+}
+
+type standingRow struct {
+	Rank        int     `json:"rank"`
+	Team        teamRef `json:"team"`
+	Points      int     `json:"points"`
+	GoalsDiff   int     `json:"goalsDiff"`
+	Group       string  `json:"group"`
+	Form        string  `json:"form"`
+	Description string  `json:"description"`
+	All         struct {
+		Played int `json:"played"`
+		Win    int `json:"win"`
+		Draw   int `json:"draw"`
+		Lose   int `json:"lose"`
+		Goals  struct {
+			For     int `json:"for"`
+			Against int `json:"against"`
+		} `json:"goals"`
+	} `json:"all"`
+	Update string `json:"update"`
+}
+
+type leagueSeasonsItem struct {
+	League  leagueRef `json:"league"`
+	Seasons []struct {
+		Year    int  `json:"year"`
+		Current bool `json:"current"`
+	} `json:"seasons"`
+}
+
+type topPlayerItem struct {
+	Player struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Nationality string `json:"nationality"`
+		Photo       string `json:"photo"`
+	} `json:"player"`
+	Statistics []struct {
+		Team   teamRef   `json:"team"`
+		League leagueRef `json:"league"`
+		Games  struct {
+			Position string `json:"position"`
+		} `json:"games"`
+		Goals struct {
+			Total   flexFloat `json:"total"`
+			Assists flexFloat `json:"assists"`
+		} `json:"goals"`
+		Cards struct {
+			Yellow flexFloat `json:"yellow"`
+			Red    flexFloat `json:"red"`
+		} `json:"cards"`
+	} `json:"statistics"`
+}
@@ -284,6 +284,14 @@ func truncateForLog(value string, max int) string {
 	return value[:max] + "...(truncated)"
 }
 
+// CircuitState reports the breaker guarding the QStash publish endpoint.
+func (p *QStashPublisher) CircuitState() resilience.CircuitState {
+	if !p.circuitEnabled || p.breaker == nil {
+		return resilience.CircuitStateDisabled
+	}
+	return p.breaker.State()
+}
+
 func (p *QStashPublisher) recordCircuitResult(err error) {
 	if !p.circuitEnabled || p.breaker == nil {
 		return
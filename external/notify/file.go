@@ -0,0 +1,60 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+)
+
+// FileSink appends every message as a JSON line to a local file, or logs it
+// when no path is set. It is meant for local runs and tests.
+type FileSink struct {
+	path   string
+	logger *logging.Logger
+	mu     sync.Mutex
+	now    func() time.Time
+}
+
+func NewFileSink(path string, logger *logging.Logger) *FileSink {
+	if logger == nil {
+		logger = logging.Default()
+	}
+	return &FileSink{path: path, logger: logger, now: time.Now}
+}
+
+func (s *FileSink) Channel() notification.Channel {
+	return notification.ChannelFile
+}
+
+func (s *FileSink) Notify(ctx context.Context, _ onboarding.NotificationPreferences, msg notification.Message) error {
+	line, err := sonic.Marshal(newPayload(msg, s.now()))
+	if err != nil {
+		return fmt.Errorf("marshal notification: %w", err)
+	}
+	if s.path == "" {
+		s.logger.InfoContext(ctx, "notification", "user_id", msg.UserID, "event", string(msg.Event), "payload", string(line))
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open notification file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write notification file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Package notify holds the delivery channels of usecase.Notifier: SMTP email,
+// web push, outbound webhooks and a local file sink for testing.
+package notify
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// payload is the JSON document sent by the webhook channel and written by the
+// file sink.
+type payload struct {
+	Event    string         `json:"event"`
+	UserID   string         `json:"user_id"`
+	Title    string         `json:"title"`
+	Body     string         `json:"body"`
+	Data     map[string]any `json:"data,omitempty"`
+	DedupKey string         `json:"dedup_key"`
+	SentAt   string         `json:"sent_at"`
+}
+
+func newPayload(msg notification.Message, now time.Time) payload {
+	return payload{
+		Event:    string(msg.Event),
+		UserID:   msg.UserID,
+		Title:    msg.Title,
+		Body:     msg.Body,
+		Data:     msg.Data,
+		DedupKey: msg.DedupKey,
+		SentAt:   now.UTC().Format(time.RFC3339),
+	}
+}
+
+func newHTTPClient(timeout time.Duration) *http.Client {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
+	}
+}
@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+)
+
+const (
+	webhookSignatureHeader = "X-Fantasy-Signature"
+	webhookEventHeader     = "X-Fantasy-Event"
+)
+
+type WebhookConfig struct {
+	// Secret signs each body with HMAC-SHA256 so receivers can verify it.
+	Secret  string
+	Timeout time.Duration
+}
+
+// Webhook posts messages as JSON to the URL in the recipient preferences.
+type Webhook struct {
+	client *http.Client
+	secret []byte
+	now    func() time.Time
+}
+
+func NewWebhook(cfg WebhookConfig) *Webhook {
+	return &Webhook{
+		client: newHTTPClient(cfg.Timeout),
+		secret: []byte(strings.TrimSpace(cfg.Secret)),
+		now:    time.Now,
+	}
+}
+
+func (w *Webhook) Channel() notification.Channel {
+	return notification.ChannelWebhook
+}
+
+func (w *Webhook) Notify(ctx context.Context, recipient onboarding.NotificationPreferences, msg notification.Message) error {
+	body, err := sonic.Marshal(newPayload(msg, w.now()))
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, recipient.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, string(msg.Event))
+	if len(w.secret) > 0 {
+		req.Header.Set(webhookSignatureHeader, "sha256="+signWebhookBody(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post webhook: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode/100 != 2 {
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("post webhook status=%d body=%s", resp.StatusCode, strings.TrimSpace(string(raw)))
+	}
+	return nil
+}
+
+func signWebhookBody(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+)
+
+func TestWebhookNotify_SignsBody(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if got, want := r.Header.Get(webhookSignatureHeader), "sha256="+signWebhookBody([]byte("s3cret"), body); got != want {
+			t.Errorf("unexpected signature: got %s want %s", got, want)
+		}
+		if r.Header.Get(webhookEventHeader) != string(notification.EventGameweekFinalized) {
+			t.Errorf("unexpected event header: %s", r.Header.Get(webhookEventHeader))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	hook := NewWebhook(WebhookConfig{Secret: "s3cret"})
+	err := hook.Notify(context.Background(), onboarding.NotificationPreferences{WebhookURL: srv.URL}, notification.Message{
+		UserID: "u-1",
+		Event:  notification.EventGameweekFinalized,
+		Title:  "Gameweek 4 is final",
+	})
+	if err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer failing.Close()
+	if err := hook.Notify(context.Background(), onboarding.NotificationPreferences{WebhookURL: failing.URL}, notification.Message{}); err == nil {
+		t.Fatalf("expected error for non-2xx response")
+	}
+}
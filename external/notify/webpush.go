@@ -0,0 +1,230 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+)
+
+const (
+	webPushTTL        = 24 * time.Hour
+	webPushTokenTTL   = 12 * time.Hour
+	webPushRecordSize = 4096
+)
+
+type WebPushConfig struct {
+	// VAPIDPrivateKey is the base64url encoded P-256 private scalar.
+	VAPIDPrivateKey string
+	// VAPIDSubject is a mailto: or https: contact of the sender.
+	VAPIDSubject string
+	Timeout      time.Duration
+}
+
+// WebPush delivers messages to browser push subscriptions, signed with VAPID
+// (RFC 8292) and encrypted with aes128gcm (RFC 8291).
+type WebPush struct {
+	client     *http.Client
+	privateKey *ecdsa.PrivateKey
+	publicKey  []byte
+	subject    string
+	now        func() time.Time
+}
+
+func NewWebPush(cfg WebPushConfig) (*WebPush, error) {
+	raw, err := decodeBase64URL(cfg.VAPIDPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode vapid private key: %w", err)
+	}
+	privateKey, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse vapid private key: %w", err)
+	}
+	publicKey, err := privateKey.PublicKey.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("encode vapid public key: %w", err)
+	}
+	subject := strings.TrimSpace(cfg.VAPIDSubject)
+	if subject == "" {
+		return nil, fmt.Errorf("vapid subject is required")
+	}
+
+	return &WebPush{
+		client:     newHTTPClient(cfg.Timeout),
+		privateKey: privateKey,
+		publicKey:  publicKey,
+		subject:    subject,
+		now:        time.Now,
+	}, nil
+}
+
+func (p *WebPush) Channel() notification.Channel {
+	return notification.ChannelPush
+}
+
+// PublicKey is the application server key browsers subscribe with.
+func (p *WebPush) PublicKey() string {
+	return base64.RawURLEncoding.EncodeToString(p.publicKey)
+}
+
+func (p *WebPush) Notify(ctx context.Context, recipient onboarding.NotificationPreferences, msg notification.Message) error {
+	sub := recipient.PushSubscription
+	body, err := sonic.Marshal(newPayload(msg, p.now()))
+	if err != nil {
+		return fmt.Errorf("marshal push payload: %w", err)
+	}
+	encrypted, err := encryptWebPush(sub, body)
+	if err != nil {
+		return err
+	}
+	token, err := p.vapidToken(sub.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(encrypted))
+	if err != nil {
+		return fmt.Errorf("create push request: %w", err)
+	}
+	req.Header.Set("Authorization", "vapid t="+token+", k="+p.PublicKey())
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(webPushTTL.Seconds())))
+	req.Header.Set("Urgency", "normal")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post push message: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode/100 != 2 {
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("post push message status=%d body=%s", resp.StatusCode, strings.TrimSpace(string(raw)))
+	}
+	return nil
+}
+
+// vapidToken signs an ES256 JWT for the origin of the push service.
+func (p *WebPush) vapidToken(endpoint string) (string, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", fmt.Errorf("invalid push endpoint")
+	}
+
+	header, err := sonic.Marshal(map[string]string{"typ": "JWT", "alg": "ES256"})
+	if err != nil {
+		return "", fmt.Errorf("marshal vapid header: %w", err)
+	}
+	claims, err := sonic.Marshal(map[string]any{
+		"aud": parsed.Scheme + "://" + parsed.Host,
+		"exp": p.now().Add(webPushTokenTTL).Unix(),
+		"sub": p.subject,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal vapid claims: %w", err)
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, p.privateKey, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("sign vapid token: %w", err)
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// encryptWebPush encrypts body for one subscription as a single aes128gcm
+// record.
+func encryptWebPush(sub onboarding.PushSubscription, body []byte) ([]byte, error) {
+	clientKeyRaw, err := decodeBase64URL(sub.P256DH)
+	if err != nil {
+		return nil, fmt.Errorf("decode push p256dh: %w", err)
+	}
+	authSecret, err := decodeBase64URL(sub.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("decode push auth: %w", err)
+	}
+	clientKey, err := ecdh.P256().NewPublicKey(clientKeyRaw)
+	if err != nil {
+		return nil, fmt.Errorf("parse push p256dh: %w", err)
+	}
+
+	serverKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate push key: %w", err)
+	}
+	sharedSecret, err := serverKey.ECDH(clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("derive push shared secret: %w", err)
+	}
+	serverPublic := serverKey.PublicKey().Bytes()
+
+	keyInfo := "WebPush: info\x00" + string(clientKeyRaw) + string(serverPublic)
+	ikm, err := hkdf.Key(sha256.New, sharedSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, fmt.Errorf("derive push input key: %w", err)
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generate push salt: %w", err)
+	}
+	contentKey, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, fmt.Errorf("derive push content key: %w", err)
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, fmt.Errorf("derive push nonce: %w", err)
+	}
+
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, fmt.Errorf("create push cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create push gcm: %w", err)
+	}
+	// 0x02 marks the last (and only) record.
+	plaintext := append(append([]byte{}, body...), 0x02)
+	if len(plaintext)+gcm.Overhead() > webPushRecordSize {
+		return nil, fmt.Errorf("push payload too large")
+	}
+
+	out := make([]byte, 0, 16+4+1+len(serverPublic)+len(plaintext)+gcm.Overhead())
+	out = append(out, salt...)
+	out = binary.BigEndian.AppendUint32(out, webPushRecordSize)
+	out = append(out, byte(len(serverPublic)))
+	out = append(out, serverPublic...)
+	return gcm.Seal(out, nonce, plaintext, nil), nil
+}
+
+func decodeBase64URL(value string) ([]byte, error) {
+	value = strings.TrimRight(strings.TrimSpace(value), "=")
+	return base64.RawURLEncoding.DecodeString(value)
+}
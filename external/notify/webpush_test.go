@@ -0,0 +1,146 @@
+package notify
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+)
+
+func TestWebPushNotify_SignsAndEncryptsForSubscription(t *testing.T) {
+	t.Parallel()
+
+	vapidKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate vapid key: %v", err)
+	}
+	vapidRaw, err := vapidKey.Bytes()
+	if err != nil {
+		t.Fatalf("encode vapid key: %v", err)
+	}
+	clientKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate client key: %v", err)
+	}
+	authSecret := make([]byte, 16)
+	_, _ = rand.Read(authSecret)
+
+	var got []byte
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "aes128gcm" {
+			t.Errorf("unexpected content encoding: %s", r.Header.Get("Content-Encoding"))
+		}
+		authorization = r.Header.Get("Authorization")
+		got, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	pusher, err := NewWebPush(WebPushConfig{
+		VAPIDPrivateKey: base64.RawURLEncoding.EncodeToString(vapidRaw),
+		VAPIDSubject:    "mailto:ops@example.com",
+	})
+	if err != nil {
+		t.Fatalf("new web push: %v", err)
+	}
+
+	recipient := onboarding.NotificationPreferences{
+		UserID:      "u-1",
+		PushEnabled: true,
+		PushSubscription: onboarding.PushSubscription{
+			Endpoint: srv.URL + "/push/abc",
+			P256DH:   base64.RawURLEncoding.EncodeToString(clientKey.PublicKey().Bytes()),
+			Auth:     base64.RawURLEncoding.EncodeToString(authSecret),
+		},
+	}
+	msg := notification.Message{UserID: "u-1", Event: notification.EventDeadline1h, Title: "Deadline", Body: "Gameweek 3 locks soon."}
+	if err := pusher.Notify(context.Background(), recipient, msg); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+
+	token, key, ok := strings.Cut(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+	if !ok || key != pusher.PublicKey() {
+		t.Fatalf("unexpected authorization header: %s", authorization)
+	}
+	verifyVAPIDToken(t, token, &vapidKey.PublicKey)
+
+	plaintext := decryptWebPush(t, got, clientKey, authSecret)
+	var decoded payload
+	if err := sonic.Unmarshal(plaintext, &decoded); err != nil {
+		t.Fatalf("decode push payload: %v", err)
+	}
+	if decoded.Title != msg.Title || decoded.Event != string(msg.Event) {
+		t.Fatalf("unexpected push payload: %+v", decoded)
+	}
+}
+
+func verifyVAPIDToken(t *testing.T, token string, key *ecdsa.PublicKey) {
+	t.Helper()
+
+	idx := strings.LastIndex(token, ".")
+	signature, err := base64.RawURLEncoding.DecodeString(token[idx+1:])
+	if err != nil || len(signature) != 64 {
+		t.Fatalf("invalid vapid signature: %v", err)
+	}
+	digest := sha256.Sum256([]byte(token[:idx]))
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		t.Fatalf("vapid signature does not verify")
+	}
+}
+
+// decryptWebPush is the user agent side of RFC 8291.
+func decryptWebPush(t *testing.T, body []byte, clientKey *ecdh.PrivateKey, authSecret []byte) []byte {
+	t.Helper()
+
+	salt := body[:16]
+	if rs := binary.BigEndian.Uint32(body[16:20]); rs != webPushRecordSize {
+		t.Fatalf("unexpected record size: %d", rs)
+	}
+	keyLen := int(body[20])
+	serverPublicRaw := body[21 : 21+keyLen]
+	ciphertext := body[21+keyLen:]
+
+	serverPublic, err := ecdh.P256().NewPublicKey(serverPublicRaw)
+	if err != nil {
+		t.Fatalf("parse server key: %v", err)
+	}
+	shared, err := clientKey.ECDH(serverPublic)
+	if err != nil {
+		t.Fatalf("derive shared secret: %v", err)
+	}
+	keyInfo := "WebPush: info\x00" + string(clientKey.PublicKey().Bytes()) + string(serverPublicRaw)
+	ikm, _ := hkdf.Key(sha256.New, shared, authSecret, keyInfo, 32)
+	contentKey, _ := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	nonce, _ := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+
+	block, _ := aes.NewCipher(contentKey)
+	gcm, _ := cipher.NewGCM(block)
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		t.Fatalf("decrypt push body: %v", err)
+	}
+	if plaintext[len(plaintext)-1] != 0x02 {
+		t.Fatalf("missing last record delimiter")
+	}
+	return plaintext[:len(plaintext)-1]
+}
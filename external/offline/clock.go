@@ -0,0 +1,77 @@
+package offline
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock maps wall-clock time onto the dataset timeline. The simulated time
+// starts at simStart when the clock is created and advances speed times faster
+// than the wall clock. Advance jumps the simulated time forward on demand.
+type Clock struct {
+	mu        sync.RWMutex
+	wallNow   func() time.Time
+	wallStart time.Time
+	simStart  time.Time
+	speed     float64
+	offset    time.Duration
+}
+
+func NewClock(simStart time.Time, speed float64) *Clock {
+	return newClock(simStart, speed, time.Now)
+}
+
+func newClock(simStart time.Time, speed float64, wallNow func() time.Time) *Clock {
+	if wallNow == nil {
+		wallNow = time.Now
+	}
+	if speed <= 0 {
+		speed = 1
+	}
+	wallStart := wallNow().UTC()
+	if simStart.IsZero() {
+		simStart = wallStart
+	}
+
+	return &Clock{
+		wallNow:   wallNow,
+		wallStart: wallStart,
+		simStart:  simStart.UTC(),
+		speed:     speed,
+	}
+}
+
+// Now returns the current simulated time.
+func (c *Clock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	elapsed := c.wallNow().UTC().Sub(c.wallStart)
+	return c.simStart.Add(c.offset + time.Duration(float64(elapsed)*c.speed))
+}
+
+// Advance moves the simulated time forward by d.
+func (c *Clock) Advance(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset += d
+}
+
+// ToWall converts a simulated timestamp to the wall-clock time at which the
+// clock reaches it, so kickoff-based deadlines and locks line up with what
+// the provider reveals.
+func (c *Clock) ToWall(sim time.Time) time.Time {
+	if sim.IsZero() {
+		return sim
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	simElapsed := sim.UTC().Sub(c.simStart) - c.offset
+	return c.wallStart.Add(time.Duration(float64(simElapsed) / c.speed))
+}
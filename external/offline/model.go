@@ -0,0 +1,284 @@
+package offline
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+)
+
+// Dataset file names, without extension. Each may be stored as .json (an
+// array of objects) or .csv (a header row followed by values); the json tags
+// of the record types double as CSV column names.
+const (
+	teamsFile       = "teams"
+	playersFile     = "players"
+	fixturesFile    = "fixtures"
+	playerStatsFile = "player_stats"
+	eventsFile      = "events"
+	standingsFile   = "standings"
+)
+
+type teamRecord struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Short    string `json:"short"`
+	ImageURL string `json:"image_url"`
+}
+
+type playerRecord struct {
+	ID       int64  `json:"id"`
+	TeamID   int64  `json:"team_id"`
+	Name     string `json:"name"`
+	Position string `json:"position"`
+	ImageURL string `json:"image_url"`
+	Price    int64  `json:"price"`
+}
+
+// fixtureRecord holds the final state of a fixture. Scores are revealed once
+// the simulated clock passes full time; Status only overrides the timeline for
+// postponed or cancelled fixtures.
+type fixtureRecord struct {
+	ID         int64     `json:"id"`
+	SeasonID   int64     `json:"season_id"`
+	Gameweek   int       `json:"gameweek"`
+	HomeTeamID int64     `json:"home_team_id"`
+	AwayTeamID int64     `json:"away_team_id"`
+	KickoffAt  time.Time `json:"kickoff_at"`
+	Venue      string    `json:"venue"`
+	Status     string    `json:"status"`
+	HomeScore  *int      `json:"home_score"`
+	AwayScore  *int      `json:"away_score"`
+}
+
+// playerStatRecord is a snapshot of a player's fixture stats at match minute
+// Minute. Rows without a minute describe the full-time line.
+type playerStatRecord struct {
+	FixtureID       int64  `json:"fixture_id"`
+	PlayerID        int64  `json:"player_id"`
+	TeamID          int64  `json:"team_id"`
+	Minute          int    `json:"minute"`
+	Position        string `json:"position"`
+	MinutesPlayed   int    `json:"minutes_played"`
+	Goals           int    `json:"goals"`
+	Assists         int    `json:"assists"`
+	CleanSheet      bool   `json:"clean_sheet"`
+	GoalsConceded   int    `json:"goals_conceded"`
+	OwnGoals        int    `json:"own_goals"`
+	PenaltiesSaved  int    `json:"penalties_saved"`
+	PenaltiesMissed int    `json:"penalties_missed"`
+	YellowCards     int    `json:"yellow_cards"`
+	RedCards        int    `json:"red_cards"`
+	Saves           int    `json:"saves"`
+	BPS             int    `json:"bps"`
+	BonusPoints     int    `json:"bonus_points"`
+	FantasyPoints   *int   `json:"fantasy_points"`
+}
+
+// eventRecord uses the SportMonks developer names for Type. For own goals,
+// TeamID is the team of the player who scored into their own net.
+type eventRecord struct {
+	ID             int64  `json:"id"`
+	FixtureID      int64  `json:"fixture_id"`
+	TeamID         int64  `json:"team_id"`
+	PlayerID       int64  `json:"player_id"`
+	AssistPlayerID int64  `json:"assist_player_id"`
+	Type           string `json:"type"`
+	Detail         string `json:"detail"`
+	Minute         int    `json:"minute"`
+	ExtraMinute    int    `json:"extra_minute"`
+}
+
+type standingRecord struct {
+	SeasonID       int64  `json:"season_id"`
+	TeamID         int64  `json:"team_id"`
+	Position       int    `json:"position"`
+	Played         int    `json:"played"`
+	Won            int    `json:"won"`
+	Draw           int    `json:"draw"`
+	Lost           int    `json:"lost"`
+	GoalsFor       int    `json:"goals_for"`
+	GoalsAgainst   int    `json:"goals_against"`
+	GoalDifference int    `json:"goal_difference"`
+	Points         int    `json:"points"`
+	Form           string `json:"form"`
+}
+
+// dataset is one league directory loaded from disk.
+type dataset struct {
+	teams       []teamRecord
+	players     []playerRecord
+	fixtures    []fixtureRecord
+	playerStats []playerStatRecord
+	events      []eventRecord
+	standings   []standingRecord
+}
+
+func loadDataset(dir string) (dataset, error) {
+	var (
+		out dataset
+		err error
+	)
+	if out.teams, err = loadRecords[teamRecord](dir, teamsFile); err != nil {
+		return dataset{}, err
+	}
+	if out.players, err = loadRecords[playerRecord](dir, playersFile); err != nil {
+		return dataset{}, err
+	}
+	if out.fixtures, err = loadRecords[fixtureRecord](dir, fixturesFile); err != nil {
+		return dataset{}, err
+	}
+	if out.playerStats, err = loadRecords[playerStatRecord](dir, playerStatsFile); err != nil {
+		return dataset{}, err
+	}
+	if out.events, err = loadRecords[eventRecord](dir, eventsFile); err != nil {
+		return dataset{}, err
+	}
+	if out.standings, err = loadRecords[standingRecord](dir, standingsFile); err != nil {
+		return dataset{}, err
+	}
+	return out, nil
+}
+
+// loadRecords reads <dir>/<name>.json or <dir>/<name>.csv. A missing file is
+// an empty dataset so partial directories still work.
+func loadRecords[T any](dir, name string) ([]T, error) {
+	jsonPath := filepath.Join(dir, name+".json")
+	raw, err := os.ReadFile(jsonPath)
+	if err == nil {
+		var out []T
+		if err := sonic.Unmarshal(raw, &out); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", jsonPath, err)
+		}
+		return out, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("read %s: %w", jsonPath, err)
+	}
+
+	csvPath := filepath.Join(dir, name+".csv")
+	raw, err = os.ReadFile(csvPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", csvPath, err)
+	}
+	out, err := decodeCSV[T](bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", csvPath, err)
+	}
+	return out, nil
+}
+
+// decodeCSV maps header columns onto struct fields by json tag.
+func decodeCSV[T any](r io.Reader) ([]T, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	recordType := reflect.TypeOf((*T)(nil)).Elem()
+	fieldByTag := make(map[string]int, recordType.NumField())
+	for idx := 0; idx < recordType.NumField(); idx++ {
+		tag := strings.Split(recordType.Field(idx).Tag.Get("json"), ",")[0]
+		if tag != "" && tag != "-" {
+			fieldByTag[tag] = idx
+		}
+	}
+	columns := make([]int, len(header))
+	for idx, name := range header {
+		fieldIdx, ok := fieldByTag[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			fieldIdx = -1
+		}
+		columns[idx] = fieldIdx
+	}
+
+	var out []T
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var item T
+		value := reflect.ValueOf(&item).Elem()
+		for idx, cell := range row {
+			if idx >= len(columns) || columns[idx] < 0 {
+				continue
+			}
+			if err := setField(value.Field(columns[idx]), strings.TrimSpace(cell)); err != nil {
+				return nil, fmt.Errorf("line %d column %q: %w", line, header[idx], err)
+			}
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func setField(field reflect.Value, cell string) error {
+	if cell == "" {
+		return nil
+	}
+
+	switch field.Interface().(type) {
+	case time.Time:
+		parsed, err := time.Parse(time.RFC3339, cell)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(parsed.UTC()))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.Pointer:
+		ptr := reflect.New(field.Type().Elem())
+		if err := setField(ptr.Elem(), cell); err != nil {
+			return err
+		}
+		field.Set(ptr)
+	case reflect.String:
+		field.SetString(cell)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(cell)
+		if err != nil {
+			return err
+		}
+		field.SetBool(parsed)
+	case reflect.Int, reflect.Int64:
+		parsed, err := strconv.ParseInt(cell, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(parsed)
+	case reflect.Float64:
+		parsed, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(parsed)
+	default:
+		return fmt.Errorf("unsupported field kind %s", field.Kind())
+	}
+	return nil
+}
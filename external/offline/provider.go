@@ -0,0 +1,696 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/rawdata"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+// DefaultReferenceID is used as the season and league reference for offline
+// leagues that have no SportMonks ids configured. Fixture and standing rows
+// without a season_id match every season.
+const DefaultReferenceID int64 = 1
+
+// Match timeline on the simulated clock: first half, a 15 minute break,
+// second half and a few minutes of stoppage before full time.
+const (
+	halfTimeMinute = 45
+	halfTimeBreak  = 15
+	fullTimeAfter  = 110 * time.Minute
+	maxFormResults = 5
+)
+
+var topScorerTypeNames = map[int]string{
+	208: "Goal Topscorer",
+	209: "Assist Topscorer",
+	83:  "Redcards",
+	84:  "Yellowcards",
+}
+
+type ProviderConfig struct {
+	Dir    string
+	Clock  *Clock
+	Logger *logging.Logger
+}
+
+// Provider implements usecase.SportDataSyncProvider on top of a directory of
+// JSON/CSV files. Fixtures, events and player stats are revealed as the
+// simulated clock moves through each match.
+type Provider struct {
+	dir    string
+	clock  *Clock
+	logger *logging.Logger
+}
+
+func NewProvider(cfg ProviderConfig) *Provider {
+	if cfg.Clock == nil {
+		cfg.Clock = NewClock(time.Time{}, 1)
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = logging.Default()
+	}
+
+	return &Provider{
+		dir:    strings.TrimSpace(cfg.Dir),
+		clock:  cfg.Clock,
+		logger: cfg.Logger,
+	}
+}
+
+// fixtureView is a fixture as visible at the current simulated time.
+type fixtureView struct {
+	record    fixtureRecord
+	status    string
+	minute    int
+	homeScore *int
+	awayScore *int
+}
+
+func (p *Provider) FetchFixtureBundleBySeason(ctx context.Context, seasonID int64) (usecase.ExternalFixtureBundle, error) {
+	data, err := p.load(ctx)
+	if err != nil {
+		return usecase.ExternalFixtureBundle{}, err
+	}
+
+	now := p.clock.Now()
+	views := p.fixtureViews(data, seasonID, now)
+	teams := teamsByID(data.teams)
+
+	out := usecase.ExternalFixtureBundle{
+		Fixtures: make([]usecase.ExternalFixture, 0, len(views)),
+		Teams:    make([]usecase.ExternalTeam, 0, len(data.teams)),
+		Players:  make([]usecase.ExternalPlayer, 0, len(data.players)),
+	}
+	viewByID := make(map[int64]fixtureView, len(views))
+	for _, view := range views {
+		viewByID[view.record.ID] = view
+		out.Fixtures = append(out.Fixtures, p.mapFixture(view, teams))
+	}
+	for _, item := range data.teams {
+		if item.ID <= 0 {
+			continue
+		}
+		out.Teams = append(out.Teams, usecase.ExternalTeam{
+			ExternalID: item.ID,
+			Name:       strings.TrimSpace(item.Name),
+			Short:      strings.TrimSpace(item.Short),
+			ImageURL:   strings.TrimSpace(item.ImageURL),
+		})
+	}
+	for _, item := range data.players {
+		if item.ID <= 0 {
+			continue
+		}
+		out.Players = append(out.Players, usecase.ExternalPlayer{
+			ExternalID:     item.ID,
+			TeamExternalID: item.TeamID,
+			Name:           strings.TrimSpace(item.Name),
+			Position:       normalizePosition(item.Position),
+			ImageURL:       strings.TrimSpace(item.ImageURL),
+			Price:          item.Price,
+		})
+	}
+	out.Events = revealEvents(data.events, viewByID)
+	out.PlayerStats = revealPlayerStats(data.playerStats, viewByID)
+
+	payload, err := buildSnapshotPayload(fmt.Sprintf("fixtures?season_id=%d", seasonID), now, out.Fixtures)
+	if err != nil {
+		return usecase.ExternalFixtureBundle{}, err
+	}
+	out.RawPayloads = []rawdata.Payload{payload}
+
+	p.logger.DebugContext(ctx, "offline fixture bundle revealed",
+		"dir", p.dir,
+		"season_id", seasonID,
+		"simulated_at", now,
+		"fixtures", len(out.Fixtures),
+		"events", len(out.Events),
+		"player_stats", len(out.PlayerStats),
+	)
+	return out, nil
+}
+
+func (p *Provider) FetchFixturesBySeason(ctx context.Context, seasonID int64) ([]usecase.ExternalFixture, []rawdata.Payload, error) {
+	bundle, err := p.FetchFixtureBundleBySeason(ctx, seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bundle.Fixtures, bundle.RawPayloads, nil
+}
+
+func (p *Provider) FetchStandingsBySeason(ctx context.Context, seasonID int64) ([]usecase.ExternalStanding, []rawdata.Payload, error) {
+	return p.standings(ctx, seasonID, false)
+}
+
+// FetchLiveStandingsByLeague includes fixtures in progress for the latest
+// season in the dataset. The league reference is ignored because a dataset
+// directory holds a single league.
+func (p *Provider) FetchLiveStandingsByLeague(ctx context.Context, _ int64) ([]usecase.ExternalStanding, []rawdata.Payload, error) {
+	return p.standings(ctx, 0, true)
+}
+
+func (p *Provider) FetchStatisticTypes(ctx context.Context) ([]usecase.ExternalStatType, []rawdata.Payload, error) {
+	return nil, nil, ctx.Err()
+}
+
+func (p *Provider) FetchTeamStatisticsBySeason(ctx context.Context, _ int64) ([]usecase.ExternalTeamStatValue, []rawdata.Payload, error) {
+	return nil, nil, ctx.Err()
+}
+
+func (p *Provider) FetchPlayerStatisticsBySeason(ctx context.Context, _ int64) ([]usecase.ExternalPlayerStatValue, []rawdata.Payload, error) {
+	return nil, nil, ctx.Err()
+}
+
+// FetchTopScorersBySeasonID ranks players from the stats revealed so far. The
+// whole ranking is returned on the first page.
+func (p *Provider) FetchTopScorersBySeasonID(ctx context.Context, seasonID, page, typeId int) ([]usecase.ExternalTopScorers, bool, error) {
+	typeName, ok := topScorerTypeNames[typeId]
+	if !ok {
+		return nil, false, fmt.Errorf("unsupported top scorer type id=%d", typeId)
+	}
+	if page > 1 {
+		return nil, false, nil
+	}
+
+	data, err := p.load(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	views := p.fixtureViews(data, int64(seasonID), p.clock.Now())
+	viewByID := make(map[int64]fixtureView, len(views))
+	for _, view := range views {
+		viewByID[view.record.ID] = view
+	}
+
+	totals := make(map[int64]int)
+	for _, stat := range revealPlayerStats(data.playerStats, viewByID) {
+		value := 0
+		switch typeId {
+		case 208:
+			value = stat.Goals
+		case 209:
+			value = stat.Assists
+		case 83:
+			value = stat.RedCards
+		case 84:
+			value = stat.YellowCards
+		}
+		if value > 0 {
+			totals[stat.PlayerExternalID] += value
+		}
+	}
+
+	players := make(map[int64]playerRecord, len(data.players))
+	for _, item := range data.players {
+		players[item.ID] = item
+	}
+	teams := teamsByID(data.teams)
+
+	playerIDs := make([]int64, 0, len(totals))
+	for playerID := range totals {
+		playerIDs = append(playerIDs, playerID)
+	}
+	sort.Slice(playerIDs, func(i, j int) bool {
+		if totals[playerIDs[i]] != totals[playerIDs[j]] {
+			return totals[playerIDs[i]] > totals[playerIDs[j]]
+		}
+		return playerIDs[i] < playerIDs[j]
+	})
+
+	out := make([]usecase.ExternalTopScorers, 0, len(playerIDs))
+	for idx, playerID := range playerIDs {
+		player := players[playerID]
+		team := teams[player.TeamID]
+		out = append(out, usecase.ExternalTopScorers{
+			TypeID:           int64(typeId),
+			TypeName:         typeName,
+			Rank:             idx + 1,
+			Total:            totals[playerID],
+			PlayerID:         playerID,
+			Season:           strconv.Itoa(seasonID),
+			ParticipantID:    player.TeamID,
+			PlayerName:       strings.TrimSpace(player.Name),
+			ImagePlayer:      strings.TrimSpace(player.ImageURL),
+			ParticipantName:  strings.TrimSpace(team.Name),
+			ImageParticipant: strings.TrimSpace(team.ImageURL),
+			PositionName:     strings.ToLower(normalizePosition(player.Position)),
+		})
+	}
+	return out, false, nil
+}
+
+func (p *Provider) load(ctx context.Context) (dataset, error) {
+	if err := ctx.Err(); err != nil {
+		return dataset{}, err
+	}
+	if p.dir == "" {
+		return dataset{}, fmt.Errorf("%w: offline data directory is not configured", usecase.ErrDependencyUnavailable)
+	}
+
+	data, err := loadDataset(p.dir)
+	if err != nil {
+		return dataset{}, fmt.Errorf("load offline dataset dir=%s: %w", p.dir, err)
+	}
+	return data, nil
+}
+
+func (p *Provider) standings(ctx context.Context, seasonID int64, includeLive bool) ([]usecase.ExternalStanding, []rawdata.Payload, error) {
+	data, err := p.load(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if seasonID <= 0 {
+		seasonID = latestSeasonID(data)
+	}
+
+	now := p.clock.Now()
+	var out []usecase.ExternalStanding
+	if len(data.standings) > 0 {
+		out = mapStandingRecords(data.standings, seasonID, teamsByID(data.teams), p.clock.ToWall(now))
+	} else {
+		out = computeStandings(p.fixtureViews(data, seasonID, now), data.teams, includeLive, p.clock.ToWall(now))
+	}
+
+	payload, err := buildSnapshotPayload(fmt.Sprintf("standings?season_id=%d&live=%t", seasonID, includeLive), now, out)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, []rawdata.Payload{payload}, nil
+}
+
+func (p *Provider) fixtureViews(data dataset, seasonID int64, now time.Time) []fixtureView {
+	goalsByFixture := make(map[int64][]eventRecord)
+	for _, item := range data.events {
+		if isGoalEvent(item.Type) {
+			goalsByFixture[item.FixtureID] = append(goalsByFixture[item.FixtureID], item)
+		}
+	}
+
+	out := make([]fixtureView, 0, len(data.fixtures))
+	for _, item := range data.fixtures {
+		if item.ID <= 0 || !matchesSeason(item.SeasonID, seasonID) {
+			continue
+		}
+
+		view := fixtureView{record: item}
+		view.status, view.minute = fixturePhase(item, now)
+		switch view.status {
+		case "FINISHED":
+			if item.HomeScore != nil && item.AwayScore != nil {
+				view.homeScore, view.awayScore = item.HomeScore, item.AwayScore
+			} else {
+				view.homeScore, view.awayScore = scoreFromGoals(item, goalsByFixture[item.ID], view.minute)
+			}
+		case "LIVE":
+			view.homeScore, view.awayScore = scoreFromGoals(item, goalsByFixture[item.ID], view.minute)
+		}
+		out = append(out, view)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].record.KickoffAt.Equal(out[j].record.KickoffAt) {
+			return out[i].record.KickoffAt.Before(out[j].record.KickoffAt)
+		}
+		return out[i].record.ID < out[j].record.ID
+	})
+	return out
+}
+
+func (p *Provider) mapFixture(view fixtureView, teams map[int64]teamRecord) usecase.ExternalFixture {
+	item := view.record
+	out := usecase.ExternalFixture{
+		ExternalID:         item.ID,
+		Gameweek:           item.Gameweek,
+		HomeTeamName:       strings.TrimSpace(teams[item.HomeTeamID].Name),
+		AwayTeamName:       strings.TrimSpace(teams[item.AwayTeamID].Name),
+		HomeTeamExternalID: item.HomeTeamID,
+		AwayTeamExternalID: item.AwayTeamID,
+		KickoffAt:          p.clock.ToWall(item.KickoffAt),
+		Venue:              strings.TrimSpace(item.Venue),
+		Status:             view.status,
+		HomeScore:          view.homeScore,
+		AwayScore:          view.awayScore,
+	}
+	if view.status == "FINISHED" {
+		finishedAt := p.clock.ToWall(item.KickoffAt.Add(fullTimeAfter))
+		out.FinishedAt = &finishedAt
+		if view.homeScore != nil && view.awayScore != nil {
+			switch {
+			case *view.homeScore > *view.awayScore:
+				out.WinnerTeamExternalID = item.HomeTeamID
+			case *view.awayScore > *view.homeScore:
+				out.WinnerTeamExternalID = item.AwayTeamID
+			}
+		}
+	}
+	return out
+}
+
+// fixturePhase returns the fixture status at now and the match minute reached
+// so far. Stoppage time keeps the minute at 90 until full time.
+func fixturePhase(item fixtureRecord, now time.Time) (string, int) {
+	switch status := strings.ToUpper(strings.TrimSpace(item.Status)); status {
+	case "POSTPONED", "CANCELLED":
+		return status, 0
+	}
+	if item.KickoffAt.IsZero() || now.Before(item.KickoffAt) {
+		return "SCHEDULED", 0
+	}
+
+	elapsed := now.Sub(item.KickoffAt)
+	if elapsed >= fullTimeAfter {
+		return "FINISHED", 90
+	}
+
+	minute := int(elapsed / time.Minute)
+	switch {
+	case minute <= halfTimeMinute:
+	case minute <= halfTimeMinute+halfTimeBreak:
+		minute = halfTimeMinute
+	default:
+		minute -= halfTimeBreak
+	}
+	if minute > 90 {
+		minute = 90
+	}
+	return "LIVE", minute
+}
+
+func revealEvents(items []eventRecord, viewByID map[int64]fixtureView) []usecase.ExternalFixtureEvent {
+	out := make([]usecase.ExternalFixtureEvent, 0, len(items))
+	for idx, item := range items {
+		view, ok := viewByID[item.FixtureID]
+		if !ok || !eventRevealed(item, view) {
+			continue
+		}
+
+		eventID := item.ID
+		if eventID <= 0 {
+			eventID = item.FixtureID*10000 + int64(idx+1)
+		}
+		out = append(out, usecase.ExternalFixtureEvent{
+			EventExternalID:        eventID,
+			FixtureExternalID:      item.FixtureID,
+			TeamExternalID:         item.TeamID,
+			PlayerExternalID:       item.PlayerID,
+			AssistPlayerExternalID: item.AssistPlayerID,
+			EventType:              strings.ToUpper(strings.TrimSpace(item.Type)),
+			Detail:                 strings.TrimSpace(item.Detail),
+			Minute:                 item.Minute,
+			ExtraMinute:            item.ExtraMinute,
+		})
+	}
+	return out
+}
+
+func eventRevealed(item eventRecord, view fixtureView) bool {
+	switch view.status {
+	case "FINISHED":
+		return true
+	case "LIVE":
+		return item.Minute <= view.minute
+	default:
+		return false
+	}
+}
+
+// revealPlayerStats picks, per fixture and player, the latest snapshot whose
+// minute has been reached. Full-time rows only appear once the match ends.
+func revealPlayerStats(items []playerStatRecord, viewByID map[int64]fixtureView) []usecase.ExternalPlayerFixtureStat {
+	type statKey struct {
+		fixtureID int64
+		playerID  int64
+	}
+
+	latest := make(map[statKey]playerStatRecord)
+	order := make([]statKey, 0, len(items))
+	for _, item := range items {
+		view, ok := viewByID[item.FixtureID]
+		if !ok || item.PlayerID <= 0 || !snapshotRevealed(item, view) {
+			continue
+		}
+		key := statKey{fixtureID: item.FixtureID, playerID: item.PlayerID}
+		current, exists := latest[key]
+		if !exists {
+			order = append(order, key)
+			latest[key] = item
+			continue
+		}
+		if snapshotMinute(item) >= snapshotMinute(current) {
+			latest[key] = item
+		}
+	}
+
+	out := make([]usecase.ExternalPlayerFixtureStat, 0, len(order))
+	for _, key := range order {
+		item := latest[key]
+		stat := usecase.ExternalPlayerFixtureStat{
+			FixtureExternalID: item.FixtureID,
+			PlayerExternalID:  item.PlayerID,
+			TeamExternalID:    item.TeamID,
+			Position:          normalizePosition(item.Position),
+			MinutesPlayed:     item.MinutesPlayed,
+			Goals:             item.Goals,
+			Assists:           item.Assists,
+			CleanSheet:        item.CleanSheet,
+			GoalsConceded:     item.GoalsConceded,
+			OwnGoals:          item.OwnGoals,
+			PenaltiesSaved:    item.PenaltiesSaved,
+			PenaltiesMissed:   item.PenaltiesMissed,
+			YellowCards:       item.YellowCards,
+			RedCards:          item.RedCards,
+			Saves:             item.Saves,
+			BPS:               item.BPS,
+			BonusPoints:       item.BonusPoints,
+		}
+		if item.FantasyPoints != nil {
+			stat.FantasyPoints = *item.FantasyPoints
+		} else {
+			stat.FantasyPoints = usecase.EstimateFantasyPoints(stat)
+		}
+		out = append(out, stat)
+	}
+	return out
+}
+
+func snapshotRevealed(item playerStatRecord, view fixtureView) bool {
+	switch view.status {
+	case "FINISHED":
+		return true
+	case "LIVE":
+		return item.Minute > 0 && item.Minute <= view.minute
+	default:
+		return false
+	}
+}
+
+// snapshotMinute orders snapshots, treating rows without a minute as the
+// full-time line.
+func snapshotMinute(item playerStatRecord) int {
+	if item.Minute <= 0 {
+		return 1 << 30
+	}
+	return item.Minute
+}
+
+func scoreFromGoals(item fixtureRecord, goals []eventRecord, minute int) (*int, *int) {
+	home, away := 0, 0
+	for _, goal := range goals {
+		if goal.Minute > minute {
+			continue
+		}
+		forHome := goal.TeamID == item.HomeTeamID
+		if strings.EqualFold(strings.TrimSpace(goal.Type), "OWNGOAL") {
+			forHome = !forHome
+		}
+		if forHome {
+			home++
+		} else {
+			away++
+		}
+	}
+	return &home, &away
+}
+
+func isGoalEvent(eventType string) bool {
+	switch strings.ToUpper(strings.TrimSpace(eventType)) {
+	case "GOAL", "PENALTY", "OWNGOAL":
+		return true
+	default:
+		return false
+	}
+}
+
+func computeStandings(views []fixtureView, teams []teamRecord, includeLive bool, updatedAt time.Time) []usecase.ExternalStanding {
+	rows := make(map[int64]*usecase.ExternalStanding, len(teams))
+	for _, item := range teams {
+		if item.ID <= 0 {
+			continue
+		}
+		rows[item.ID] = &usecase.ExternalStanding{
+			TeamExternalID: item.ID,
+			TeamName:       strings.TrimSpace(item.Name),
+		}
+	}
+	row := func(teamID int64) *usecase.ExternalStanding {
+		if existing, ok := rows[teamID]; ok {
+			return existing
+		}
+		created := &usecase.ExternalStanding{TeamExternalID: teamID}
+		rows[teamID] = created
+		return created
+	}
+
+	for _, view := range views {
+		if view.homeScore == nil || view.awayScore == nil {
+			continue
+		}
+		if view.status != "FINISHED" && !(includeLive && view.status == "LIVE") {
+			continue
+		}
+		applyResult(row(view.record.HomeTeamID), *view.homeScore, *view.awayScore)
+		applyResult(row(view.record.AwayTeamID), *view.awayScore, *view.homeScore)
+	}
+
+	out := make([]usecase.ExternalStanding, 0, len(rows))
+	for _, item := range rows {
+		item.GoalDifference = item.GoalsFor - item.GoalsAgainst
+		item.SourceUpdatedAt = &updatedAt
+		out = append(out, *item)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Points != out[j].Points {
+			return out[i].Points > out[j].Points
+		}
+		if out[i].GoalDifference != out[j].GoalDifference {
+			return out[i].GoalDifference > out[j].GoalDifference
+		}
+		if out[i].GoalsFor != out[j].GoalsFor {
+			return out[i].GoalsFor > out[j].GoalsFor
+		}
+		return out[i].TeamName < out[j].TeamName
+	})
+	for idx := range out {
+		out[idx].Position = idx + 1
+	}
+	return out
+}
+
+func applyResult(row *usecase.ExternalStanding, scored, conceded int) {
+	row.Played++
+	row.GoalsFor += scored
+	row.GoalsAgainst += conceded
+
+	result := "D"
+	switch {
+	case scored > conceded:
+		row.Won++
+		row.Points += 3
+		result = "W"
+	case scored < conceded:
+		row.Lost++
+		result = "L"
+	default:
+		row.Draw++
+		row.Points++
+	}
+	row.Form += result
+	if len(row.Form) > maxFormResults {
+		row.Form = row.Form[len(row.Form)-maxFormResults:]
+	}
+}
+
+func mapStandingRecords(items []standingRecord, seasonID int64, teams map[int64]teamRecord, updatedAt time.Time) []usecase.ExternalStanding {
+	out := make([]usecase.ExternalStanding, 0, len(items))
+	for _, item := range items {
+		if item.TeamID <= 0 || !matchesSeason(item.SeasonID, seasonID) {
+			continue
+		}
+		out = append(out, usecase.ExternalStanding{
+			TeamExternalID:  item.TeamID,
+			TeamName:        strings.TrimSpace(teams[item.TeamID].Name),
+			Position:        item.Position,
+			Played:          item.Played,
+			Won:             item.Won,
+			Draw:            item.Draw,
+			Lost:            item.Lost,
+			GoalsFor:        item.GoalsFor,
+			GoalsAgainst:    item.GoalsAgainst,
+			GoalDifference:  item.GoalDifference,
+			Points:          item.Points,
+			Form:            strings.ToUpper(strings.TrimSpace(item.Form)),
+			SourceUpdatedAt: &updatedAt,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Position < out[j].Position
+	})
+	return out
+}
+
+func latestSeasonID(data dataset) int64 {
+	var out int64
+	for _, item := range data.fixtures {
+		if item.SeasonID > out {
+			out = item.SeasonID
+		}
+	}
+	for _, item := range data.standings {
+		if item.SeasonID > out {
+			out = item.SeasonID
+		}
+	}
+	return out
+}
+
+func matchesSeason(rowSeasonID, seasonID int64) bool {
+	return rowSeasonID <= 0 || seasonID <= 0 || rowSeasonID == seasonID
+}
+
+func teamsByID(items []teamRecord) map[int64]teamRecord {
+	out := make(map[int64]teamRecord, len(items))
+	for _, item := range items {
+		out[item.ID] = item
+	}
+	return out
+}
+
+func normalizePosition(value string) string {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "GK", "GOALKEEPER", "G":
+		return "GK"
+	case "DEF", "DEFENDER", "D":
+		return "DEF"
+	case "MID", "MIDFIELDER", "M":
+		return "MID"
+	case "FWD", "FORWARD", "ATTACKER", "F":
+		return "FWD"
+	default:
+		return strings.ToUpper(strings.TrimSpace(value))
+	}
+}
+
+func buildSnapshotPayload(entityKey string, simulatedAt time.Time, items any) (rawdata.Payload, error) {
+	raw, err := sonic.Marshal(map[string]any{
+		"simulated_at": simulatedAt.UTC().Format(time.RFC3339),
+		"items":        items,
+	})
+	if err != nil {
+		return rawdata.Payload{}, fmt.Errorf("encode offline snapshot %s: %w", entityKey, err)
+	}
+	return rawdata.Payload{
+		EntityType:  "offline_snapshot",
+		EntityKey:   entityKey,
+		PayloadJSON: string(raw),
+	}, nil
+}
@@ -0,0 +1,194 @@
+package offline
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+var testWallNow = time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestProvider(simStart time.Time) (*Provider, *Clock) {
+	clock := newClock(simStart, 1, func() time.Time { return testWallNow })
+	return NewProvider(ProviderConfig{
+		Dir:    "testdata/league",
+		Clock:  clock,
+		Logger: logging.NewNop(),
+	}), clock
+}
+
+func fixtureByID(items []usecase.ExternalFixture, id int64) usecase.ExternalFixture {
+	for _, item := range items {
+		if item.ExternalID == id {
+			return item
+		}
+	}
+	return usecase.ExternalFixture{}
+}
+
+func statsByPlayer(items []usecase.ExternalPlayerFixtureStat) map[int64]usecase.ExternalPlayerFixtureStat {
+	out := make(map[int64]usecase.ExternalPlayerFixtureStat, len(items))
+	for _, item := range items {
+		out[item.PlayerExternalID] = item
+	}
+	return out
+}
+
+func TestProviderFetchFixtureBundleBySeason_RevealsMatchAsClockAdvances(t *testing.T) {
+	t.Parallel()
+
+	provider, clock := newTestProvider(time.Date(2025, 8, 9, 12, 35, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	bundle, err := provider.FetchFixtureBundleBySeason(ctx, 2025)
+	if err != nil {
+		t.Fatalf("fetch bundle: %v", err)
+	}
+	if len(bundle.Fixtures) != 2 || len(bundle.Teams) != 2 || len(bundle.Players) != 4 || len(bundle.RawPayloads) != 1 {
+		t.Fatalf("unexpected bundle sizes: fixtures=%d teams=%d players=%d payloads=%d",
+			len(bundle.Fixtures), len(bundle.Teams), len(bundle.Players), len(bundle.RawPayloads))
+	}
+
+	live := fixtureByID(bundle.Fixtures, 9001)
+	if live.Status != "LIVE" || live.HomeScore == nil || *live.HomeScore != 1 || *live.AwayScore != 0 {
+		t.Fatalf("unexpected first-half fixture: %+v", live)
+	}
+	if want := testWallNow.Add(-35 * time.Minute); !live.KickoffAt.Equal(want) {
+		t.Fatalf("expected kickoff mapped to wall clock %s, got %s", want, live.KickoffAt)
+	}
+	if upcoming := fixtureByID(bundle.Fixtures, 9002); upcoming.Status != "SCHEDULED" || upcoming.HomeScore != nil {
+		t.Fatalf("unexpected upcoming fixture: %+v", upcoming)
+	}
+	if len(bundle.Events) != 1 || bundle.Events[0].EventType != "GOAL" {
+		t.Fatalf("expected only the opening goal, got %+v", bundle.Events)
+	}
+	stats := statsByPlayer(bundle.PlayerStats)
+	if len(stats) != 1 || stats[102].Goals != 1 || stats[102].MinutesPlayed != 30 || stats[102].FantasyPoints != 5 {
+		t.Fatalf("unexpected minute-30 stats: %+v", bundle.PlayerStats)
+	}
+
+	// 95 minutes after kickoff is match minute 80 once the break is removed.
+	clock.Advance(60 * time.Minute)
+	bundle, err = provider.FetchFixtureBundleBySeason(ctx, 2025)
+	if err != nil {
+		t.Fatalf("fetch bundle: %v", err)
+	}
+	live = fixtureByID(bundle.Fixtures, 9001)
+	if live.Status != "LIVE" || *live.HomeScore != 2 || *live.AwayScore != 1 {
+		t.Fatalf("unexpected second-half fixture: %+v", live)
+	}
+	if len(bundle.Events) != 4 {
+		t.Fatalf("expected all events by minute 80, got %d", len(bundle.Events))
+	}
+	if stats := statsByPlayer(bundle.PlayerStats); len(stats) != 1 || stats[102].Goals != 1 {
+		t.Fatalf("full-time stats must stay hidden while live: %+v", bundle.PlayerStats)
+	}
+
+	clock.Advance(20 * time.Minute)
+	bundle, err = provider.FetchFixtureBundleBySeason(ctx, 2025)
+	if err != nil {
+		t.Fatalf("fetch bundle: %v", err)
+	}
+	finished := fixtureByID(bundle.Fixtures, 9001)
+	if finished.Status != "FINISHED" || finished.WinnerTeamExternalID != 10 || finished.FinishedAt == nil {
+		t.Fatalf("unexpected finished fixture: %+v", finished)
+	}
+	stats = statsByPlayer(bundle.PlayerStats)
+	if len(stats) != 3 {
+		t.Fatalf("expected full-time stats for 3 players, got %+v", bundle.PlayerStats)
+	}
+	// FWD: 2 goals 8 + minutes 2 + bonus 3 = 13
+	if striker := stats[102]; striker.Goals != 2 || striker.FantasyPoints != 13 {
+		t.Fatalf("unexpected striker stats: %+v", striker)
+	}
+	if keeper := stats[101]; keeper.FantasyPoints != 5 {
+		t.Fatalf("expected explicit fantasy points to win, got %+v", keeper)
+	}
+	if mid := stats[202]; mid.Position != "MID" || mid.FantasyPoints != 7 {
+		t.Fatalf("unexpected midfielder stats: %+v", mid)
+	}
+}
+
+func TestProviderStandings_ComputedFromRevealedResults(t *testing.T) {
+	t.Parallel()
+
+	provider, clock := newTestProvider(time.Date(2025, 8, 9, 12, 30, 0, 0, time.UTC))
+	ctx := context.Background()
+
+	items, _, err := provider.FetchStandingsBySeason(ctx, 2025)
+	if err != nil {
+		t.Fatalf("fetch standings: %v", err)
+	}
+	for _, item := range items {
+		if item.Played != 0 {
+			t.Fatalf("expected no finished results yet, got %+v", items)
+		}
+	}
+
+	live, _, err := provider.FetchLiveStandingsByLeague(ctx, 1)
+	if err != nil {
+		t.Fatalf("fetch live standings: %v", err)
+	}
+	if len(live) != 2 || live[0].TeamExternalID != 10 || live[0].Points != 3 || live[0].Played != 1 {
+		t.Fatalf("unexpected live standings: %+v", live)
+	}
+
+	clock.Advance(2 * time.Hour)
+	items, payloads, err := provider.FetchStandingsBySeason(ctx, 2025)
+	if err != nil {
+		t.Fatalf("fetch standings: %v", err)
+	}
+	if len(payloads) != 1 {
+		t.Fatalf("unexpected payload count: %d", len(payloads))
+	}
+	top, bottom := items[0], items[1]
+	if top.TeamExternalID != 10 || top.Position != 1 || top.Points != 3 || top.GoalDifference != 1 || top.Form != "W" {
+		t.Fatalf("unexpected top row: %+v", top)
+	}
+	if bottom.TeamExternalID != 20 || bottom.Lost != 1 || bottom.Form != "L" {
+		t.Fatalf("unexpected bottom row: %+v", bottom)
+	}
+}
+
+func TestProviderFetchTopScorersBySeasonID_RanksRevealedStats(t *testing.T) {
+	t.Parallel()
+
+	provider, _ := newTestProvider(time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC))
+
+	items, hasMore, err := provider.FetchTopScorersBySeasonID(context.Background(), 2025, 1, 208)
+	if err != nil {
+		t.Fatalf("fetch top scorers: %v", err)
+	}
+	if hasMore || len(items) != 2 {
+		t.Fatalf("unexpected top scorers: hasMore=%v items=%+v", hasMore, items)
+	}
+	if items[0].PlayerID != 102 || items[0].Total != 2 || items[0].Rank != 1 || items[0].ParticipantName != "Persija Jakarta" {
+		t.Fatalf("unexpected leader: %+v", items[0])
+	}
+
+	if _, _, err := provider.FetchTopScorersBySeasonID(context.Background(), 2025, 1, 1); err == nil {
+		t.Fatalf("expected error for unsupported type id")
+	}
+}
+
+func TestProvider_MissingDirectoryIsDependencyError(t *testing.T) {
+	t.Parallel()
+
+	provider := NewProvider(ProviderConfig{Logger: logging.NewNop()})
+	if _, err := provider.FetchFixtureBundleBySeason(context.Background(), 1); err == nil {
+		t.Fatalf("expected error without data directory")
+	}
+}
+
+func TestDecodeCSV_ReportsInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	_, err := decodeCSV[playerRecord](strings.NewReader("id,name,price\n1,Player,abc\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("expected line-scoped decode error, got %v", err)
+	}
+}
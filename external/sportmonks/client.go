@@ -18,6 +18,7 @@ import (
 	crerr "github.com/cockroachdb/errors"
 	"github.com/riskibarqy/fantasy-league/internal/domain/rawdata"
 	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+	"github.com/riskibarqy/fantasy-league/internal/platform/metrics"
 	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
 	"github.com/riskibarqy/fantasy-league/internal/usecase"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -104,6 +105,14 @@ func withTracingTransport(client *http.Client) *http.Client {
 	return &copyClient
 }
 
+// CircuitState reports the breaker guarding SportMonks requests.
+func (c *Client) CircuitState() resilience.CircuitState {
+	if !c.circuitEnabled {
+		return resilience.CircuitStateDisabled
+	}
+	return c.breaker.State()
+}
+
 func (c *Client) FetchFixtureBundleBySeason(ctx context.Context, seasonID int64) (usecase.ExternalFixtureBundle, error) {
 	if seasonID <= 0 {
 		return usecase.ExternalFixtureBundle{}, fmt.Errorf("season id must be greater than zero")
@@ -339,7 +348,7 @@ func (c *Client) FetchFixtureBundleBySeason(ctx context.Context, seasonID int64)
 				}
 				key := fmt.Sprintf("%d:%d", item.ID, lineupItem.PlayerID)
 				stat := playerStatsByKey[key]
-				stat.FantasyPoints = estimateFantasyPoints(stat)
+				stat.FantasyPoints = usecase.EstimateFantasyPoints(stat)
 				playerStatsByKey[key] = stat
 			}
 
@@ -697,6 +706,7 @@ func (c *Client) doJSON(ctx context.Context, path string, query map[string]strin
 	if c.circuitEnabled {
 		if err := c.breaker.Allow(); err != nil {
 			c.logger.WarnContext(ctx, "sportmonks circuit breaker rejected request", "state", c.breaker.State())
+			metrics.ProviderRequests.WithLabelValues("sportmonks", metrics.EndpointLabel(path), metrics.OutcomeCircuitOpen).Inc()
 			return nil, fmt.Errorf("%w: sport data provider is temporarily unavailable", usecase.ErrDependencyUnavailable)
 		}
 	}
@@ -715,6 +725,7 @@ func (c *Client) doJSON(ctx context.Context, path string, query map[string]strin
 	key := path + "?" + values.Encode()
 	out, err, _ := c.flight.Do(key, func() (any, error) {
 		raw, reqErr := c.executeRequest(ctx, fullURL)
+		metrics.ObserveProviderRequest("sportmonks", path, reqErr)
 		if c.circuitEnabled {
 			if reqErr != nil {
 				if isSportMonksCircuitFailure(reqErr) {
@@ -2164,53 +2175,6 @@ func extractPlayerDetailStats(details []lineupDetailItem) extractedPlayerDetail
 	return out
 }
 
-func estimateFantasyPoints(stat usecase.ExternalPlayerFixtureStat) int {
-	points := 0
-	switch strings.ToUpper(strings.TrimSpace(stat.Position)) {
-	case "GK", "DEF":
-		points += stat.Goals * 6
-	case "MID":
-		points += stat.Goals * 5
-	default:
-		points += stat.Goals * 4
-	}
-	points += stat.Assists * 3
-
-	if stat.CleanSheet && stat.MinutesPlayed >= 60 {
-		switch strings.ToUpper(strings.TrimSpace(stat.Position)) {
-		case "GK", "DEF":
-			points += 4
-		case "MID":
-			points += 1
-		}
-	}
-
-	if (strings.EqualFold(stat.Position, "GK") || strings.EqualFold(stat.Position, "DEF")) && stat.GoalsConceded > 0 {
-		points -= stat.GoalsConceded / 2
-	}
-
-	if strings.EqualFold(stat.Position, "GK") && stat.Saves > 0 {
-		points += stat.Saves / 3
-	}
-
-	if strings.EqualFold(stat.Position, "GK") && stat.PenaltiesSaved > 0 {
-		points += stat.PenaltiesSaved * 5
-	}
-	points -= stat.PenaltiesMissed * 2
-	points -= stat.OwnGoals * 2
-	points -= stat.YellowCards
-	points -= stat.RedCards * 3
-
-	if stat.MinutesPlayed >= 60 {
-		points += 2
-	} else if stat.MinutesPlayed > 0 {
-		points += 1
-	}
-
-	points += stat.BonusPoints
-	return points
-}
-
 func applyFPLBonusByBPS(fixtureExternalID int64, stats map[string]usecase.ExternalPlayerFixtureStat) {
 	if fixtureExternalID <= 0 || len(stats) == 0 {
 		return
@@ -6,42 +6,6 @@ import (
 	"github.com/riskibarqy/fantasy-league/internal/usecase"
 )
 
-func TestEstimateFantasyPoints_FPLStyleGoalAndCleanSheetByPosition(t *testing.T) {
-	t.Parallel()
-
-	gk := usecase.ExternalPlayerFixtureStat{
-		Position:       "GK",
-		MinutesPlayed:  90,
-		Goals:          1,
-		Assists:        0,
-		CleanSheet:     true,
-		Saves:          6,
-		GoalsConceded:  0,
-		YellowCards:    0,
-		RedCards:       0,
-		BonusPoints:    3,
-		PenaltiesSaved: 1,
-	}
-	// GK: goal 6 + clean sheet 4 + saves 2 + minutes 2 + pen saved 5 + bonus 3 = 22
-	if got := estimateFantasyPoints(gk); got != 22 {
-		t.Fatalf("gk fantasy points mismatch: got=%d want=22", got)
-	}
-
-	mid := usecase.ExternalPlayerFixtureStat{
-		Position:      "MID",
-		MinutesPlayed: 90,
-		Goals:         1,
-		Assists:       1,
-		CleanSheet:    true,
-		YellowCards:   1,
-		BonusPoints:   2,
-	}
-	// MID: goal 5 + assist 3 + clean sheet 1 + minutes 2 - yellow 1 + bonus 2 = 12
-	if got := estimateFantasyPoints(mid); got != 12 {
-		t.Fatalf("mid fantasy points mismatch: got=%d want=12", got)
-	}
-}
-
 func TestApplyFPLBonusByBPS_TieRules(t *testing.T) {
 	t.Parallel()
 
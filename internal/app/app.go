@@ -6,24 +6,35 @@ import (
 	"github.com/riskibarqy/fantasy-league/internal/domain/topscorers"
 	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	_ "github.com/lib/pq"
 	"github.com/riskibarqy/fantasy-league/external/anubis"
+	"github.com/riskibarqy/fantasy-league/external/apifootball"
 	"github.com/riskibarqy/fantasy-league/external/jobqueue"
+	"github.com/riskibarqy/fantasy-league/external/offline"
 	"github.com/riskibarqy/fantasy-league/external/sportmonks"
 	"github.com/riskibarqy/fantasy-league/internal/config"
+	accountdomain "github.com/riskibarqy/fantasy-league/internal/domain/account"
+	awardsdomain "github.com/riskibarqy/fantasy-league/internal/domain/awards"
 	customleaguedomain "github.com/riskibarqy/fantasy-league/internal/domain/customleague"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
 	fixturedomain "github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	fixturedifficultydomain "github.com/riskibarqy/fantasy-league/internal/domain/fixturedifficulty"
 	jobschedulerdomain "github.com/riskibarqy/fantasy-league/internal/domain/jobscheduler"
 	leaguedomain "github.com/riskibarqy/fantasy-league/internal/domain/league"
 	leaguestandingdomain "github.com/riskibarqy/fantasy-league/internal/domain/leaguestanding"
 	lineupdomain "github.com/riskibarqy/fantasy-league/internal/domain/lineup"
+	masterdatadomain "github.com/riskibarqy/fantasy-league/internal/domain/masterdata"
+	notificationdomain "github.com/riskibarqy/fantasy-league/internal/domain/notification"
 	onboardingdomain "github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+	ownershipdomain "github.com/riskibarqy/fantasy-league/internal/domain/ownership"
 	playerdomain "github.com/riskibarqy/fantasy-league/internal/domain/player"
 	playerstatsdomain "github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
 	scoringdomain "github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+	seasondomain "github.com/riskibarqy/fantasy-league/internal/domain/season"
+	statcorrectiondomain "github.com/riskibarqy/fantasy-league/internal/domain/statcorrection"
 	teamdomain "github.com/riskibarqy/fantasy-league/internal/domain/team"
 	teamstatsdomain "github.com/riskibarqy/fantasy-league/internal/domain/teamstats"
 	cacherepo "github.com/riskibarqy/fantasy-league/internal/infrastructure/repository/cache"
@@ -31,6 +42,7 @@ import (
 	"github.com/riskibarqy/fantasy-league/internal/interfaces/httpapi"
 	basecache "github.com/riskibarqy/fantasy-league/internal/platform/cache"
 	idgen "github.com/riskibarqy/fantasy-league/internal/platform/id"
+	"github.com/riskibarqy/fantasy-league/internal/platform/metrics"
 	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
 	"github.com/riskibarqy/fantasy-league/internal/usecase"
 	"github.com/uptrace/opentelemetry-go-extra/otelsql"
@@ -73,12 +85,35 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 	statValueRepo := postgresrepo.NewStatValueRepository(db)
 	rawDataRepo := postgresrepo.NewRawDataRepository(db)
 	var customLeagueRepo customleaguedomain.Repository = postgresrepo.NewCustomLeagueRepository(db)
-	var onboardingRepo onboardingdomain.Repository = postgresrepo.NewOnboardingRepository(db)
+	onboardingStore := postgresrepo.NewOnboardingRepository(db)
+	var onboardingRepo onboardingdomain.Repository = onboardingStore
+	var notificationPrefsRepo onboardingdomain.NotificationPreferencesRepository = onboardingStore
+	var notificationRepo notificationdomain.Repository = postgresrepo.NewNotificationRepository(db)
 	var scoringRepo scoringdomain.Repository = postgresrepo.NewScoringRepository(db)
 	var jobDispatchRepo jobschedulerdomain.Repository = postgresrepo.NewJobDispatchRepository(db)
+	var seasonRepo seasondomain.Repository = postgresrepo.NewSeasonRepository(db)
+	var ownershipRepo ownershipdomain.Repository = postgresrepo.NewOwnershipRepository(db)
+	var awardsRepo awardsdomain.Repository = postgresrepo.NewAwardsRepository(db)
+	var difficultyRepo fixturedifficultydomain.Repository = postgresrepo.NewFixtureDifficultyRepository(db)
+	var masterDataRepo masterdatadomain.Repository = postgresrepo.NewMasterDataRepository(db)
+	var statCorrectionRepo statcorrectiondomain.Repository = postgresrepo.NewStatCorrectionRepository(db)
+	auditLogRepo := postgresrepo.NewAuditLogRepository(db)
+	var accountRepo accountdomain.Repository = postgresrepo.NewAccountRepository(db)
+	idempotencyRepo := postgresrepo.NewIdempotencyRepository(db)
+	systemStatusRepo := postgresrepo.NewSystemStatusRepository(db)
+	systemStatusSvc := usecase.NewSystemStatusService(systemStatusRepo, jobDispatchRepo)
+	addCircuit := func(name string, reporter usecase.CircuitReporter) {
+		systemStatusSvc.AddCircuit(name, reporter)
+		metrics.RegisterCircuit(name, reporter)
+	}
+	if err := metrics.Register(newActivityCollector(systemStatusRepo, logger)); err != nil {
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("register activity metrics: %w", err)
+	}
 
 	if cfg.CacheEnabled {
 		cacheStore := basecache.NewStore(cfg.CacheTTL)
+		systemStatusSvc.SetCache(cacheStore)
 		leagueRepo = cacherepo.NewLeagueRepository(leagueRepo, cacheStore)
 		teamRepo = cacherepo.NewTeamRepository(teamRepo, cacheStore)
 		playerRepo = cacherepo.NewPlayerRepository(playerRepo, cacheStore)
@@ -90,23 +125,50 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 		playerStatsRepo = cacherepo.NewPlayerStatsRepository(playerStatsRepo, cacheStore)
 		teamStatsRepo = cacherepo.NewTeamStatsRepository(teamStatsRepo, cacheStore)
 		customLeagueRepo = cacherepo.NewCustomLeagueRepository(customLeagueRepo, cacheStore)
+		seasonRepo = cacherepo.NewSeasonRepository(seasonRepo, cacheStore)
+		ownershipRepo = cacherepo.NewOwnershipRepository(ownershipRepo, cacheStore)
+		awardsRepo = cacherepo.NewAwardsRepository(awardsRepo, cacheStore)
+		difficultyRepo = cacherepo.NewFixtureDifficultyRepository(difficultyRepo, cacheStore)
+		masterDataRepo = cacherepo.NewMasterDataRepository(masterDataRepo, cacheStore)
+		statCorrectionRepo = cacherepo.NewStatCorrectionRepository(statCorrectionRepo, cacheStore)
+		accountRepo = cacherepo.NewAccountRepository(accountRepo, cacheStore)
 	}
 
 	leagueSvc := usecase.NewLeagueService(leagueRepo, teamRepo)
 	teamSvc := usecase.NewTeamService(leagueRepo, teamRepo, teamStatsRepo)
 	playerSvc := usecase.NewPlayerService(leagueRepo, playerRepo)
+	masterDataSvc := usecase.NewMasterDataService(leagueRepo, teamRepo, playerRepo, masterDataRepo)
 	topScoreSvc := usecase.NewTopScoreService(topScoreRepo)
 	playerStatsSvc := usecase.NewPlayerStatsService(playerStatsRepo)
 	fixtureSvc := usecase.NewFixtureService(leagueRepo, fixtureRepo)
 	leagueStandingSvc := usecase.NewLeagueStandingService(leagueRepo, leagueStandingRepo, fixtureRepo)
 	lineupSvc := usecase.NewLineupService(leagueRepo, playerRepo, lineupRepo, squadRepo)
 	scoringSvc := usecase.NewScoringService(fixtureRepo, squadRepo, lineupRepo, playerStatsRepo, customLeagueRepo, scoringRepo)
+	ownershipSvc := usecase.NewOwnershipService(leagueRepo, scoringRepo, ownershipRepo)
+	scoringSvc.SetOwnershipAggregator(ownershipSvc)
+	statCorrectionSvc := usecase.NewStatCorrectionService(leagueRepo, fixtureRepo, playerRepo, playerStatsRepo, scoringRepo, statCorrectionRepo, scoringSvc)
+	awardsSvc := usecase.NewAwardsService(leagueRepo, playerRepo, playerStatsRepo, scoringRepo, awardsRepo)
+	awardsSvc.SetScoringUpdater(scoringSvc)
+	scoringSvc.SetGameweekAwarder(awardsSvc)
+	difficultySvc := usecase.NewFixtureDifficultyService(leagueRepo, fixtureRepo, leagueStandingRepo, teamStatsRepo, difficultyRepo)
+	difficultySvc.SetScoringUpdater(scoringSvc)
+	scoringSvc.SetFixtureDifficultyRefresher(difficultySvc)
+	comparisonSvc := usecase.NewPlayerComparisonService(leagueRepo, playerRepo, playerStatsRepo, statValueRepo, difficultySvc)
+	statValueSvc := usecase.NewStatValueService(leagueRepo, statValueRepo)
+	leaderboardSvc := usecase.NewLeaderboardService(leagueRepo, playerStatsRepo)
+	enrichmentSvc := usecase.NewEnrichmentService(leagueRepo, teamRepo, fixtureRepo, playerStatsRepo)
 	dashboardSvc := usecase.NewDashboardService(leagueRepo, fixtureRepo, squadRepo, customLeagueRepo, scoringSvc)
 	customLeagueSvc := usecase.NewCustomLeagueService(leagueRepo, squadRepo, customLeagueRepo, scoringSvc, idgen.NewRandomGenerator())
 	ingestionSvc := usecase.NewIngestionService(fixtureWriter, leagueStandingRepo, playerStatsRepo, teamStatsRepo, rawDataRepo)
+	auditRecorder := usecase.NewAuditRecorder(auditLogRepo, logger)
+	auditLogSvc := usecase.NewAuditLogService(auditLogRepo)
+	lineupSvc.SetAuditRecorder(auditRecorder)
+	customLeagueSvc.SetAuditRecorder(auditRecorder)
+	ingestionSvc.SetAuditRecorder(auditRecorder)
+	masterDataSvc.SetAuditRecorder(auditRecorder)
 	var sportDataProvider usecase.SportDataSyncProvider
 	if cfg.SportMonksEnabled {
-		sportDataProvider = sportmonks.NewClient(sportmonks.ClientConfig{
+		sportMonksClient := sportmonks.NewClient(sportmonks.ClientConfig{
 			BaseURL:    cfg.SportMonksBaseURL,
 			Token:      cfg.SportMonksToken,
 			Timeout:    cfg.SportMonksTimeout,
@@ -119,7 +181,10 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 				HalfOpenMaxReq:   cfg.SportMonksCircuitHalfOpenMaxReq,
 			},
 		})
+		sportDataProvider = sportMonksClient
+		addCircuit("sportmonks", sportMonksClient)
 	}
+	sportDataSeasonIDByLeague, sportDataLeagueIDByLeague := sportDataReferenceMaps(cfg)
 	sportDataSyncSvc := usecase.NewSportDataSyncService(
 		sportDataProvider,
 		teamRepo,
@@ -127,16 +192,48 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 		topScoreRepo,
 		ingestionSvc,
 		usecase.SportDataSyncConfig{
-			Enabled:          cfg.SportMonksEnabled,
-			SeasonIDByLeague: cfg.SportMonksSeasonIDByLeague,
-			LeagueIDByLeague: cfg.SportMonksLeagueIDByLeague,
+			Enabled:          cfg.SportMonksEnabled || cfg.APIFootballEnabled || cfg.OfflineDataEnabled,
+			SeasonIDByLeague: sportDataSeasonIDByLeague,
+			LeagueIDByLeague: sportDataLeagueIDByLeague,
 		},
 		logger,
 	)
 	sportDataSyncSvc.SetStatValueRepository(statValueRepo)
+	sportDataSyncSvc.SetLeagueRepository(leagueRepo)
+	sportDataSyncSvc.SetMasterDataRepository(masterDataRepo)
+	offlineClock := offline.NewClock(cfg.OfflineClockStart, cfg.OfflineClockSpeed)
+	for leagueID, provider := range cfg.SportDataProviderByLeague {
+		if provider == config.SportDataProviderOffline && cfg.OfflineDataEnabled {
+			sportDataSyncSvc.SetLeagueProvider(leagueID, usecase.SportDataSourceOffline, offline.NewProvider(offline.ProviderConfig{
+				Dir:    filepath.Join(cfg.OfflineDataDir, leagueID),
+				Clock:  offlineClock,
+				Logger: logger,
+			}))
+			continue
+		}
+		if provider != config.SportDataProviderAPIFootball || !cfg.APIFootballEnabled {
+			continue
+		}
+		apiFootballClient := apifootball.NewClient(apifootball.ClientConfig{
+			BaseURL:    cfg.APIFootballBaseURL,
+			Token:      cfg.APIFootballToken,
+			LeagueID:   cfg.APIFootballLeagueIDByLeague[leagueID],
+			Timeout:    cfg.APIFootballTimeout,
+			MaxRetries: cfg.APIFootballMaxRetries,
+			Logger:     logger,
+			CircuitBreaker: resilience.CircuitBreakerConfig{
+				Enabled:          cfg.APIFootballCircuitEnabled,
+				FailureThreshold: cfg.APIFootballCircuitFailureCount,
+				OpenTimeout:      cfg.APIFootballCircuitOpenTimeout,
+				HalfOpenMaxReq:   cfg.APIFootballCircuitHalfOpenMaxReq,
+			},
+		})
+		sportDataSyncSvc.SetLeagueProvider(leagueID, usecase.SportDataSourceAPIFootball, apiFootballClient)
+		addCircuit("api-football:"+leagueID, apiFootballClient)
+	}
 	jobQueue := usecase.NewNoopJobQueue()
 	if cfg.QStashEnabled {
-		jobQueue = jobqueue.NewQStashPublisher(jobqueue.QStashPublisherConfig{
+		qstashPublisher := jobqueue.NewQStashPublisher(jobqueue.QStashPublisherConfig{
 			BaseURL:          cfg.QStashBaseURL,
 			Token:            cfg.QStashToken,
 			TargetBaseURL:    cfg.QStashTargetBaseURL,
@@ -149,6 +246,8 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 				HalfOpenMaxReq:   cfg.QStashCircuitHalfOpenMaxReq,
 			},
 		}, logger)
+		jobQueue = qstashPublisher
+		addCircuit("qstash", qstashPublisher)
 	}
 	jobOrchestrator := usecase.NewJobOrchestratorService(
 		leagueRepo,
@@ -164,6 +263,25 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 		},
 		logger,
 	)
+	notifiers, err := buildNotifiers(cfg, logger)
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, err
+	}
+	notificationSvc := usecase.NewNotificationService(
+		leagueRepo,
+		fixtureRepo,
+		squadRepo,
+		lineupRepo,
+		playerRepo,
+		scoringRepo,
+		customLeagueRepo,
+		notificationPrefsRepo,
+		notificationRepo,
+		notifiers,
+		logger,
+	)
+	jobOrchestrator.SetLeagueNotifier(notificationSvc)
 	squadSvc := usecase.NewSquadService(
 		leagueRepo,
 		playerRepo,
@@ -174,8 +292,24 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 	)
 	squadSvc.SetScoringUpdater(scoringSvc)
 	squadSvc.SetDefaultLeagueJoiner(customLeagueSvc)
+	squadSvc.SetAuditRecorder(auditRecorder)
 	lineupSvc.SetScoringUpdater(scoringSvc)
 	onboardingSvc := usecase.NewOnboardingService(teamRepo, onboardingRepo, squadSvc, lineupSvc, customLeagueSvc)
+	onboardingSvc.SetAuditRecorder(auditRecorder)
+	accountSvc := usecase.NewAccountService(customLeagueRepo, accountRepo)
+	accountSvc.SetAuditRecorder(auditRecorder)
+	idempotencySvc := usecase.NewIdempotencyService(idempotencyRepo, cfg.IdempotencyKeyTTL, logger)
+	seasonRolloverSvc := usecase.NewSeasonRolloverService(
+		leagueRepo,
+		fixtureRepo,
+		customLeagueRepo,
+		seasonRepo,
+		sportDataSyncSvc,
+		idgen.NewRandomGenerator(),
+		logger,
+	)
+	seasonHistorySvc := usecase.NewSeasonHistoryService(leagueRepo, scoringRepo, squadRepo, customLeagueRepo, seasonRepo, scoringSvc)
+	seasonRolloverSvc.SetSeasonSummarizer(seasonHistorySvc)
 
 	anubisClient := anubis.NewClient(
 		&http.Client{Timeout: cfg.AnubisTimeout},
@@ -190,6 +324,7 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 		},
 		logger,
 	)
+	addCircuit("anubis", anubisClient)
 
 	handler := httpapi.NewHandler(
 		leagueSvc,
@@ -209,6 +344,22 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 		onboardingSvc,
 		jobDispatchRepo,
 		topScoreSvc,
+		seasonRolloverSvc,
+		seasonHistorySvc,
+		ownershipSvc,
+		awardsSvc,
+		difficultySvc,
+		comparisonSvc,
+		statValueSvc,
+		leaderboardSvc,
+		notificationSvc,
+		systemStatusSvc,
+		masterDataSvc,
+		statCorrectionSvc,
+		auditLogSvc,
+		accountSvc,
+		idempotencySvc,
+		enrichmentSvc,
 		logger,
 	)
 	router := httpapi.NewRouter(
@@ -218,8 +369,22 @@ func NewHTTPHandler(cfg config.Config, logger *logging.Logger) (http.Handler, fu
 		cfg.SwaggerEnabled,
 		cfg.CORSAllowedOrigins,
 		cfg.InternalJobToken,
+		cfg.AdminUserIDs,
+		cfg.AnubisWebhookSecret,
 		cfg.UptraceCaptureRequestBody,
 		cfg.UptraceRequestBodyMaxBytes,
+		httpapi.RateLimitConfig{
+			Enabled:    cfg.RateLimitEnabled,
+			Public:     httpapi.RateLimitRule{RequestsPerSecond: cfg.RateLimitPublicRPS, Burst: cfg.RateLimitPublicBurst},
+			Authorized: httpapi.RateLimitRule{RequestsPerSecond: cfg.RateLimitAuthorizedRPS, Burst: cfg.RateLimitAuthorizedBurst},
+			Write:      httpapi.RateLimitRule{RequestsPerSecond: cfg.RateLimitWriteRPS, Burst: cfg.RateLimitWriteBurst},
+			Invite:     httpapi.RateLimitRule{RequestsPerSecond: cfg.RateLimitInviteRPS, Burst: cfg.RateLimitInviteBurst},
+		},
+		httpapi.HTTPCacheConfig{
+			Live:     cfg.HTTPCacheLiveMaxAge,
+			Standard: cfg.HTTPCacheStandardMaxAge,
+			Catalog:  cfg.HTTPCacheCatalogMaxAge,
+		},
 	)
 
 	return router, db.Close, nil
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/riskibarqy/fantasy-league/internal/domain/systemstatus"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+)
+
+const (
+	activityRefreshInterval = time.Minute
+	activityQueryTimeout    = 5 * time.Second
+)
+
+// activityCollector exports squad and lineup counts as gauges. Counts are
+// cached between scrapes so a tight scrape interval does not hit Postgres.
+type activityCollector struct {
+	repo   systemstatus.Repository
+	logger *logging.Logger
+
+	squads  *prometheus.Desc
+	lineups *prometheus.Desc
+
+	mu        sync.Mutex
+	activity  systemstatus.Activity
+	fetchedAt time.Time
+}
+
+func newActivityCollector(repo systemstatus.Repository, logger *logging.Logger) *activityCollector {
+	return &activityCollector{
+		repo:   repo,
+		logger: logger,
+		squads: prometheus.NewDesc(
+			"fantasy_active_squads",
+			"Fantasy squads that are not deleted, per league.",
+			[]string{"league"},
+			nil,
+		),
+		lineups: prometheus.NewDesc(
+			"fantasy_lineups_saved",
+			"Lineups snapshotted at the gameweek deadline, per league and gameweek.",
+			[]string{"league", "gameweek"},
+			nil,
+		),
+	}
+}
+
+func (c *activityCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.squads
+	ch <- c.lineups
+}
+
+func (c *activityCollector) Collect(ch chan<- prometheus.Metric) {
+	activity := c.current()
+	for leagueID, count := range activity.SquadsByLeague {
+		ch <- prometheus.MustNewConstMetric(c.squads, prometheus.GaugeValue, float64(count), leagueID)
+	}
+	for _, item := range activity.Lineups {
+		ch <- prometheus.MustNewConstMetric(c.lineups, prometheus.GaugeValue, float64(item.Count), item.LeagueID, strconv.Itoa(item.Gameweek))
+	}
+}
+
+// current returns the cached counts, refreshing them once they are stale.
+// A failed refresh keeps serving the previous counts.
+func (c *activityCollector) current() systemstatus.Activity {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.fetchedAt.IsZero() && time.Since(c.fetchedAt) < activityRefreshInterval {
+		return c.activity
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), activityQueryTimeout)
+	defer cancel()
+	activity, err := c.repo.CountActivity(ctx)
+	c.fetchedAt = time.Now()
+	if err != nil {
+		c.logger.WarnContext(ctx, "refresh activity metrics failed", "error", err)
+		return c.activity
+	}
+	c.activity = activity
+	return c.activity
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/riskibarqy/fantasy-league/external/notify"
+	"github.com/riskibarqy/fantasy-league/internal/config"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+// buildNotifiers returns the enabled notification channels. With none
+// enabled, the notification service skips every run.
+func buildNotifiers(cfg config.Config, logger *logging.Logger) ([]usecase.Notifier, error) {
+	var out []usecase.Notifier
+	if cfg.NotifyFileEnabled {
+		out = append(out, notify.NewFileSink(cfg.NotifyFilePath, logger))
+	}
+	if cfg.NotifySMTPEnabled {
+		out = append(out, notify.NewSMTP(notify.SMTPConfig{
+			Host:     cfg.NotifySMTPHost,
+			Port:     cfg.NotifySMTPPort,
+			Username: cfg.NotifySMTPUsername,
+			Password: cfg.NotifySMTPPassword,
+			From:     cfg.NotifySMTPFrom,
+			Timeout:  cfg.NotifyTimeout,
+		}))
+	}
+	if cfg.NotifyWebPushEnabled {
+		webPush, err := notify.NewWebPush(notify.WebPushConfig{
+			VAPIDPrivateKey: cfg.NotifyVAPIDPrivateKey,
+			VAPIDSubject:    cfg.NotifyVAPIDSubject,
+			Timeout:         cfg.NotifyTimeout,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("configure web push notifier: %w", err)
+		}
+		out = append(out, webPush)
+	}
+	if cfg.NotifyWebhookEnabled {
+		out = append(out, notify.NewWebhook(notify.WebhookConfig{
+			Secret:  cfg.NotifyWebhookSecret,
+			Timeout: cfg.NotifyTimeout,
+		}))
+	}
+	return out, nil
+}
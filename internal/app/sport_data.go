@@ -0,0 +1,38 @@
+package app
+
+import (
+	"github.com/riskibarqy/fantasy-league/external/offline"
+	"github.com/riskibarqy/fantasy-league/internal/config"
+)
+
+// sportDataReferenceMaps merges the per-provider season and league id maps
+// into the single view used by the sync service. Leagues mapped to
+// API-Football take their references from the API-Football maps, offline
+// leagues fall back to a placeholder id, and every other league keeps the
+// SportMonks values.
+func sportDataReferenceMaps(cfg config.Config) (map[string]int64, map[string]int64) {
+	seasonIDByLeague := make(map[string]int64, len(cfg.SportMonksSeasonIDByLeague))
+	for leagueID, seasonID := range cfg.SportMonksSeasonIDByLeague {
+		seasonIDByLeague[leagueID] = seasonID
+	}
+	leagueIDByLeague := make(map[string]int64, len(cfg.SportMonksLeagueIDByLeague))
+	for leagueID, refID := range cfg.SportMonksLeagueIDByLeague {
+		leagueIDByLeague[leagueID] = refID
+	}
+
+	for leagueID, provider := range cfg.SportDataProviderByLeague {
+		switch {
+		case provider == config.SportDataProviderAPIFootball && cfg.APIFootballEnabled:
+			seasonIDByLeague[leagueID] = cfg.APIFootballSeasonByLeague[leagueID]
+			leagueIDByLeague[leagueID] = cfg.APIFootballLeagueIDByLeague[leagueID]
+		case provider == config.SportDataProviderOffline && cfg.OfflineDataEnabled:
+			if seasonIDByLeague[leagueID] <= 0 {
+				seasonIDByLeague[leagueID] = offline.DefaultReferenceID
+			}
+			if leagueIDByLeague[leagueID] <= 0 {
+				leagueIDByLeague[leagueID] = offline.DefaultReferenceID
+			}
+		}
+	}
+	return seasonIDByLeague, leagueIDByLeague
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/external/offline"
+	"github.com/riskibarqy/fantasy-league/internal/config"
+)
+
+func TestSportDataReferenceMaps(t *testing.T) {
+	cfg := config.Config{
+		SportMonksSeasonIDByLeague:  map[string]int64{"idn-liga-1-2025": 25965, "global-liga-1-2025": 23614},
+		SportMonksLeagueIDByLeague:  map[string]int64{"idn-liga-1-2025": 123},
+		APIFootballSeasonByLeague:   map[string]int64{"global-liga-1-2025": 2025},
+		APIFootballLeagueIDByLeague: map[string]int64{"global-liga-1-2025": 274},
+		SportDataProviderByLeague:   map[string]string{"global-liga-1-2025": config.SportDataProviderAPIFootball},
+	}
+
+	t.Run("api-football disabled keeps sportmonks values", func(t *testing.T) {
+		seasons, leagues := sportDataReferenceMaps(cfg)
+		if seasons["global-liga-1-2025"] != 23614 {
+			t.Fatalf("unexpected season id: %d", seasons["global-liga-1-2025"])
+		}
+		if _, ok := leagues["global-liga-1-2025"]; ok {
+			t.Fatalf("expected no league id for sportmonks league without map entry")
+		}
+	})
+
+	t.Run("api-football leagues use their own references", func(t *testing.T) {
+		enabled := cfg
+		enabled.APIFootballEnabled = true
+		seasons, leagues := sportDataReferenceMaps(enabled)
+		if seasons["global-liga-1-2025"] != 2025 || leagues["global-liga-1-2025"] != 274 {
+			t.Fatalf("unexpected api-football references: season=%d league=%d", seasons["global-liga-1-2025"], leagues["global-liga-1-2025"])
+		}
+		if seasons["idn-liga-1-2025"] != 25965 || leagues["idn-liga-1-2025"] != 123 {
+			t.Fatalf("unexpected sportmonks references: season=%d league=%d", seasons["idn-liga-1-2025"], leagues["idn-liga-1-2025"])
+		}
+		if cfg.SportMonksSeasonIDByLeague["global-liga-1-2025"] != 23614 {
+			t.Fatalf("expected source config map to stay untouched")
+		}
+	})
+
+	t.Run("offline leagues fall back to placeholder references", func(t *testing.T) {
+		offlineCfg := cfg
+		offlineCfg.OfflineDataEnabled = true
+		offlineCfg.SportDataProviderByLeague = map[string]string{
+			"idn-liga-1-2025": config.SportDataProviderOffline,
+			"sandbox-league":  config.SportDataProviderOffline,
+		}
+		seasons, leagues := sportDataReferenceMaps(offlineCfg)
+		if seasons["idn-liga-1-2025"] != 25965 || leagues["idn-liga-1-2025"] != 123 {
+			t.Fatalf("expected configured sportmonks references to be kept: season=%d league=%d", seasons["idn-liga-1-2025"], leagues["idn-liga-1-2025"])
+		}
+		if seasons["sandbox-league"] != offline.DefaultReferenceID || leagues["sandbox-league"] != offline.DefaultReferenceID {
+			t.Fatalf("unexpected placeholder references: season=%d league=%d", seasons["sandbox-league"], leagues["sandbox-league"])
+		}
+	})
+}
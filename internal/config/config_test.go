@@ -355,3 +355,121 @@ func TestLoad_SportMonksConfigParsing(t *testing.T) {
 		}
 	})
 }
+
+func TestLoad_APIFootballConfigParsing(t *testing.T) {
+	t.Setenv("APP_ENV", EnvDev)
+	t.Setenv("UPTRACE_ENABLED", "false")
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Setenv("API_FOOTBALL_ENABLED", "")
+		t.Setenv("SPORT_DATA_PROVIDER_MAP", "")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("load config: %v", err)
+		}
+		if cfg.APIFootballEnabled {
+			t.Fatalf("expected APIFootballEnabled=false by default")
+		}
+		if len(cfg.SportDataProviderByLeague) != 0 {
+			t.Fatalf("expected empty provider map, got %+v", cfg.SportDataProviderByLeague)
+		}
+	})
+
+	t.Run("rejects unknown provider", func(t *testing.T) {
+		t.Setenv("SPORT_DATA_PROVIDER_MAP", "idn-liga-1-2025:opta")
+		if _, err := Load(); err == nil {
+			t.Fatalf("expected error for unknown provider")
+		}
+	})
+
+	t.Run("league mapped to api-football requires enabled provider", func(t *testing.T) {
+		t.Setenv("API_FOOTBALL_ENABLED", "false")
+		t.Setenv("SPORT_DATA_PROVIDER_MAP", "idn-liga-1-2025:apifootball")
+		if _, err := Load(); err == nil {
+			t.Fatalf("expected error when api-football league is mapped while disabled")
+		}
+	})
+
+	t.Run("league mapped to api-football requires league id", func(t *testing.T) {
+		t.Setenv("API_FOOTBALL_ENABLED", "true")
+		t.Setenv("API_FOOTBALL_TOKEN", "token")
+		t.Setenv("API_FOOTBALL_SEASON_MAP", "idn-liga-1-2025:2025")
+		t.Setenv("API_FOOTBALL_LEAGUE_ID_MAP", "")
+		t.Setenv("SPORT_DATA_PROVIDER_MAP", "idn-liga-1-2025:apifootball")
+		if _, err := Load(); err == nil {
+			t.Fatalf("expected error when API_FOOTBALL_LEAGUE_ID_MAP misses the league")
+		}
+	})
+
+	t.Run("enabled with valid values", func(t *testing.T) {
+		t.Setenv("API_FOOTBALL_ENABLED", "true")
+		t.Setenv("API_FOOTBALL_TOKEN", "token")
+		t.Setenv("API_FOOTBALL_SEASON_MAP", "idn-liga-1-2025:2025")
+		t.Setenv("API_FOOTBALL_LEAGUE_ID_MAP", "idn-liga-1-2025:274")
+		t.Setenv("API_FOOTBALL_TIMEOUT", "10s")
+		t.Setenv("SPORT_DATA_PROVIDER_MAP", "idn-liga-1-2025:APIFootball,global-liga-1-2025:sportmonks")
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("load config: %v", err)
+		}
+		if cfg.SportDataProviderByLeague["idn-liga-1-2025"] != SportDataProviderAPIFootball {
+			t.Fatalf("unexpected provider map: %+v", cfg.SportDataProviderByLeague)
+		}
+		if cfg.APIFootballLeagueIDByLeague["idn-liga-1-2025"] != 274 || cfg.APIFootballSeasonByLeague["idn-liga-1-2025"] != 2025 {
+			t.Fatalf("unexpected api-football maps: leagues=%+v seasons=%+v", cfg.APIFootballLeagueIDByLeague, cfg.APIFootballSeasonByLeague)
+		}
+		if cfg.APIFootballTimeout != 10*time.Second {
+			t.Fatalf("unexpected api-football timeout: %s", cfg.APIFootballTimeout)
+		}
+	})
+}
+
+func TestLoad_OfflineDataConfigParsing(t *testing.T) {
+	t.Setenv("APP_ENV", EnvDev)
+	t.Setenv("UPTRACE_ENABLED", "false")
+
+	t.Run("enabled requires data dir", func(t *testing.T) {
+		t.Setenv("OFFLINE_DATA_ENABLED", "true")
+		t.Setenv("OFFLINE_DATA_DIR", "")
+		if _, err := Load(); err == nil {
+			t.Fatalf("expected error when OFFLINE_DATA_ENABLED=true without OFFLINE_DATA_DIR")
+		}
+	})
+
+	t.Run("league mapped to offline requires enabled provider", func(t *testing.T) {
+		t.Setenv("OFFLINE_DATA_ENABLED", "false")
+		t.Setenv("SPORT_DATA_PROVIDER_MAP", "idn-liga-1-2025:offline")
+		if _, err := Load(); err == nil {
+			t.Fatalf("expected error when offline league is mapped while disabled")
+		}
+	})
+
+	t.Run("rejects non-positive clock speed", func(t *testing.T) {
+		t.Setenv("OFFLINE_DATA_ENABLED", "true")
+		t.Setenv("OFFLINE_DATA_DIR", "./testdata")
+		t.Setenv("OFFLINE_CLOCK_SPEED", "0")
+		if _, err := Load(); err == nil {
+			t.Fatalf("expected error for OFFLINE_CLOCK_SPEED=0")
+		}
+	})
+
+	t.Run("enabled with valid values", func(t *testing.T) {
+		t.Setenv("OFFLINE_DATA_ENABLED", "true")
+		t.Setenv("OFFLINE_DATA_DIR", "./testdata")
+		t.Setenv("OFFLINE_CLOCK_START", "2025-08-09T11:00:00Z")
+		t.Setenv("OFFLINE_CLOCK_SPEED", "30")
+		t.Setenv("SPORT_DATA_PROVIDER_MAP", "idn-liga-1-2025:offline")
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("load config: %v", err)
+		}
+		if cfg.SportDataProviderByLeague["idn-liga-1-2025"] != SportDataProviderOffline {
+			t.Fatalf("unexpected provider map: %+v", cfg.SportDataProviderByLeague)
+		}
+		if !cfg.OfflineClockStart.Equal(time.Date(2025, 8, 9, 11, 0, 0, 0, time.UTC)) || cfg.OfflineClockSpeed != 30 {
+			t.Fatalf("unexpected offline clock: start=%s speed=%v", cfg.OfflineClockStart, cfg.OfflineClockSpeed)
+		}
+	})
+}
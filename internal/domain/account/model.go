@@ -0,0 +1,37 @@
+package account
+
+import "time"
+
+// DeletedUserID replaces the user id on rows that outlive an account, such
+// as custom leagues nobody could take over and audit log entries.
+const DeletedUserID = "deleted-user"
+
+// Archive is every row tied to one user, keyed by table name. Rows keep the
+// column names of the table so the export stays complete as tables grow.
+type Archive struct {
+	UserID      string
+	GeneratedAt time.Time
+	Tables      map[string][]map[string]any
+}
+
+// DeletionPlan describes how the custom leagues a user owns are handed over
+// before their rows are removed.
+type DeletionPlan struct {
+	UserID string
+	// NewOwners maps each owned custom league to the member taking it over.
+	NewOwners map[string]string
+	// ClosedGroupIDs are owned custom leagues without any other member.
+	ClosedGroupIDs []string
+	// RerankGroupIDs are the other custom leagues the user is in; their
+	// remaining standings are re-ranked once the user's row is gone.
+	RerankGroupIDs []string
+}
+
+// DeletionResult counts the rows removed or anonymized per table.
+type DeletionResult struct {
+	UserID       string
+	RowsByTable  map[string]int64
+	NewOwners    map[string]string
+	ClosedGroups []string
+	LeagueIDs    []string
+}
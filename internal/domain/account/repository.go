@@ -0,0 +1,11 @@
+package account
+
+import "context"
+
+type Repository interface {
+	ExportUserData(ctx context.Context, userID string) (map[string][]map[string]any, error)
+	// DeleteUserData applies the plan and removes or anonymizes every row of
+	// the user in one transaction. Audit log entries are kept with the user
+	// id replaced by DeletedUserID.
+	DeleteUserData(ctx context.Context, plan DeletionPlan) (map[string]int64, error)
+}
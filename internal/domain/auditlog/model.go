@@ -0,0 +1,45 @@
+package auditlog
+
+import "time"
+
+// Entity types recorded in the audit log.
+const (
+	EntityLeague       = "league"
+	EntityTeam         = "team"
+	EntityPlayer       = "player"
+	EntitySquad        = "squad"
+	EntityLineup       = "lineup"
+	EntityCustomLeague = "custom_league"
+	EntityOnboarding   = "onboarding"
+	EntityFixture      = "fixture"
+	EntityUser         = "user"
+)
+
+// ActorSystem is recorded when a change has no authenticated principal, such
+// as ingestion calls made with the internal job token.
+const ActorSystem = "system"
+
+// Entry is one mutation in the append-only audit log. Before and After hold
+// the changed fields; Before is nil for creations.
+type Entry struct {
+	ID          int64
+	ActorUserID string
+	Action      string
+	EntityType  string
+	EntityID    string
+	LeagueID    string
+	TraceID     string
+	Before      map[string]any
+	After       map[string]any
+	CreatedAt   time.Time
+}
+
+// Query filters audit entries. Empty fields and zero times match everything.
+type Query struct {
+	ActorUserID string
+	EntityType  string
+	EntityID    string
+	From        time.Time
+	To          time.Time
+	Limit       int
+}
@@ -0,0 +1,9 @@
+package auditlog
+
+import "context"
+
+// Repository appends audit entries. Entries are never updated or deleted.
+type Repository interface {
+	Append(ctx context.Context, entry Entry) error
+	List(ctx context.Context, query Query) ([]Entry, error)
+}
@@ -0,0 +1,30 @@
+package awards
+
+import (
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+)
+
+// DreamTeamPlayer is one starter of a dream team with the fantasy points that
+// earned the spot.
+type DreamTeamPlayer struct {
+	PlayerID string
+	Position player.Position
+	Points   int
+}
+
+// GameweekAwards is computed once a gameweek is finalized. DreamTeam is the
+// best XI of the gameweek and SeasonDreamTeam the best XI on points summed
+// from the first gameweek through this one.
+type GameweekAwards struct {
+	LeagueID         string
+	Gameweek         int
+	DreamTeam        []DreamTeamPlayer
+	SeasonDreamTeam  []DreamTeamPlayer
+	TopPlayerID      string
+	TopPlayerPoints  int
+	TopManagerUserID string
+	TopManagerPoints int
+	ComputedAt       time.Time
+}
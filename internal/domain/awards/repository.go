@@ -0,0 +1,10 @@
+package awards
+
+import "context"
+
+type Repository interface {
+	// Replace swaps the stored awards of the league gameweek of item.
+	Replace(ctx context.Context, item GameweekAwards) error
+	GetByLeagueGameweek(ctx context.Context, leagueID string, gameweek int) (GameweekAwards, bool, error)
+	ListGameweeksByLeague(ctx context.Context, leagueID string) ([]int, error)
+}
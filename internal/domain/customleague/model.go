@@ -19,8 +19,11 @@ type Group struct {
 	Name        string
 	InviteCode  string
 	IsDefault   bool
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// PreviousGroupID links a group carried over by a season rollover to the
+	// group it was copied from.
+	PreviousGroupID string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 type Membership struct {
@@ -12,6 +12,9 @@ type Repository interface {
 	ListGroupsByUser(ctx context.Context, userID string) ([]Group, error)
 	ListDefaultGroupsByLeague(ctx context.Context, leagueID string) ([]Group, error)
 	ListDefaultGroupsByLeagueAndCountry(ctx context.Context, leagueID, countryCode string) ([]Group, error)
+	// ListCarriedOverGroupsByUser returns leagueID groups copied by a season
+	// rollover from a group the user belonged to.
+	ListCarriedOverGroupsByUser(ctx context.Context, leagueID, userID string) ([]Group, error)
 	ListMembershipsByGroup(ctx context.Context, groupID string) ([]Membership, error)
 	ListMembershipsByLeague(ctx context.Context, leagueID string) ([]Membership, error)
 	ListStandingsByUser(ctx context.Context, userID string) ([]Standing, error)
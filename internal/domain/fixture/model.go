@@ -23,6 +23,7 @@ type Fixture struct {
 	HomeTeamID   string
 	AwayTeamID   string
 	FixtureRefID int64
+	RefSource    string
 	KickoffAt    time.Time
 	Venue        string
 	HomeScore    *int
@@ -0,0 +1,32 @@
+package fixturedifficulty
+
+import "time"
+
+const (
+	MinDifficulty     = 1
+	MaxDifficulty     = 5
+	NeutralDifficulty = 3
+)
+
+// TeamStrength rates how hard a team is to play on a 0..1 scale and as a
+// 1..5 difficulty. Home values apply when the team hosts the fixture, away
+// values when it travels.
+type TeamStrength struct {
+	LeagueID        string
+	TeamID          string
+	HomeStrength    float64
+	AwayStrength    float64
+	HomeDifficulty  int
+	AwayDifficulty  int
+	BasedOnGameweek int
+	ComputedAt      time.Time
+}
+
+// DifficultyAgainst returns the difficulty of facing this team, which hosts
+// the fixture when hostsFixture is true.
+func (s TeamStrength) DifficultyAgainst(hostsFixture bool) int {
+	if hostsFixture {
+		return s.HomeDifficulty
+	}
+	return s.AwayDifficulty
+}
@@ -0,0 +1,9 @@
+package fixturedifficulty
+
+import "context"
+
+type Repository interface {
+	// ReplaceByLeague swaps every team strength of a league with items.
+	ReplaceByLeague(ctx context.Context, leagueID string, items []TeamStrength) error
+	ListByLeague(ctx context.Context, leagueID string) ([]TeamStrength, error)
+}
@@ -0,0 +1,23 @@
+package idempotency
+
+import "time"
+
+// Record is a mutating request stored under the client's Idempotency-Key,
+// scoped to the user that sent it.
+type Record struct {
+	UserID      string
+	Key         string
+	Method      string
+	Path        string
+	RequestHash string
+	// StatusCode stays zero until the first request has been answered.
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func (r Record) Completed() bool {
+	return r.StatusCode > 0
+}
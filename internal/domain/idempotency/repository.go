@@ -0,0 +1,17 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	// Reserve stores record unless an unexpired record already holds the
+	// same user and key, in which case that record is returned with false.
+	// A record still in progress after a one minute lease is taken over.
+	Reserve(ctx context.Context, record Record) (Record, bool, error)
+	Complete(ctx context.Context, record Record) error
+	// Release drops a reservation so the key can be retried.
+	Release(ctx context.Context, userID, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -10,6 +10,13 @@ const (
 	StatusFailed    DispatchStatus = "failed"
 )
 
+// LastCompletion is the latest successful run of a job for one league.
+type LastCompletion struct {
+	JobName     string
+	LeagueID    string
+	CompletedAt time.Time
+}
+
 type DispatchEvent struct {
 	DispatchID   string
 	JobName      string
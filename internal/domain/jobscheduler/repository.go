@@ -4,4 +4,5 @@ import "context"
 
 type Repository interface {
 	UpsertEvent(ctx context.Context, event DispatchEvent) error
+	ListLastCompletions(ctx context.Context, jobNames []string) ([]LastCompletion, error)
 }
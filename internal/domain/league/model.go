@@ -1,6 +1,9 @@
 package league
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // League is a football league supported by the fantasy platform.
 type League struct {
@@ -10,6 +13,14 @@ type League struct {
 	Season      string
 	IsDefault   bool
 	LeagueRefID int64
+	// SeasonRefID is the provider season id synced for this league record.
+	// Zero means the configured season map still decides.
+	SeasonRefID int64
+	// OriginLeagueID is the first season's league id. Rolled-over leagues keep
+	// using it to look up provider and reference configuration.
+	OriginLeagueID   string
+	PreviousLeagueID string
+	ArchivedAt       *time.Time
 }
 
 func (l League) Validate() error {
@@ -28,3 +39,17 @@ func (l League) Validate() error {
 
 	return nil
 }
+
+// IsArchived reports whether the league season has been rolled over. Archived
+// leagues stay readable but no longer accept squad or lineup changes.
+func (l League) IsArchived() bool {
+	return l.ArchivedAt != nil
+}
+
+// ConfigKey returns the league id used by per-league configuration maps.
+func (l League) ConfigKey() string {
+	if l.OriginLeagueID != "" {
+		return l.OriginLeagueID
+	}
+	return l.ID
+}
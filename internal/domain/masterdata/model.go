@@ -0,0 +1,60 @@
+package masterdata
+
+import (
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/team"
+)
+
+// TeamOverride is a manual correction of a provider team. It is stored apart
+// from the team row and applied again on every sync.
+type TeamOverride struct {
+	LeagueID  string
+	TeamID    string
+	Hidden    bool
+	Note      string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// Apply returns the team with the override on top.
+func (o TeamOverride) Apply(item team.Team) team.Team {
+	item.Hidden = o.Hidden
+	return item
+}
+
+// PlayerOverride is a manual correction of a provider player. Nil fields keep
+// the provider value.
+type PlayerOverride struct {
+	LeagueID  string
+	PlayerID  string
+	Position  *player.Position
+	Price     *int64
+	Available *bool
+	Hidden    bool
+	Note      string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// Apply returns the player with the override on top.
+func (o PlayerOverride) Apply(item player.Player) player.Player {
+	if o.Position != nil {
+		item.Position = *o.Position
+	}
+	if o.Price != nil {
+		item.Price = *o.Price
+	}
+	if o.Available != nil {
+		item.Unavailable = !*o.Available
+	}
+	item.Hidden = o.Hidden
+	return item
+}
+
+// Overrides is every override of one league.
+type Overrides struct {
+	Teams   []TeamOverride
+	Players []PlayerOverride
+}
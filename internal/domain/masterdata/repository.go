@@ -0,0 +1,17 @@
+package masterdata
+
+import (
+	"context"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+)
+
+// Repository stores admin changes to master data. Every save also updates the
+// live row and appends the audit entry in the same transaction.
+type Repository interface {
+	ListOverrides(ctx context.Context, leagueID string) (Overrides, error)
+	SaveTeamOverride(ctx context.Context, item TeamOverride, entry auditlog.Entry) error
+	SavePlayerOverride(ctx context.Context, item PlayerOverride, entry auditlog.Entry) error
+	SaveLeague(ctx context.Context, item league.League, entry auditlog.Entry) error
+}
@@ -0,0 +1,53 @@
+package notification
+
+import "time"
+
+// Event identifies why a notification is sent. Users can mute events one by
+// one in their preferences.
+type Event string
+
+const (
+	EventDeadline24h        Event = "deadline_24h"
+	EventDeadline1h         Event = "deadline_1h"
+	EventGameweekFinalized  Event = "gameweek_finalized"
+	EventStarterUnavailable Event = "starter_unavailable"
+	EventCustomLeagueJoined Event = "custom_league_joined"
+)
+
+var AllEvents = map[Event]struct{}{
+	EventDeadline24h:        {},
+	EventDeadline1h:         {},
+	EventGameweekFinalized:  {},
+	EventStarterUnavailable: {},
+	EventCustomLeagueJoined: {},
+}
+
+// Channel is a delivery medium of a Notifier.
+type Channel string
+
+const (
+	ChannelEmail   Channel = "email"
+	ChannelPush    Channel = "push"
+	ChannelWebhook Channel = "webhook"
+	ChannelFile    Channel = "file"
+)
+
+// Message is one notification addressed to a user. DedupKey is unique per
+// user, so the same reminder or result is never sent twice.
+type Message struct {
+	UserID   string
+	Event    Event
+	Title    string
+	Body     string
+	Data     map[string]any
+	DedupKey string
+}
+
+// Delivery records that a message reached at least one channel of a user.
+type Delivery struct {
+	UserID   string
+	DedupKey string
+	Event    Event
+	Channels []Channel
+	SentAt   time.Time
+}
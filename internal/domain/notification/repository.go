@@ -0,0 +1,9 @@
+package notification
+
+import "context"
+
+// Repository keeps the delivery log used to send each message only once.
+type Repository interface {
+	HasDelivery(ctx context.Context, userID, dedupKey string) (bool, error)
+	RecordDelivery(ctx context.Context, delivery Delivery) error
+}
@@ -12,3 +12,39 @@ type Profile struct {
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
+
+// NotificationPreferences are the notification settings of a user, stored
+// next to the onboarding profile. A channel is used only when it is enabled
+// and has a destination.
+type NotificationPreferences struct {
+	UserID           string
+	Email            string
+	EmailEnabled     bool
+	PushEnabled      bool
+	PushSubscription PushSubscription
+	WebhookURL       string
+	WebhookEnabled   bool
+	MutedEvents      []string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// PushSubscription is a browser web push subscription.
+type PushSubscription struct {
+	Endpoint string
+	P256DH   string
+	Auth     string
+}
+
+func (s PushSubscription) IsZero() bool {
+	return s.Endpoint == "" || s.P256DH == "" || s.Auth == ""
+}
+
+func (p NotificationPreferences) IsMuted(event string) bool {
+	for _, item := range p.MutedEvents {
+		if item == event {
+			return true
+		}
+	}
+	return false
+}
@@ -6,3 +6,10 @@ type Repository interface {
 	GetByUserID(ctx context.Context, userID string) (Profile, bool, error)
 	Upsert(ctx context.Context, profile Profile) error
 }
+
+// NotificationPreferencesRepository stores the per-user notification settings.
+type NotificationPreferencesRepository interface {
+	GetNotificationPreferences(ctx context.Context, userID string) (NotificationPreferences, bool, error)
+	ListNotificationPreferencesByUsers(ctx context.Context, userIDs []string) ([]NotificationPreferences, error)
+	UpsertNotificationPreferences(ctx context.Context, prefs NotificationPreferences) error
+}
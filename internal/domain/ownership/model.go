@@ -0,0 +1,22 @@
+package ownership
+
+import "time"
+
+// PlayerGameweek is how managers of a league held one player when a gameweek
+// locked. Percentages are relative to TotalManagers; transfers compare the
+// squad snapshot with the one of the previous gameweek.
+type PlayerGameweek struct {
+	LeagueID        string
+	Gameweek        int
+	PlayerID        string
+	TotalManagers   int
+	SelectedCount   int
+	CaptainCount    int
+	StarterCount    int
+	TransfersIn     int
+	TransfersOut    int
+	SelectedPercent float64
+	CaptainPercent  float64
+	StarterPercent  float64
+	ComputedAt      time.Time
+}
@@ -0,0 +1,10 @@
+package ownership
+
+import "context"
+
+type Repository interface {
+	// ReplaceGameweek swaps every row of a league gameweek with items.
+	ReplaceGameweek(ctx context.Context, leagueID string, gameweek int, items []PlayerGameweek) error
+	ListByLeagueGameweek(ctx context.Context, leagueID string, gameweek int) ([]PlayerGameweek, error)
+	GetLatestGameweek(ctx context.Context, leagueID string) (int, bool, error)
+}
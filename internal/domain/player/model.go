@@ -1,6 +1,9 @@
 package player
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // Position represents football position categories used in fantasy rules.
 type Position string
@@ -19,6 +22,32 @@ var AllPositions = map[Position]struct{}{
 	PositionForward:    {},
 }
 
+// MarketSort selects the ordering of a transfer market search.
+type MarketSort string
+
+const (
+	// MarketSortDefault keeps the catalog order of the league.
+	MarketSortDefault     MarketSort = ""
+	MarketSortPrice       MarketSort = "price"
+	MarketSortTotalPoints MarketSort = "total_points"
+	MarketSortForm        MarketSort = "form"
+	MarketSortOwnership   MarketSort = "ownership"
+)
+
+var AllMarketSorts = map[MarketSort]struct{}{
+	MarketSortPrice:       {},
+	MarketSortTotalPoints: {},
+	MarketSortForm:        {},
+	MarketSortOwnership:   {},
+}
+
+// MarketFormWindow is the number of latest appearances averaged into form.
+const MarketFormWindow = 5
+
+// ErrInvalidCursor is returned when a market cursor cannot be decoded or was
+// issued for a different ordering.
+var ErrInvalidCursor = errors.New("invalid market cursor")
+
 // Player is a selectable athlete in a fantasy league pool.
 type Player struct {
 	ID          string
@@ -29,6 +58,13 @@ type Player struct {
 	Price       int64
 	ImageURL    string
 	PlayerRefID int64
+	RefSource   string
+	// Hidden players are left out of public listings and the market but stay
+	// in squads that already picked them.
+	Hidden bool
+	// Unavailable is set by an admin override; the market reports it as
+	// MarketPlayer.Available.
+	Unavailable bool
 }
 
 func (p Player) Validate() error {
@@ -53,3 +89,37 @@ func (p Player) Validate() error {
 
 	return nil
 }
+
+// MarketQuery filters, orders and pages the transfer market of one league.
+// Zero values disable a filter. Limit zero returns every matching player.
+type MarketQuery struct {
+	LeagueID      string
+	Positions     []Position
+	TeamIDs       []string
+	MinPrice      int64
+	MaxPrice      int64
+	Name          string
+	AvailableOnly bool
+	MinMinutes    int
+	Sort          MarketSort
+	Descending    bool
+	Cursor        string
+	Limit         int
+}
+
+// MarketPlayer is a player enriched with the season numbers the transfer
+// market filters and sorts on.
+type MarketPlayer struct {
+	Player
+	Available        bool
+	TotalPoints      int
+	MinutesPlayed    int
+	Form             float64
+	OwnershipPercent float64
+}
+
+// MarketPage is one page of market results. NextCursor is empty on the last page.
+type MarketPage struct {
+	Items      []MarketPlayer
+	NextCursor string
+}
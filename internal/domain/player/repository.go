@@ -6,4 +6,5 @@ import "context"
 type Repository interface {
 	ListByLeague(ctx context.Context, leagueID string) ([]Player, error)
 	GetByIDs(ctx context.Context, leagueID string, playerIDs []string) ([]Player, error)
+	SearchMarket(ctx context.Context, query MarketQuery) (MarketPage, error)
 }
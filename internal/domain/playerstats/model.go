@@ -10,10 +10,18 @@ type SeasonStats struct {
 	YellowCards   int
 	RedCards      int
 	Saves         int
+	BonusPoints   int
 	Appearances   int
 	TotalPoints   int
 }
 
+// PlayerTotals is the SeasonStats of one player of a league.
+type PlayerTotals struct {
+	PlayerID string
+	TeamID   string
+	SeasonStats
+}
+
 type MatchHistory struct {
 	FixtureID     string
 	Gameweek      int
@@ -62,6 +70,7 @@ type FixtureStat struct {
 	YellowCards       int
 	RedCards          int
 	Saves             int
+	BonusPoints       int
 	FantasyPoints     int
 	AdvancedStats     map[string]any
 }
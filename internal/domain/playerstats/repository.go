@@ -10,4 +10,7 @@ type Repository interface {
 	UpsertFixtureStats(ctx context.Context, fixtureID string, stats []FixtureStat) error
 	ReplaceFixtureEvents(ctx context.Context, fixtureID string, events []FixtureEvent) error
 	GetFantasyPointsByLeagueAndGameweek(ctx context.Context, leagueID string, gameweek int) (map[string]int, error)
+	// ListPlayerTotalsByLeague sums the fixture stats of every player between
+	// two gameweeks, both inclusive. A zero bound leaves that side open.
+	ListPlayerTotalsByLeague(ctx context.Context, leagueID string, fromGameweek, toGameweek int) ([]PlayerTotals, error)
 }
@@ -23,6 +23,20 @@ type UserGameweekPoints struct {
 	CalculatedAt time.Time
 }
 
+// GameweekSummary holds league-wide stats of one gameweek, recomputed with
+// the user points. Managers counts lineup snapshots of the gameweek.
+type GameweekSummary struct {
+	LeagueID              string
+	Gameweek              int
+	Managers              int
+	AveragePoints         float64
+	HighestPoints         int
+	HighestUserID         string
+	MostCaptainedPlayerID string
+	MostCaptainedCount    int
+	CalculatedAt          time.Time
+}
+
 type SquadSnapshot struct {
 	LeagueID   string
 	Gameweek   int
@@ -9,6 +9,7 @@ type Repository interface {
 
 	GetSquadSnapshot(ctx context.Context, leagueID string, gameweek int, userID string) (SquadSnapshot, bool, error)
 	UpsertSquadSnapshot(ctx context.Context, snapshot SquadSnapshot) error
+	ListSquadSnapshotsByLeagueGameweek(ctx context.Context, leagueID string, gameweek int) ([]SquadSnapshot, error)
 
 	GetLineupSnapshot(ctx context.Context, leagueID string, gameweek int, userID string) (LineupSnapshot, bool, error)
 	UpsertLineupSnapshot(ctx context.Context, snapshot LineupSnapshot) error
@@ -17,4 +18,7 @@ type Repository interface {
 
 	UpsertUserGameweekPoints(ctx context.Context, points UserGameweekPoints) error
 	ListUserGameweekPointsByLeague(ctx context.Context, leagueID string) ([]UserGameweekPoints, error)
+
+	UpsertGameweekSummary(ctx context.Context, summary GameweekSummary) error
+	ListGameweekSummariesByLeague(ctx context.Context, leagueID string) ([]GameweekSummary, error)
 }
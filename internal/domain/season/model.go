@@ -0,0 +1,69 @@
+package season
+
+import (
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+)
+
+// Rollover records a league moving from a finished season to the next one.
+// The counters describe the previous season rows kept as read-only history.
+type Rollover struct {
+	ID                   string
+	FromLeagueID         string
+	ToLeagueID           string
+	FromSeason           string
+	ToSeason             string
+	SeasonRefID          int64
+	ArchivedSquads       int
+	ArchivedLineups      int
+	ArchivedPoints       int
+	ArchivedStandings    int
+	CarriedCustomLeagues int
+	CreatedAt            time.Time
+}
+
+// CarriedGroup is a custom league recreated for the new season.
+type CarriedGroup struct {
+	PreviousGroupID string
+	Group           customleague.Group
+}
+
+// RolloverPlan holds everything written atomically by Repository.Rollover.
+type RolloverPlan struct {
+	RolloverID string
+	From       league.League
+	To         league.League
+	Groups     []CarriedGroup
+}
+
+// UserSummary is a user's final result for one league season, computed once
+// the season ends so history does not need to rescan every gameweek.
+type UserSummary struct {
+	LeagueID           string
+	Season             string
+	UserID             string
+	SquadID            string
+	SquadName          string
+	TotalPoints        int
+	OverallRank        int
+	TotalManagers      int
+	GameweeksPlayed    int
+	BestGameweek       int
+	BestGameweekPoints int
+	FinalPicks         []fantasy.SquadPick
+	CustomLeagues      []CustomLeagueFinish
+	ComputedAt         time.Time
+}
+
+// CustomLeagueFinish is the user's final position in one custom league.
+type CustomLeagueFinish struct {
+	GroupID   string
+	Name      string
+	IsDefault bool
+	Rank      int
+	Points    int
+	Members   int
+}
@@ -0,0 +1,10 @@
+package season
+
+import "context"
+
+type Repository interface {
+	Rollover(ctx context.Context, plan RolloverPlan) (Rollover, error)
+	GetRolloverByFromLeague(ctx context.Context, leagueID string) (Rollover, bool, error)
+	UpsertUserSummaries(ctx context.Context, items []UserSummary) error
+	ListUserSummariesByUser(ctx context.Context, userID string) ([]UserSummary, error)
+}
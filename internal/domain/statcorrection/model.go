@@ -0,0 +1,41 @@
+package statcorrection
+
+import (
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+)
+
+// Correction is one manual fix of provider fixture stats. The corrected
+// stats are kept as overrides that provider syncs do not overwrite.
+// PointsBefore holds the gameweek points of every user when the correction
+// was saved, and RescoredAt stays nil until the rescore has been recorded.
+type Correction struct {
+	ID           int64
+	LeagueID     string
+	FixtureID    string
+	Gameweek     int
+	Reason       string
+	CreatedBy    string
+	TraceID      string
+	Players      []PlayerChange
+	PointsBefore map[string]int
+	UserPoints   []UserPointsChange
+	RescoredAt   *time.Time
+	CreatedAt    time.Time
+}
+
+// PlayerChange holds the stats of one player before and after a correction.
+type PlayerChange struct {
+	PlayerID string
+	Before   playerstats.FixtureStat
+	After    playerstats.FixtureStat
+}
+
+// UserPointsChange is the gameweek score of one user before and after the
+// rescoring a correction caused. Only users whose score changed are kept.
+type UserPointsChange struct {
+	UserID string
+	Before int
+	After  int
+}
@@ -0,0 +1,14 @@
+package statcorrection
+
+import "context"
+
+// Repository stores corrections. Save writes the correction, its overrides
+// and the corrected player_fixture_stats rows in one transaction.
+// SaveUserPoints replaces the user points of a correction and marks it
+// rescored, so calling it again for the same correction is safe.
+type Repository interface {
+	Save(ctx context.Context, item Correction) (Correction, error)
+	SaveUserPoints(ctx context.Context, correctionID int64, items []UserPointsChange) error
+	ListByFixture(ctx context.Context, leagueID, fixtureID string) ([]Correction, error)
+	ListPending(ctx context.Context, leagueID string) ([]Correction, error)
+}
@@ -99,3 +99,34 @@ func (v PlayerValue) Validate() error {
 	}
 	return nil
 }
+
+// PlayerValueFilter selects season level player stat values of one league.
+// Empty fields match every row.
+type PlayerValueFilter struct {
+	LeagueID    string
+	SeasonRefID int64
+	PlayerIDs   []string
+	StatKeys    []string
+	Scope       string
+}
+
+// TeamValueFilter selects season level team stat values of one league.
+// Empty fields match every row.
+type TeamValueFilter struct {
+	LeagueID    string
+	SeasonRefID int64
+	TeamIDs     []string
+	StatKeys    []string
+	Scope       string
+}
+
+// RankQuery orders the season level numeric values of one stat key across a
+// league, highest first unless Ascending is set.
+type RankQuery struct {
+	LeagueID    string
+	SeasonRefID int64
+	StatKey     string
+	Scope       string
+	Ascending   bool
+	Limit       int
+}
@@ -6,4 +6,9 @@ type Repository interface {
 	UpsertTypes(ctx context.Context, items []Type) error
 	UpsertTeamValues(ctx context.Context, items []TeamValue) error
 	UpsertPlayerValues(ctx context.Context, items []PlayerValue) error
+	ListTypes(ctx context.Context) ([]Type, error)
+	ListPlayerValues(ctx context.Context, filter PlayerValueFilter) ([]PlayerValue, error)
+	ListTeamValues(ctx context.Context, filter TeamValueFilter) ([]TeamValue, error)
+	RankPlayerValues(ctx context.Context, query RankQuery) ([]PlayerValue, error)
+	RankTeamValues(ctx context.Context, query RankQuery) ([]TeamValue, error)
 }
@@ -0,0 +1,31 @@
+package systemstatus
+
+import "time"
+
+// Database is a point-in-time view of the Postgres connection pool and the
+// schema migration state recorded by golang-migrate.
+type Database struct {
+	PingLatency     time.Duration
+	OpenConnections int
+	InUse           int
+	Idle            int
+	MaxOpen         int
+	WaitCount       int64
+	WaitDuration    time.Duration
+	// MigrationVersion is zero when no migration has been applied.
+	MigrationVersion int64
+	MigrationDirty   bool
+}
+
+// Activity counts the fantasy data exported as business gauges.
+type Activity struct {
+	SquadsByLeague map[string]int
+	Lineups        []GameweekLineups
+}
+
+// GameweekLineups is the number of lineups snapshotted for a gameweek.
+type GameweekLineups struct {
+	LeagueID string
+	Gameweek int
+	Count    int
+}
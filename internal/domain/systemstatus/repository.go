@@ -0,0 +1,9 @@
+package systemstatus
+
+import "context"
+
+type Repository interface {
+	// DatabaseStatus pings the database and fails when it is unreachable.
+	DatabaseStatus(ctx context.Context) (Database, error)
+	CountActivity(ctx context.Context) (Activity, error)
+}
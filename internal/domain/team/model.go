@@ -12,6 +12,9 @@ type Team struct {
 	PrimaryColor   string
 	SecondaryColor string
 	TeamRefID      int64
+	RefSource      string
+	// Hidden teams are left out of public team listings and the market.
+	Hidden bool
 }
 
 func (t Team) Validate() error {
@@ -0,0 +1,18 @@
+package user
+
+import "context"
+
+type contextKey string
+
+const principalContextKey contextKey = "auth_principal"
+
+// WithPrincipal stores the authenticated principal so usecases can attribute
+// the changes they make.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey, p)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalContextKey).(Principal)
+	return p, ok
+}
@@ -7,13 +7,21 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/account"
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/awards"
 	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixturedifficulty"
 	"github.com/riskibarqy/fantasy-league/internal/domain/league"
 	"github.com/riskibarqy/fantasy-league/internal/domain/lineup"
+	"github.com/riskibarqy/fantasy-league/internal/domain/masterdata"
+	"github.com/riskibarqy/fantasy-league/internal/domain/ownership"
 	"github.com/riskibarqy/fantasy-league/internal/domain/player"
 	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/season"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statcorrection"
 	"github.com/riskibarqy/fantasy-league/internal/domain/team"
 	"github.com/riskibarqy/fantasy-league/internal/domain/teamstats"
 	basecache "github.com/riskibarqy/fantasy-league/internal/platform/cache"
@@ -66,6 +74,215 @@ type cachedLeagueByID struct {
 	exists bool
 }
 
+// SeasonRepository drops league and custom league entries after a rollover,
+// since it archives one league and creates another together with its groups.
+// User season summaries are cached per user and dropped whenever a league
+// season is summarized.
+type SeasonRepository struct {
+	next  season.Repository
+	cache *basecache.Store
+}
+
+func NewSeasonRepository(next season.Repository, cache *basecache.Store) *SeasonRepository {
+	return &SeasonRepository{next: next, cache: cache}
+}
+
+func (r *SeasonRepository) Rollover(ctx context.Context, plan season.RolloverPlan) (season.Rollover, error) {
+	item, err := r.next.Rollover(ctx, plan)
+	if err != nil {
+		return season.Rollover{}, err
+	}
+
+	r.cache.DeletePrefix(ctx, "league:")
+	r.cache.DeletePrefix(ctx, "custom-league:")
+	return item, nil
+}
+
+func (r *SeasonRepository) GetRolloverByFromLeague(ctx context.Context, leagueID string) (season.Rollover, bool, error) {
+	return r.next.GetRolloverByFromLeague(ctx, leagueID)
+}
+
+func (r *SeasonRepository) UpsertUserSummaries(ctx context.Context, items []season.UserSummary) error {
+	if err := r.next.UpsertUserSummaries(ctx, items); err != nil {
+		return err
+	}
+
+	r.cache.DeletePrefix(ctx, "season-summary:user:")
+	return nil
+}
+
+func (r *SeasonRepository) ListUserSummariesByUser(ctx context.Context, userID string) ([]season.UserSummary, error) {
+	key := "season-summary:user:" + userID
+	v, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) (any, error) {
+		items, err := r.next.ListUserSummariesByUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		return append([]season.UserSummary(nil), items...), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := v.([]season.UserSummary)
+	return append([]season.UserSummary(nil), items...), nil
+}
+
+type OwnershipRepository struct {
+	next  ownership.Repository
+	cache *basecache.Store
+}
+
+type cachedOwnershipGameweek struct {
+	value  int
+	exists bool
+}
+
+func NewOwnershipRepository(next ownership.Repository, cache *basecache.Store) *OwnershipRepository {
+	return &OwnershipRepository{next: next, cache: cache}
+}
+
+func (r *OwnershipRepository) ReplaceGameweek(ctx context.Context, leagueID string, gameweek int, items []ownership.PlayerGameweek) error {
+	if err := r.next.ReplaceGameweek(ctx, leagueID, gameweek, items); err != nil {
+		return err
+	}
+
+	r.cache.DeletePrefix(ctx, "ownership:"+leagueID+":")
+	return nil
+}
+
+func (r *OwnershipRepository) ListByLeagueGameweek(ctx context.Context, leagueID string, gameweek int) ([]ownership.PlayerGameweek, error) {
+	key := "ownership:" + leagueID + ":gw:" + strconv.Itoa(gameweek)
+	v, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) (any, error) {
+		items, err := r.next.ListByLeagueGameweek(ctx, leagueID, gameweek)
+		if err != nil {
+			return nil, err
+		}
+		return append([]ownership.PlayerGameweek(nil), items...), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := v.([]ownership.PlayerGameweek)
+	return append([]ownership.PlayerGameweek(nil), items...), nil
+}
+
+func (r *OwnershipRepository) GetLatestGameweek(ctx context.Context, leagueID string) (int, bool, error) {
+	key := "ownership:" + leagueID + ":latest"
+	v, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) (any, error) {
+		gameweek, exists, err := r.next.GetLatestGameweek(ctx, leagueID)
+		if err != nil {
+			return nil, err
+		}
+		return cachedOwnershipGameweek{value: gameweek, exists: exists}, nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	item, _ := v.(cachedOwnershipGameweek)
+	return item.value, item.exists, nil
+}
+
+type AwardsRepository struct {
+	next  awards.Repository
+	cache *basecache.Store
+}
+
+type cachedGameweekAwards struct {
+	value  awards.GameweekAwards
+	exists bool
+}
+
+func NewAwardsRepository(next awards.Repository, cache *basecache.Store) *AwardsRepository {
+	return &AwardsRepository{next: next, cache: cache}
+}
+
+func (r *AwardsRepository) Replace(ctx context.Context, item awards.GameweekAwards) error {
+	if err := r.next.Replace(ctx, item); err != nil {
+		return err
+	}
+
+	r.cache.DeletePrefix(ctx, "awards:"+item.LeagueID+":")
+	return nil
+}
+
+func (r *AwardsRepository) GetByLeagueGameweek(ctx context.Context, leagueID string, gameweek int) (awards.GameweekAwards, bool, error) {
+	key := "awards:" + leagueID + ":gw:" + strconv.Itoa(gameweek)
+	v, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) (any, error) {
+		item, exists, err := r.next.GetByLeagueGameweek(ctx, leagueID, gameweek)
+		if err != nil {
+			return nil, err
+		}
+		return cachedGameweekAwards{value: copyGameweekAwards(item), exists: exists}, nil
+	})
+	if err != nil {
+		return awards.GameweekAwards{}, false, err
+	}
+
+	item, _ := v.(cachedGameweekAwards)
+	return copyGameweekAwards(item.value), item.exists, nil
+}
+
+func (r *AwardsRepository) ListGameweeksByLeague(ctx context.Context, leagueID string) ([]int, error) {
+	key := "awards:" + leagueID + ":gameweeks"
+	v, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) (any, error) {
+		items, err := r.next.ListGameweeksByLeague(ctx, leagueID)
+		if err != nil {
+			return nil, err
+		}
+		return append([]int(nil), items...), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := v.([]int)
+	return append([]int(nil), items...), nil
+}
+
+func copyGameweekAwards(item awards.GameweekAwards) awards.GameweekAwards {
+	item.DreamTeam = append([]awards.DreamTeamPlayer(nil), item.DreamTeam...)
+	item.SeasonDreamTeam = append([]awards.DreamTeamPlayer(nil), item.SeasonDreamTeam...)
+	return item
+}
+
+type FixtureDifficultyRepository struct {
+	next  fixturedifficulty.Repository
+	cache *basecache.Store
+}
+
+func NewFixtureDifficultyRepository(next fixturedifficulty.Repository, cache *basecache.Store) *FixtureDifficultyRepository {
+	return &FixtureDifficultyRepository{next: next, cache: cache}
+}
+
+func (r *FixtureDifficultyRepository) ReplaceByLeague(ctx context.Context, leagueID string, items []fixturedifficulty.TeamStrength) error {
+	if err := r.next.ReplaceByLeague(ctx, leagueID, items); err != nil {
+		return err
+	}
+
+	r.cache.Delete(ctx, "fixture-difficulty:"+leagueID)
+	return nil
+}
+
+func (r *FixtureDifficultyRepository) ListByLeague(ctx context.Context, leagueID string) ([]fixturedifficulty.TeamStrength, error) {
+	key := "fixture-difficulty:" + leagueID
+	v, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) (any, error) {
+		items, err := r.next.ListByLeague(ctx, leagueID)
+		if err != nil {
+			return nil, err
+		}
+		return append([]fixturedifficulty.TeamStrength(nil), items...), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := v.([]fixturedifficulty.TeamStrength)
+	return append([]fixturedifficulty.TeamStrength(nil), items...), nil
+}
+
 type TeamRepository struct {
 	next  team.Repository
 	cache *basecache.Store
@@ -191,6 +408,12 @@ func (r *PlayerRepository) GetByIDs(ctx context.Context, leagueID string, player
 	return append([]player.Player(nil), items...), nil
 }
 
+// SearchMarket is not cached: filter combinations rarely repeat and the
+// result depends on live ownership.
+func (r *PlayerRepository) SearchMarket(ctx context.Context, query player.MarketQuery) (player.MarketPage, error) {
+	return r.next.SearchMarket(ctx, query)
+}
+
 func (r *PlayerRepository) UpsertPlayers(ctx context.Context, items []player.Player) error {
 	writer, ok := r.next.(playerIngestionWriter)
 	if !ok {
@@ -216,6 +439,50 @@ func (r *PlayerRepository) UpsertPlayers(ctx context.Context, items []player.Pla
 	return nil
 }
 
+// MasterDataRepository drops the league, team and player entries an admin
+// change rewrites. Overrides themselves are read uncached by admin and sync.
+type MasterDataRepository struct {
+	next  masterdata.Repository
+	cache *basecache.Store
+}
+
+func NewMasterDataRepository(next masterdata.Repository, cache *basecache.Store) *MasterDataRepository {
+	return &MasterDataRepository{next: next, cache: cache}
+}
+
+func (r *MasterDataRepository) ListOverrides(ctx context.Context, leagueID string) (masterdata.Overrides, error) {
+	return r.next.ListOverrides(ctx, leagueID)
+}
+
+func (r *MasterDataRepository) SaveTeamOverride(ctx context.Context, item masterdata.TeamOverride, entry auditlog.Entry) error {
+	if err := r.next.SaveTeamOverride(ctx, item, entry); err != nil {
+		return err
+	}
+
+	r.cache.Delete(ctx, "team:list:"+item.LeagueID)
+	r.cache.Delete(ctx, "team:id:"+item.LeagueID+":"+item.TeamID)
+	return nil
+}
+
+func (r *MasterDataRepository) SavePlayerOverride(ctx context.Context, item masterdata.PlayerOverride, entry auditlog.Entry) error {
+	if err := r.next.SavePlayerOverride(ctx, item, entry); err != nil {
+		return err
+	}
+
+	r.cache.Delete(ctx, "player:list:"+item.LeagueID)
+	r.cache.DeletePrefix(ctx, "player:ids:"+item.LeagueID+":")
+	return nil
+}
+
+func (r *MasterDataRepository) SaveLeague(ctx context.Context, item league.League, entry auditlog.Entry) error {
+	if err := r.next.SaveLeague(ctx, item, entry); err != nil {
+		return err
+	}
+
+	r.cache.DeletePrefix(ctx, "league:")
+	return nil
+}
+
 type FixtureRepository struct {
 	next  fixture.Repository
 	cache *basecache.Store
@@ -558,6 +825,83 @@ func (r *PlayerStatsRepository) GetFantasyPointsByLeagueAndGameweek(ctx context.
 	return r.next.GetFantasyPointsByLeagueAndGameweek(ctx, leagueID, gameweek)
 }
 
+func (r *PlayerStatsRepository) ListPlayerTotalsByLeague(ctx context.Context, leagueID string, fromGameweek, toGameweek int) ([]playerstats.PlayerTotals, error) {
+	key := "player-stats:totals:" + leagueID + ":" + strconv.Itoa(fromGameweek) + ":" + strconv.Itoa(toGameweek)
+	v, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) (any, error) {
+		items, err := r.next.ListPlayerTotalsByLeague(ctx, leagueID, fromGameweek, toGameweek)
+		if err != nil {
+			return nil, err
+		}
+		return items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := v.([]playerstats.PlayerTotals)
+	return append([]playerstats.PlayerTotals(nil), items...), nil
+}
+
+// StatCorrectionRepository drops cached player stats once a correction
+// rewrites fixture rows. Corrections themselves are read uncached.
+type StatCorrectionRepository struct {
+	next  statcorrection.Repository
+	cache *basecache.Store
+}
+
+func NewStatCorrectionRepository(next statcorrection.Repository, cache *basecache.Store) *StatCorrectionRepository {
+	return &StatCorrectionRepository{next: next, cache: cache}
+}
+
+func (r *StatCorrectionRepository) Save(ctx context.Context, item statcorrection.Correction) (statcorrection.Correction, error) {
+	out, err := r.next.Save(ctx, item)
+	if err != nil {
+		return statcorrection.Correction{}, err
+	}
+	r.cache.DeletePrefix(ctx, "player-stats:")
+	return out, nil
+}
+
+func (r *StatCorrectionRepository) SaveUserPoints(ctx context.Context, correctionID int64, items []statcorrection.UserPointsChange) error {
+	return r.next.SaveUserPoints(ctx, correctionID, items)
+}
+
+func (r *StatCorrectionRepository) ListByFixture(ctx context.Context, leagueID, fixtureID string) ([]statcorrection.Correction, error) {
+	return r.next.ListByFixture(ctx, leagueID, fixtureID)
+}
+
+func (r *StatCorrectionRepository) ListPending(ctx context.Context, leagueID string) ([]statcorrection.Correction, error) {
+	return r.next.ListPending(ctx, leagueID)
+}
+
+// AccountRepository drops every per-user entry once an account is deleted.
+// Exports are read uncached.
+type AccountRepository struct {
+	next  account.Repository
+	cache *basecache.Store
+}
+
+func NewAccountRepository(next account.Repository, cache *basecache.Store) *AccountRepository {
+	return &AccountRepository{next: next, cache: cache}
+}
+
+func (r *AccountRepository) ExportUserData(ctx context.Context, userID string) (map[string][]map[string]any, error) {
+	return r.next.ExportUserData(ctx, userID)
+}
+
+func (r *AccountRepository) DeleteUserData(ctx context.Context, plan account.DeletionPlan) (map[string]int64, error) {
+	out, err := r.next.DeleteUserData(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.DeletePrefix(ctx, "squad:")
+	r.cache.DeletePrefix(ctx, "lineup:")
+	r.cache.DeletePrefix(ctx, "custom-league:")
+	r.cache.DeletePrefix(ctx, "season-summary:user:"+plan.UserID)
+	return out, nil
+}
+
 type TeamStatsRepository struct {
 	next  teamstats.Repository
 	cache *basecache.Store
@@ -749,6 +1093,12 @@ func (r *CustomLeagueRepository) ListGroupsByUser(ctx context.Context, userID st
 	return append([]customleague.Group(nil), items...), nil
 }
 
+// ListCarriedOverGroupsByUser is only used when a squad is first created in a
+// league, so it goes straight to the underlying repository.
+func (r *CustomLeagueRepository) ListCarriedOverGroupsByUser(ctx context.Context, leagueID, userID string) ([]customleague.Group, error) {
+	return r.next.ListCarriedOverGroupsByUser(ctx, leagueID, userID)
+}
+
 func (r *CustomLeagueRepository) ListMembershipsByGroup(ctx context.Context, groupID string) ([]customleague.Membership, error) {
 	key := "custom-league:members:group:" + groupID
 	v, err := r.cache.GetOrLoad(ctx, key, func(ctx context.Context) (any, error) {
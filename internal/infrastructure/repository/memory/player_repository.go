@@ -2,10 +2,18 @@ package memory
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"unicode"
 
 	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 type PlayerRepository struct {
@@ -60,6 +68,96 @@ func (r *PlayerRepository) GetByIDs(_ context.Context, leagueID string, playerID
 	return out, nil
 }
 
+// SearchMarket filters the in-memory catalog. Memory keeps no fixture stats or
+// squads, so points, minutes, form and ownership are zero and every player is
+// available; ties keep catalog order. The cursor is an offset.
+func (r *PlayerRepository) SearchMarket(_ context.Context, query player.MarketQuery) (player.MarketPage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	offset := 0
+	if query.Cursor != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(query.Cursor)
+		if err != nil {
+			return player.MarketPage{}, fmt.Errorf("%w: %v", player.ErrInvalidCursor, err)
+		}
+		offset, err = strconv.Atoi(string(raw))
+		if err != nil || offset < 0 {
+			return player.MarketPage{}, fmt.Errorf("%w: bad offset", player.ErrInvalidCursor)
+		}
+	}
+
+	positions := make(map[player.Position]struct{}, len(query.Positions))
+	for _, position := range query.Positions {
+		positions[position] = struct{}{}
+	}
+	teams := make(map[string]struct{}, len(query.TeamIDs))
+	for _, teamID := range query.TeamIDs {
+		teams[teamID] = struct{}{}
+	}
+	name := foldPlayerName(query.Name)
+
+	matched := make([]player.MarketPlayer, 0)
+	for _, p := range r.playersByLeague[query.LeagueID] {
+		if p.Hidden {
+			continue
+		}
+		if _, ok := positions[p.Position]; len(positions) > 0 && !ok {
+			continue
+		}
+		if _, ok := teams[p.TeamID]; len(teams) > 0 && !ok {
+			continue
+		}
+		if query.MinPrice > 0 && p.Price < query.MinPrice {
+			continue
+		}
+		if query.MaxPrice > 0 && p.Price > query.MaxPrice {
+			continue
+		}
+		if name != "" && !strings.Contains(foldPlayerName(p.Name), name) {
+			continue
+		}
+		if query.MinMinutes > 0 {
+			continue
+		}
+		if query.AvailableOnly && p.Unavailable {
+			continue
+		}
+		matched = append(matched, player.MarketPlayer{Player: p, Available: !p.Unavailable})
+	}
+
+	if query.Sort == player.MarketSortPrice {
+		sort.SliceStable(matched, func(i, j int) bool {
+			if query.Descending {
+				return matched[i].Price > matched[j].Price
+			}
+			return matched[i].Price < matched[j].Price
+		})
+	}
+
+	if offset > len(matched) {
+		offset = len(matched)
+	}
+	matched = matched[offset:]
+
+	page := player.MarketPage{}
+	if query.Limit > 0 && len(matched) > query.Limit {
+		matched = matched[:query.Limit]
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset + query.Limit)))
+	}
+	page.Items = matched
+
+	return page, nil
+}
+
+func foldPlayerName(value string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), value)
+	if err != nil {
+		folded = value
+	}
+	return strings.ToLower(strings.TrimSpace(folded))
+}
+
 func (r *PlayerRepository) UpsertPlayers(_ context.Context, items []player.Player) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
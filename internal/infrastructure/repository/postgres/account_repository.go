@@ -0,0 +1,274 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/riskibarqy/fantasy-league/internal/domain/account"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+// userDataSource selects the rows of one table that belong to a user. The
+// table is aliased as t.
+type userDataSource struct {
+	table string
+	where string
+}
+
+var userDataSources = []userDataSource{
+	{table: "user_onboarding_profiles", where: "t.user_id = ?"},
+	{table: "user_notification_preferences", where: "t.user_id = ?"},
+	{table: "notification_deliveries", where: "t.user_id = ?"},
+	{table: "fantasy_squads", where: "t.user_id = ?"},
+	{table: "fantasy_squad_picks", where: "t.squad_public_id IN (SELECT public_id FROM fantasy_squads WHERE user_id = ?)"},
+	{table: "fantasy_squad_snapshots", where: "t.user_id = ?"},
+	{table: "fantasy_squad_snapshot_picks", where: "t.squad_snapshot_id IN (SELECT id FROM fantasy_squad_snapshots WHERE user_id = ?)"},
+	{table: "lineups", where: "t.user_id = ?"},
+	{table: "lineup_snapshots", where: "t.user_id = ?"},
+	{table: "user_gameweek_points", where: "t.user_id = ?"},
+	{table: "user_season_summaries", where: "t.user_id = ?"},
+	{table: "custom_leagues", where: "t.owner_user_id = ?"},
+	{table: "custom_league_members", where: "t.user_id = ?"},
+	{table: "custom_league_standings", where: "t.user_id = ?"},
+	{table: "stat_correction_user_points", where: "t.user_id = ?"},
+	{table: "audit_logs", where: "t.actor_user_id = ?"},
+	{table: "idempotency_keys", where: "t.user_id = ?"},
+}
+
+// userDataDeletes run in order; members and standings go before squads
+// because they reference them without cascading.
+var userDataDeletes = []string{
+	"custom_league_standings",
+	"custom_league_members",
+	"lineups",
+	"lineup_snapshots",
+	"fantasy_squad_snapshots",
+	"fantasy_squads",
+	"user_gameweek_points",
+	"user_season_summaries",
+	"user_onboarding_profiles",
+	"user_notification_preferences",
+	"notification_deliveries",
+	"stat_correction_user_points",
+	"idempotency_keys",
+}
+
+// userReferenceColumns are rows about other things that only point at the
+// user; deletion clears the column and keeps the row.
+var userReferenceColumns = map[string]string{
+	"gameweek_awards":    "top_manager_user_id",
+	"gameweek_summaries": "highest_user_id",
+}
+
+type AccountRepository struct {
+	db *sqlx.DB
+}
+
+func NewAccountRepository(db *sqlx.DB) *AccountRepository {
+	return &AccountRepository{db: db}
+}
+
+func (r *AccountRepository) ExportUserData(ctx context.Context, userID string) (map[string][]map[string]any, error) {
+	out := make(map[string][]map[string]any, len(userDataSources))
+	for _, source := range userDataSources {
+		query, args, err := qb.Select("to_jsonb(t)::text").
+			From(source.table + " t").
+			Where(qb.Expr(source.where, userID)).
+			OrderBy("t.id").
+			ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("build export %s query: %w", source.table, err)
+		}
+
+		var rows []string
+		if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+			return nil, fmt.Errorf("export %s: %w", source.table, err)
+		}
+		items := make([]map[string]any, 0, len(rows))
+		for _, raw := range rows {
+			var item map[string]any
+			if err := sonic.Unmarshal([]byte(raw), &item); err != nil {
+				return nil, fmt.Errorf("decode exported %s row: %w", source.table, err)
+			}
+			items = append(items, item)
+		}
+		out[source.table] = items
+	}
+	return out, nil
+}
+
+func (r *AccountRepository) DeleteUserData(ctx context.Context, plan account.DeletionPlan) (map[string]int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin delete user data tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	affected := make(map[string]int64)
+	exec := func(table, query string, args []any) error {
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		affected[table] += n
+		return nil
+	}
+
+	for groupID, ownerUserID := range plan.NewOwners {
+		query, args, err := qb.Update("custom_leagues").
+			Set("owner_user_id", ownerUserID).
+			Where(
+				qb.Eq("public_id", groupID),
+				qb.Eq("owner_user_id", plan.UserID),
+			).
+			ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("build reassign custom league query: %w", err)
+		}
+		if err := exec("custom_leagues", query, args); err != nil {
+			return nil, fmt.Errorf("reassign custom league=%s: %w", groupID, err)
+		}
+	}
+	if len(plan.ClosedGroupIDs) > 0 {
+		groupIDs := make([]any, 0, len(plan.ClosedGroupIDs))
+		for _, groupID := range plan.ClosedGroupIDs {
+			groupIDs = append(groupIDs, groupID)
+		}
+		query, args, err := qb.Update("custom_leagues").
+			SetExpr("deleted_at", "NOW()").
+			Where(
+				qb.In("public_id", groupIDs),
+				qb.IsNull("deleted_at"),
+			).
+			ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("build close custom leagues query: %w", err)
+		}
+		if err := exec("custom_leagues", query, args); err != nil {
+			return nil, fmt.Errorf("close custom leagues: %w", err)
+		}
+	}
+	// Anything still owned by the user, closed or missed by the plan, keeps
+	// its row but loses the user id.
+	query, args, err := qb.Update("custom_leagues").
+		Set("owner_user_id", account.DeletedUserID).
+		Where(qb.Eq("owner_user_id", plan.UserID)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build anonymize custom leagues query: %w", err)
+	}
+	if err := exec("custom_leagues", query, args); err != nil {
+		return nil, fmt.Errorf("anonymize custom leagues: %w", err)
+	}
+
+	for _, table := range userDataDeletes {
+		query, args, err := qb.DeleteFrom(table).
+			Where(qb.Eq("user_id", plan.UserID)).
+			ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("build delete %s query: %w", table, err)
+		}
+		if err := exec(table, query, args); err != nil {
+			return nil, fmt.Errorf("delete %s: %w", table, err)
+		}
+	}
+
+	// Points of the other members do not change, so only their ranks are
+	// recomputed.
+	if len(plan.RerankGroupIDs) > 0 {
+		query, args, err := rerankStandingsQuery(plan.RerankGroupIDs)
+		if err != nil {
+			return nil, fmt.Errorf("build rerank custom_league_standings query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return nil, fmt.Errorf("rerank custom_league_standings: %w", err)
+		}
+	}
+
+	for table, column := range userReferenceColumns {
+		query, args, err := qb.Update(table).
+			SetExpr(column, "NULL").
+			Where(qb.Eq(column, plan.UserID)).
+			ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("build anonymize %s query: %w", table, err)
+		}
+		if err := exec(table, query, args); err != nil {
+			return nil, fmt.Errorf("anonymize %s: %w", table, err)
+		}
+	}
+
+	// Corrections keep the gameweek points they started from keyed by user.
+	query, args, err = qb.Update("stat_corrections").
+		SetExpr("points_before", "points_before - ?::text", plan.UserID).
+		Where(qb.Expr("jsonb_exists(points_before, ?)", plan.UserID)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build anonymize stat_corrections query: %w", err)
+	}
+	if err := exec("stat_corrections", query, args); err != nil {
+		return nil, fmt.Errorf("anonymize stat_corrections: %w", err)
+	}
+
+	// Audit entries are kept but lose the user id. The append-only trigger
+	// only accepts the rewrite while this setting is on, and it resets when
+	// the transaction ends.
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('fantasy.audit_log_anonymize', 'on', true)"); err != nil {
+		return nil, fmt.Errorf("enable audit log anonymization: %w", err)
+	}
+	query, args, err = anonymizeAuditLogsQuery(plan.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("build anonymize audit_logs query: %w", err)
+	}
+	if err := exec("audit_logs", query, args); err != nil {
+		return nil, fmt.Errorf("anonymize audit_logs: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit delete user data tx: %w", err)
+	}
+	return affected, nil
+}
+
+// rerankStandingsQuery ranks the active standings of the groups by points the
+// way scoring does: equal points share a rank and the next rank follows on.
+// The current rank becomes previous_rank.
+func rerankStandingsQuery(groupIDs []string) (string, []any, error) {
+	return qb.Update("custom_league_standings").
+		SetExpr("previous_rank", "CASE WHEN rank > 0 THEN rank ELSE previous_rank END").
+		SetExpr("rank", `(
+    SELECT COUNT(DISTINCT other.points) + 1
+    FROM custom_league_standings other
+    WHERE other.custom_league_public_id = custom_league_standings.custom_league_public_id
+      AND other.deleted_at IS NULL
+      AND other.points > custom_league_standings.points
+)`).
+		SetExpr("last_calculated_at", "NOW()").
+		Where(
+			qb.Expr("custom_league_public_id = ANY(?)", pq.Array(groupIDs)),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+}
+
+// anonymizeAuditLogsQuery replaces the user id with account.DeletedUserID in
+// the actor, the entity and every JSON string of the before and after data.
+func anonymizeAuditLogsQuery(userID string) (string, []any, error) {
+	return qb.Update("audit_logs").
+		SetExpr("actor_user_id", "CASE WHEN actor_user_id = ? THEN ? ELSE actor_user_id END", userID, account.DeletedUserID).
+		SetExpr("entity_id", "CASE WHEN entity_id = ? THEN ? ELSE entity_id END", userID, account.DeletedUserID).
+		SetExpr("before_data", "replace(before_data::text, to_jsonb(?::text)::text, to_jsonb(?::text)::text)::jsonb", userID, account.DeletedUserID).
+		SetExpr("after_data", "replace(after_data::text, to_jsonb(?::text)::text, to_jsonb(?::text)::text)::jsonb", userID, account.DeletedUserID).
+		Where(qb.Expr(
+			"(actor_user_id = ? OR entity_id = ? OR strpos(before_data::text, to_jsonb(?::text)::text) > 0 OR strpos(after_data::text, to_jsonb(?::text)::text) > 0)",
+			userID, userID, userID, userID,
+		)).
+		ToSQL()
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/account"
+)
+
+func TestRerankStandingsQuery(t *testing.T) {
+	query, args, err := rerankStandingsQuery([]string{"kantor", "friends"})
+	if err != nil {
+		t.Fatalf("build query: %v", err)
+	}
+
+	for _, match := range []string{"previous_rank = CASE WHEN rank > 0", "COUNT(DISTINCT other.points) + 1", "other.points > custom_league_standings.points", "custom_league_public_id = ANY($1)", "deleted_at IS NULL"} {
+		if !strings.Contains(query, match) {
+			t.Fatalf("expected %q in query, got %s", match, query)
+		}
+	}
+	if strings.Contains(query, "?") || len(args) != 1 {
+		t.Fatalf("expected the group ids as the only bound argument, got %s %v", query, args)
+	}
+}
+
+func TestAnonymizeAuditLogsQuery(t *testing.T) {
+	query, args, err := anonymizeAuditLogsQuery("u-1")
+	if err != nil {
+		t.Fatalf("build query: %v", err)
+	}
+
+	for _, column := range []string{"actor_user_id", "entity_id", "before_data", "after_data"} {
+		if !strings.Contains(query, column+" = ") {
+			t.Fatalf("expected %s to be rewritten, got %s", column, query)
+		}
+	}
+	where := query[strings.Index(query, " WHERE "):]
+	for _, match := range []string{"actor_user_id = ", "entity_id = ", "strpos(before_data::text", "strpos(after_data::text"} {
+		if !strings.Contains(where, match) {
+			t.Fatalf("expected rows matching %q to be selected, got %s", match, where)
+		}
+	}
+	if strings.Contains(query, "?") {
+		t.Fatalf("expected every placeholder to be bound, got %s", query)
+	}
+
+	users, deleted := 0, 0
+	for _, arg := range args {
+		switch arg {
+		case "u-1":
+			users++
+		case account.DeletedUserID:
+			deleted++
+		}
+	}
+	if users != 8 || deleted != 4 || len(args) != 12 {
+		t.Fatalf("unexpected args %v", args)
+	}
+}
+
+var (
+	createTablePattern = regexp.MustCompile(`(?s)CREATE TABLE (?:IF NOT EXISTS )?(\w+)\s*\((.*?)\n\);`)
+	userColumnPattern  = regexp.MustCompile(`(?m)^\s*(\w*user_id)\s`)
+)
+
+// TestUserDataTablesCoverMigrations fails when a migration adds a table that
+// stores a user id without adding it to the export and deletion lists.
+func TestUserDataTablesCoverMigrations(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "..", "db", "migrations", "*.up.sql"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("list migrations: %v (%d files)", err, len(files))
+	}
+
+	exported := make(map[string]bool, len(userDataSources))
+	for _, source := range userDataSources {
+		exported[source.table] = true
+	}
+	handled := map[string]bool{
+		// Reassigned or anonymized by dedicated statements in DeleteUserData.
+		"custom_leagues": true,
+		"audit_logs":     true,
+	}
+	for _, table := range userDataDeletes {
+		handled[table] = true
+	}
+	for table := range userReferenceColumns {
+		handled[table] = true
+	}
+
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		for _, match := range createTablePattern.FindAllStringSubmatch(string(raw), -1) {
+			table := match[1]
+			for _, column := range userColumnPattern.FindAllStringSubmatch(match[2], -1) {
+				if column[1] == "user_id" && !exported[table] {
+					t.Errorf("table %s has user_id but is missing from userDataSources", table)
+				}
+				if !handled[table] {
+					t.Errorf("table %s has %s but account deletion leaves it untouched", table, column[1])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+)
+
+type auditLogInsertModel struct {
+	ActorUserID string  `db:"actor_user_id"`
+	Action      string  `db:"action"`
+	EntityType  string  `db:"entity_type"`
+	EntityID    string  `db:"entity_id"`
+	LeagueID    string  `db:"league_public_id"`
+	TraceID     string  `db:"trace_id"`
+	BeforeData  *string `db:"before_data"`
+	AfterData   *string `db:"after_data"`
+}
+
+type auditLogTableModel struct {
+	ID          int64          `db:"id"`
+	ActorUserID string         `db:"actor_user_id"`
+	Action      string         `db:"action"`
+	EntityType  string         `db:"entity_type"`
+	EntityID    string         `db:"entity_id"`
+	LeagueID    string         `db:"league_public_id"`
+	TraceID     string         `db:"trace_id"`
+	BeforeData  sql.NullString `db:"before_data"`
+	AfterData   sql.NullString `db:"after_data"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type AuditLogRepository struct {
+	db *sqlx.DB
+}
+
+func NewAuditLogRepository(db *sqlx.DB) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+func (r *AuditLogRepository) Append(ctx context.Context, entry auditlog.Entry) error {
+	return appendAuditEntry(ctx, r.db, entry)
+}
+
+func (r *AuditLogRepository) List(ctx context.Context, query auditlog.Query) ([]auditlog.Entry, error) {
+	conditions := make([]qb.Condition, 0, 5)
+	if query.ActorUserID != "" {
+		conditions = append(conditions, qb.Eq("actor_user_id", query.ActorUserID))
+	}
+	if query.EntityType != "" {
+		conditions = append(conditions, qb.Eq("entity_type", query.EntityType))
+	}
+	if query.EntityID != "" {
+		conditions = append(conditions, qb.Eq("entity_id", query.EntityID))
+	}
+	if !query.From.IsZero() {
+		conditions = append(conditions, qb.Expr("created_at >= ?", query.From))
+	}
+	if !query.To.IsZero() {
+		conditions = append(conditions, qb.Expr("created_at < ?", query.To))
+	}
+
+	sqlQuery, args, err := qb.Select("id", "actor_user_id", "action", "entity_type", "entity_id", "league_public_id", "trace_id", "before_data", "after_data", "created_at").
+		From("audit_logs").
+		Where(conditions...).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(query.Limit).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list audit logs query: %w", err)
+	}
+
+	var rows []auditLogTableModel
+	if err := r.db.SelectContext(ctx, &rows, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("list audit logs: %w", err)
+	}
+
+	out := make([]auditlog.Entry, 0, len(rows))
+	for _, row := range rows {
+		before, err := unmarshalAuditData(row.BeforeData)
+		if err != nil {
+			return nil, fmt.Errorf("decode audit log id=%d before data: %w", row.ID, err)
+		}
+		after, err := unmarshalAuditData(row.AfterData)
+		if err != nil {
+			return nil, fmt.Errorf("decode audit log id=%d after data: %w", row.ID, err)
+		}
+		out = append(out, auditlog.Entry{
+			ID:          row.ID,
+			ActorUserID: row.ActorUserID,
+			Action:      row.Action,
+			EntityType:  row.EntityType,
+			EntityID:    row.EntityID,
+			LeagueID:    row.LeagueID,
+			TraceID:     row.TraceID,
+			Before:      before,
+			After:       after,
+			CreatedAt:   row.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+// appendAuditEntry lets repositories write the audit entry inside the
+// transaction of the change it describes.
+func appendAuditEntry(ctx context.Context, db sqlx.ExecerContext, entry auditlog.Entry) error {
+	before, err := marshalAuditData(entry.Before)
+	if err != nil {
+		return fmt.Errorf("marshal audit before data: %w", err)
+	}
+	after, err := marshalAuditData(entry.After)
+	if err != nil {
+		return fmt.Errorf("marshal audit after data: %w", err)
+	}
+
+	insertModel := auditLogInsertModel{
+		ActorUserID: entry.ActorUserID,
+		Action:      entry.Action,
+		EntityType:  entry.EntityType,
+		EntityID:    entry.EntityID,
+		LeagueID:    entry.LeagueID,
+		TraceID:     entry.TraceID,
+		BeforeData:  before,
+		AfterData:   after,
+	}
+	query, args, err := qb.InsertModel("audit_logs", insertModel, "")
+	if err != nil {
+		return fmt.Errorf("build append audit log query: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("append audit log action=%s entity=%s/%s: %w", entry.Action, entry.EntityType, entry.EntityID, err)
+	}
+	return nil
+}
+
+func marshalAuditData(data map[string]any) (*string, error) {
+	if data == nil {
+		return nil, nil
+	}
+	raw, err := sonic.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	out := string(raw)
+	return &out, nil
+}
+
+func unmarshalAuditData(raw sql.NullString) (map[string]any, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+	var out map[string]any
+	if err := sonic.Unmarshal([]byte(raw.String), &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
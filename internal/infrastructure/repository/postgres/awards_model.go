@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+)
+
+const (
+	dreamTeamScopeGameweek = "gameweek"
+	dreamTeamScopeSeason   = "season"
+)
+
+type gameweekAwardsTableModel struct {
+	ID               int64          `db:"id"`
+	LeagueID         string         `db:"league_public_id"`
+	Gameweek         int            `db:"gameweek"`
+	TopPlayerID      sql.NullString `db:"top_player_public_id"`
+	TopPlayerPoints  int            `db:"top_player_points"`
+	TopManagerUserID sql.NullString `db:"top_manager_user_id"`
+	TopManagerPoints int            `db:"top_manager_points"`
+	ComputedAt       time.Time      `db:"computed_at"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+	DeletedAt        *time.Time     `db:"deleted_at"`
+}
+
+type gameweekAwardsInsertModel struct {
+	LeagueID         string    `db:"league_public_id"`
+	Gameweek         int       `db:"gameweek"`
+	TopPlayerID      *string   `db:"top_player_public_id"`
+	TopPlayerPoints  int       `db:"top_player_points"`
+	TopManagerUserID *string   `db:"top_manager_user_id"`
+	TopManagerPoints int       `db:"top_manager_points"`
+	ComputedAt       time.Time `db:"computed_at"`
+}
+
+type dreamTeamPlayerTableModel struct {
+	ID        int64      `db:"id"`
+	LeagueID  string     `db:"league_public_id"`
+	Gameweek  int        `db:"gameweek"`
+	Scope     string     `db:"scope"`
+	Slot      int        `db:"slot"`
+	PlayerID  string     `db:"player_public_id"`
+	Position  string     `db:"position"`
+	Points    int        `db:"points"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+type dreamTeamPlayerInsertModel struct {
+	LeagueID string `db:"league_public_id"`
+	Gameweek int    `db:"gameweek"`
+	Scope    string `db:"scope"`
+	Slot     int    `db:"slot"`
+	PlayerID string `db:"player_public_id"`
+	Position string `db:"position"`
+	Points   int    `db:"points"`
+}
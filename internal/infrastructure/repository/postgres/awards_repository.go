@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/awards"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type AwardsRepository struct {
+	db *sqlx.DB
+}
+
+func NewAwardsRepository(db *sqlx.DB) *AwardsRepository {
+	return &AwardsRepository{db: db}
+}
+
+func (r *AwardsRepository) Replace(ctx context.Context, item awards.GameweekAwards) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx replace gameweek awards: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, table := range []string{"gameweek_awards", "gameweek_dream_team_players"} {
+		clearQuery, clearArgs, err := qb.Update(table).
+			SetExpr("deleted_at", "NOW()").
+			Where(
+				qb.Eq("league_public_id", item.LeagueID),
+				qb.Eq("gameweek", item.Gameweek),
+				qb.IsNull("deleted_at"),
+			).
+			ToSQL()
+		if err != nil {
+			return fmt.Errorf("build clear %s query: %w", table, err)
+		}
+		if _, err := tx.ExecContext(ctx, clearQuery, clearArgs...); err != nil {
+			return fmt.Errorf("clear %s: %w", table, err)
+		}
+	}
+
+	query, args, err := qb.InsertModel("gameweek_awards", gameweekAwardsInsertModel{
+		LeagueID:         item.LeagueID,
+		Gameweek:         item.Gameweek,
+		TopPlayerID:      nullableString(item.TopPlayerID),
+		TopPlayerPoints:  item.TopPlayerPoints,
+		TopManagerUserID: nullableString(item.TopManagerUserID),
+		TopManagerPoints: item.TopManagerPoints,
+		ComputedAt:       item.ComputedAt.UTC(),
+	}, "")
+	if err != nil {
+		return fmt.Errorf("build insert gameweek awards query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert gameweek awards: %w", err)
+	}
+
+	teams := []struct {
+		scope   string
+		players []awards.DreamTeamPlayer
+	}{
+		{scope: dreamTeamScopeGameweek, players: item.DreamTeam},
+		{scope: dreamTeamScopeSeason, players: item.SeasonDreamTeam},
+	}
+	for _, team := range teams {
+		for idx, member := range team.players {
+			query, args, err := qb.InsertModel("gameweek_dream_team_players", dreamTeamPlayerInsertModel{
+				LeagueID: item.LeagueID,
+				Gameweek: item.Gameweek,
+				Scope:    team.scope,
+				Slot:     idx + 1,
+				PlayerID: member.PlayerID,
+				Position: string(member.Position),
+				Points:   member.Points,
+			}, "")
+			if err != nil {
+				return fmt.Errorf("build insert dream team player query: %w", err)
+			}
+			if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("insert dream team player scope=%s player=%s: %w", team.scope, member.PlayerID, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit replace gameweek awards tx: %w", err)
+	}
+	return nil
+}
+
+func (r *AwardsRepository) GetByLeagueGameweek(ctx context.Context, leagueID string, gameweek int) (awards.GameweekAwards, bool, error) {
+	query, args, err := qb.Select("*").From("gameweek_awards").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.Eq("gameweek", gameweek),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return awards.GameweekAwards{}, false, fmt.Errorf("build get gameweek awards query: %w", err)
+	}
+
+	var row gameweekAwardsTableModel
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if isNotFound(err) {
+			return awards.GameweekAwards{}, false, nil
+		}
+		return awards.GameweekAwards{}, false, fmt.Errorf("get gameweek awards: %w", err)
+	}
+
+	playersQuery, playersArgs, err := qb.Select("*").From("gameweek_dream_team_players").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.Eq("gameweek", gameweek),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("scope", "slot").
+		ToSQL()
+	if err != nil {
+		return awards.GameweekAwards{}, false, fmt.Errorf("build list dream team players query: %w", err)
+	}
+
+	var playerRows []dreamTeamPlayerTableModel
+	if err := r.db.SelectContext(ctx, &playerRows, playersQuery, playersArgs...); err != nil {
+		return awards.GameweekAwards{}, false, fmt.Errorf("list dream team players: %w", err)
+	}
+
+	out := awards.GameweekAwards{
+		LeagueID:         row.LeagueID,
+		Gameweek:         row.Gameweek,
+		DreamTeam:        []awards.DreamTeamPlayer{},
+		SeasonDreamTeam:  []awards.DreamTeamPlayer{},
+		TopPlayerID:      nullStringToString(row.TopPlayerID),
+		TopPlayerPoints:  row.TopPlayerPoints,
+		TopManagerUserID: nullStringToString(row.TopManagerUserID),
+		TopManagerPoints: row.TopManagerPoints,
+		ComputedAt:       row.ComputedAt,
+	}
+	for _, playerRow := range playerRows {
+		member := awards.DreamTeamPlayer{
+			PlayerID: playerRow.PlayerID,
+			Position: player.Position(playerRow.Position),
+			Points:   playerRow.Points,
+		}
+		if playerRow.Scope == dreamTeamScopeSeason {
+			out.SeasonDreamTeam = append(out.SeasonDreamTeam, member)
+			continue
+		}
+		out.DreamTeam = append(out.DreamTeam, member)
+	}
+	return out, true, nil
+}
+
+func (r *AwardsRepository) ListGameweeksByLeague(ctx context.Context, leagueID string) ([]int, error) {
+	query, args, err := qb.Select("gameweek").From("gameweek_awards").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("gameweek").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list awarded gameweeks query: %w", err)
+	}
+
+	var rows []int
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list awarded gameweeks: %w", err)
+	}
+	return rows, nil
+}
@@ -14,6 +14,7 @@ type customLeagueTableModel struct {
 	Name        string         `db:"name"`
 	InviteCode  string         `db:"invite_code"`
 	IsDefault   bool           `db:"is_default"`
+	PreviousID  sql.NullString `db:"previous_custom_league_public_id"`
 	CreatedAt   time.Time      `db:"created_at"`
 	UpdatedAt   time.Time      `db:"updated_at"`
 	DeletedAt   *time.Time     `db:"deleted_at"`
@@ -41,6 +42,7 @@ type customLeagueInsertModel struct {
 	Name        string  `db:"name"`
 	InviteCode  string  `db:"invite_code"`
 	IsDefault   bool    `db:"is_default"`
+	PreviousID  *string `db:"previous_custom_league_public_id"`
 }
 
 type customLeagueMemberInsertModel struct {
@@ -19,12 +19,28 @@ func NewCustomLeagueRepository(db *sqlx.DB) *CustomLeagueRepository {
 }
 
 func (r *CustomLeagueRepository) CreateGroup(ctx context.Context, group customleague.Group) error {
+	query, args, err := qb.InsertModel("custom_leagues", customLeagueInsertModelFromGroup(group), "")
+	if err != nil {
+		return fmt.Errorf("build create custom league query: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("create custom league: %w", err)
+	}
+
+	return nil
+}
+
+func customLeagueInsertModelFromGroup(group customleague.Group) customLeagueInsertModel {
 	var countryCode *string
 	if normalized := strings.ToUpper(strings.TrimSpace(group.CountryCode)); normalized != "" {
 		countryCode = &normalized
 	}
+	var previousID *string
+	if group.PreviousGroupID != "" {
+		previousID = &group.PreviousGroupID
+	}
 
-	insertModel := customLeagueInsertModel{
+	return customLeagueInsertModel{
 		PublicID:    group.ID,
 		LeagueID:    group.LeagueID,
 		CountryCode: countryCode,
@@ -32,16 +48,8 @@ func (r *CustomLeagueRepository) CreateGroup(ctx context.Context, group customle
 		Name:        group.Name,
 		InviteCode:  group.InviteCode,
 		IsDefault:   group.IsDefault,
+		PreviousID:  previousID,
 	}
-	query, args, err := qb.InsertModel("custom_leagues", insertModel, "")
-	if err != nil {
-		return fmt.Errorf("build create custom league query: %w", err)
-	}
-	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
-		return fmt.Errorf("create custom league: %w", err)
-	}
-
-	return nil
 }
 
 func (r *CustomLeagueRepository) UpdateGroupName(ctx context.Context, groupID, ownerUserID, name string) error {
@@ -291,6 +299,34 @@ func (r *CustomLeagueRepository) ListDefaultGroupsByLeagueAndCountry(ctx context
 	return out, nil
 }
 
+func (r *CustomLeagueRepository) ListCarriedOverGroupsByUser(ctx context.Context, leagueID, userID string) ([]customleague.Group, error) {
+	query, args, err := qb.Select("cl.*").
+		From("custom_leagues cl JOIN custom_league_members m ON m.custom_league_public_id = cl.previous_custom_league_public_id").
+		Where(
+			qb.Eq("cl.league_public_id", leagueID),
+			qb.Eq("m.user_id", userID),
+			qb.Expr("cl.previous_custom_league_public_id IS NOT NULL"),
+			qb.IsNull("cl.deleted_at"),
+			qb.IsNull("m.deleted_at"),
+		).
+		OrderBy("cl.id").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list carried over custom leagues query: %w", err)
+	}
+
+	var rows []customLeagueTableModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list carried over custom leagues: %w", err)
+	}
+
+	out := make([]customleague.Group, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, customLeagueFromRow(row))
+	}
+	return out, nil
+}
+
 func (r *CustomLeagueRepository) ListMembershipsByGroup(ctx context.Context, groupID string) ([]customleague.Membership, error) {
 	query, args, err := qb.Select("*").
 		From("custom_league_members").
@@ -556,14 +592,15 @@ func customLeagueFromRow(row customLeagueTableModel) customleague.Group {
 	}
 
 	return customleague.Group{
-		ID:          row.PublicID,
-		LeagueID:    row.LeagueID,
-		CountryCode: countryCode,
-		OwnerUserID: row.OwnerUserID,
-		Name:        row.Name,
-		InviteCode:  row.InviteCode,
-		IsDefault:   row.IsDefault,
-		CreatedAt:   row.CreatedAt,
-		UpdatedAt:   row.UpdatedAt,
+		ID:              row.PublicID,
+		LeagueID:        row.LeagueID,
+		CountryCode:     countryCode,
+		OwnerUserID:     row.OwnerUserID,
+		Name:            row.Name,
+		InviteCode:      row.InviteCode,
+		IsDefault:       row.IsDefault,
+		PreviousGroupID: row.PreviousID.String,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
 	}
 }
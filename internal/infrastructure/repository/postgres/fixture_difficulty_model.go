@@ -0,0 +1,29 @@
+package postgres
+
+import "time"
+
+type teamFixtureDifficultyTableModel struct {
+	ID              int64      `db:"id"`
+	LeagueID        string     `db:"league_public_id"`
+	TeamID          string     `db:"team_public_id"`
+	HomeStrength    float64    `db:"home_strength"`
+	AwayStrength    float64    `db:"away_strength"`
+	HomeDifficulty  int        `db:"home_difficulty"`
+	AwayDifficulty  int        `db:"away_difficulty"`
+	BasedOnGameweek int        `db:"based_on_gameweek"`
+	ComputedAt      time.Time  `db:"computed_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+	UpdatedAt       time.Time  `db:"updated_at"`
+	DeletedAt       *time.Time `db:"deleted_at"`
+}
+
+type teamFixtureDifficultyInsertModel struct {
+	LeagueID        string    `db:"league_public_id"`
+	TeamID          string    `db:"team_public_id"`
+	HomeStrength    float64   `db:"home_strength"`
+	AwayStrength    float64   `db:"away_strength"`
+	HomeDifficulty  int       `db:"home_difficulty"`
+	AwayDifficulty  int       `db:"away_difficulty"`
+	BasedOnGameweek int       `db:"based_on_gameweek"`
+	ComputedAt      time.Time `db:"computed_at"`
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixturedifficulty"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type FixtureDifficultyRepository struct {
+	db *sqlx.DB
+}
+
+func NewFixtureDifficultyRepository(db *sqlx.DB) *FixtureDifficultyRepository {
+	return &FixtureDifficultyRepository{db: db}
+}
+
+func (r *FixtureDifficultyRepository) ReplaceByLeague(ctx context.Context, leagueID string, items []fixturedifficulty.TeamStrength) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx replace team fixture difficulty: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	clearQuery, clearArgs, err := qb.Update("team_fixture_difficulty").
+		SetExpr("deleted_at", "NOW()").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("build clear team fixture difficulty query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, clearQuery, clearArgs...); err != nil {
+		return fmt.Errorf("clear team fixture difficulty: %w", err)
+	}
+
+	for _, item := range items {
+		query, args, err := qb.InsertModel("team_fixture_difficulty", teamFixtureDifficultyInsertModel{
+			LeagueID:        leagueID,
+			TeamID:          item.TeamID,
+			HomeStrength:    item.HomeStrength,
+			AwayStrength:    item.AwayStrength,
+			HomeDifficulty:  item.HomeDifficulty,
+			AwayDifficulty:  item.AwayDifficulty,
+			BasedOnGameweek: item.BasedOnGameweek,
+			ComputedAt:      item.ComputedAt.UTC(),
+		}, "")
+		if err != nil {
+			return fmt.Errorf("build insert team fixture difficulty query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("insert team fixture difficulty team=%s: %w", item.TeamID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit replace team fixture difficulty tx: %w", err)
+	}
+	return nil
+}
+
+func (r *FixtureDifficultyRepository) ListByLeague(ctx context.Context, leagueID string) ([]fixturedifficulty.TeamStrength, error) {
+	query, args, err := qb.Select("*").From("team_fixture_difficulty").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("team_public_id").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list team fixture difficulty query: %w", err)
+	}
+
+	var rows []teamFixtureDifficultyTableModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list team fixture difficulty: %w", err)
+	}
+
+	out := make([]fixturedifficulty.TeamStrength, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, fixturedifficulty.TeamStrength{
+			LeagueID:        row.LeagueID,
+			TeamID:          row.TeamID,
+			HomeStrength:    row.HomeStrength,
+			AwayStrength:    row.AwayStrength,
+			HomeDifficulty:  row.HomeDifficulty,
+			AwayDifficulty:  row.AwayDifficulty,
+			BasedOnGameweek: row.BasedOnGameweek,
+			ComputedAt:      row.ComputedAt,
+		})
+	}
+	return out, nil
+}
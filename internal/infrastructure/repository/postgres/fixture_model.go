@@ -30,19 +30,20 @@ type fixtureTableModel struct {
 }
 
 type fixtureInsertModel struct {
-	PublicID     string     `db:"public_id"`
-	LeagueID     string     `db:"league_public_id"`
-	Gameweek     int        `db:"gameweek"`
-	HomeTeam     string     `db:"home_team"`
-	AwayTeam     string     `db:"away_team"`
-	FixtureRefID *int64     `db:"external_fixture_id"`
-	HomeTeamID   *string    `db:"home_team_public_id"`
-	AwayTeamID   *string    `db:"away_team_public_id"`
-	KickoffAt    time.Time  `db:"kickoff_at"`
-	Venue        string     `db:"venue"`
-	HomeScore    *int       `db:"home_score"`
-	AwayScore    *int       `db:"away_score"`
-	Status       string     `db:"status"`
-	WinnerTeamID *string    `db:"winner_team_public_id"`
-	FinishedAt   *time.Time `db:"finished_at"`
+	PublicID       string     `db:"public_id"`
+	LeagueID       string     `db:"league_public_id"`
+	Gameweek       int        `db:"gameweek"`
+	HomeTeam       string     `db:"home_team"`
+	AwayTeam       string     `db:"away_team"`
+	FixtureRefID   *int64     `db:"external_fixture_id"`
+	HomeTeamID     *string    `db:"home_team_public_id"`
+	AwayTeamID     *string    `db:"away_team_public_id"`
+	KickoffAt      time.Time  `db:"kickoff_at"`
+	Venue          string     `db:"venue"`
+	HomeScore      *int       `db:"home_score"`
+	AwayScore      *int       `db:"away_score"`
+	Status         string     `db:"status"`
+	WinnerTeamID   *string    `db:"winner_team_public_id"`
+	FinishedAt     *time.Time `db:"finished_at"`
+	ExternalSource string     `db:"external_source"`
 }
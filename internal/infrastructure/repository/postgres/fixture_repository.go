@@ -124,21 +124,22 @@ func (r *FixtureRepository) UpsertFixtures(ctx context.Context, items []fixture.
 
 	for _, item := range items {
 		insertModel := fixtureInsertModel{
-			PublicID:     item.ID,
-			LeagueID:     item.LeagueID,
-			Gameweek:     item.Gameweek,
-			HomeTeam:     item.HomeTeam,
-			AwayTeam:     item.AwayTeam,
-			FixtureRefID: nullableInt64(item.FixtureRefID),
-			HomeTeamID:   nullableString(item.HomeTeamID),
-			AwayTeamID:   nullableString(item.AwayTeamID),
-			KickoffAt:    item.KickoffAt,
-			Venue:        item.Venue,
-			HomeScore:    item.HomeScore,
-			AwayScore:    item.AwayScore,
-			Status:       fixture.NormalizeStatus(item.Status),
-			WinnerTeamID: nullableString(item.WinnerTeamID),
-			FinishedAt:   item.FinishedAt,
+			PublicID:       item.ID,
+			LeagueID:       item.LeagueID,
+			Gameweek:       item.Gameweek,
+			HomeTeam:       item.HomeTeam,
+			AwayTeam:       item.AwayTeam,
+			FixtureRefID:   nullableInt64(item.FixtureRefID),
+			HomeTeamID:     nullableString(item.HomeTeamID),
+			AwayTeamID:     nullableString(item.AwayTeamID),
+			KickoffAt:      item.KickoffAt,
+			Venue:          item.Venue,
+			HomeScore:      item.HomeScore,
+			AwayScore:      item.AwayScore,
+			Status:         fixture.NormalizeStatus(item.Status),
+			WinnerTeamID:   nullableString(item.WinnerTeamID),
+			FinishedAt:     item.FinishedAt,
+			ExternalSource: externalSourceOrDefault(item.RefSource),
 		}
 		query, args, err := qb.InsertModel("fixtures", insertModel, `ON CONFLICT (public_id)
 DO UPDATE SET
@@ -147,6 +148,7 @@ DO UPDATE SET
     home_team = EXCLUDED.home_team,
     away_team = EXCLUDED.away_team,
     external_fixture_id = EXCLUDED.external_fixture_id,
+    external_source = EXCLUDED.external_source,
     home_team_public_id = EXCLUDED.home_team_public_id,
     away_team_public_id = EXCLUDED.away_team_public_id,
     kickoff_at = EXCLUDED.kickoff_at,
@@ -191,6 +193,7 @@ func fixtureFromTableRow(row fixtureTableModel) fixture.Fixture {
 		HomeTeamID:   row.HomeTeamID.String,
 		AwayTeamID:   row.AwayTeamID.String,
 		FixtureRefID: nullInt64ToInt64(row.FixtureRefID),
+		RefSource:    row.ExternalSource,
 		KickoffAt:    row.KickoffAt,
 		Venue:        row.Venue,
 		HomeScore:    nullInt64ToIntPtr(row.HomeScore),
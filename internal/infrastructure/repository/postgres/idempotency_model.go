@@ -0,0 +1,25 @@
+package postgres
+
+import "time"
+
+type idempotencyInsertModel struct {
+	UserID      string    `db:"user_id"`
+	Key         string    `db:"idempotency_key"`
+	Method      string    `db:"method"`
+	Path        string    `db:"path"`
+	RequestHash string    `db:"request_hash"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+type idempotencyTableModel struct {
+	UserID       string    `db:"user_id"`
+	Key          string    `db:"idempotency_key"`
+	Method       string    `db:"method"`
+	Path         string    `db:"path"`
+	RequestHash  string    `db:"request_hash"`
+	StatusCode   int       `db:"status_code"`
+	ContentType  string    `db:"content_type"`
+	ResponseBody []byte    `db:"response_body"`
+	CreatedAt    time.Time `db:"created_at"`
+	ExpiresAt    time.Time `db:"expires_at"`
+}
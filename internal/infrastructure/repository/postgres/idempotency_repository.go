@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/idempotency"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type IdempotencyRepository struct {
+	db *sqlx.DB
+}
+
+func NewIdempotencyRepository(db *sqlx.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+// Reserve inserts the key, taking over a row of the same key that expired or
+// whose request has been in progress for longer than the reservation lease,
+// which means the request that reserved it died. When another row wins the
+// conflict nothing is returned by the insert and that row is read instead.
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record idempotency.Record) (idempotency.Record, bool, error) {
+	insertModel := idempotencyInsertModel{
+		UserID:      record.UserID,
+		Key:         record.Key,
+		Method:      record.Method,
+		Path:        record.Path,
+		RequestHash: record.RequestHash,
+		ExpiresAt:   record.ExpiresAt,
+	}
+	query, args, err := qb.InsertModel("idempotency_keys", insertModel, `ON CONFLICT (user_id, idempotency_key)
+DO UPDATE SET
+    method = EXCLUDED.method,
+    path = EXCLUDED.path,
+    request_hash = EXCLUDED.request_hash,
+    status_code = 0,
+    content_type = '',
+    response_body = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW()
+    OR (idempotency_keys.status_code = 0 AND idempotency_keys.created_at < NOW() - interval '1 minute')
+RETURNING id`)
+	if err != nil {
+		return idempotency.Record{}, false, fmt.Errorf("build reserve idempotency key query: %w", err)
+	}
+
+	var id int64
+	err = r.db.QueryRowxContext(ctx, query, args...).Scan(&id)
+	if err == nil {
+		return record, true, nil
+	}
+	if !isNotFound(err) {
+		return idempotency.Record{}, false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+
+	selectQuery, selectArgs, err := qb.Select("user_id", "idempotency_key", "method", "path", "request_hash", "status_code", "content_type", "response_body", "created_at", "expires_at").
+		From("idempotency_keys").
+		Where(
+			qb.Eq("user_id", record.UserID),
+			qb.Eq("idempotency_key", record.Key),
+		).
+		ToSQL()
+	if err != nil {
+		return idempotency.Record{}, false, fmt.Errorf("build select idempotency key query: %w", err)
+	}
+
+	var row idempotencyTableModel
+	if err := r.db.GetContext(ctx, &row, selectQuery, selectArgs...); err != nil {
+		return idempotency.Record{}, false, fmt.Errorf("select idempotency key: %w", err)
+	}
+	return idempotency.Record{
+		UserID:      row.UserID,
+		Key:         row.Key,
+		Method:      row.Method,
+		Path:        row.Path,
+		RequestHash: row.RequestHash,
+		StatusCode:  row.StatusCode,
+		ContentType: row.ContentType,
+		Body:        row.ResponseBody,
+		CreatedAt:   row.CreatedAt,
+		ExpiresAt:   row.ExpiresAt,
+	}, false, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, record idempotency.Record) error {
+	query, args, err := qb.Update("idempotency_keys").
+		Set("status_code", record.StatusCode).
+		Set("content_type", record.ContentType).
+		Set("response_body", record.Body).
+		Where(
+			qb.Eq("user_id", record.UserID),
+			qb.Eq("idempotency_key", record.Key),
+			qb.Eq("request_hash", record.RequestHash),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("build complete idempotency key query: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) Release(ctx context.Context, userID, key string) error {
+	query, args, err := qb.DeleteFrom("idempotency_keys").
+		Where(
+			qb.Eq("user_id", userID),
+			qb.Eq("idempotency_key", key),
+			qb.Eq("status_code", 0),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("build release idempotency key query: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	query, args, err := qb.DeleteFrom("idempotency_keys").
+		Where(qb.Expr("expires_at <= ?", now)).
+		ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("build delete expired idempotency keys query: %w", err)
+	}
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("count expired idempotency keys: %w", err)
+	}
+	return n, nil
+}
@@ -2,6 +2,12 @@ package postgres
 
 import "time"
 
+type jobLastCompletionRow struct {
+	JobName     string    `db:"job_name"`
+	LeagueID    string    `db:"league_public_id"`
+	CompletedAt time.Time `db:"completed_at"`
+}
+
 type jobDispatchInsertModel struct {
 	DispatchID       string     `db:"dispatch_id"`
 	JobName          string     `db:"job_name"`
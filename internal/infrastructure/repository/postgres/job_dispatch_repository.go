@@ -9,6 +9,7 @@ import (
 	sonic "github.com/bytedance/sonic"
 	"github.com/jmoiron/sqlx"
 	"github.com/riskibarqy/fantasy-league/internal/domain/jobscheduler"
+	"github.com/riskibarqy/fantasy-league/internal/platform/metrics"
 	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
 )
 
@@ -132,10 +133,49 @@ DO UPDATE SET
 	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
 		return fmt.Errorf("upsert job dispatch dispatch_id=%s status=%s: %w", dispatchID, event.Status, err)
 	}
+	metrics.JobDispatches.WithLabelValues(jobName, string(event.Status)).Inc()
 
 	return nil
 }
 
+func (r *JobDispatchRepository) ListLastCompletions(ctx context.Context, jobNames []string) ([]jobscheduler.LastCompletion, error) {
+	if len(jobNames) == 0 {
+		return []jobscheduler.LastCompletion{}, nil
+	}
+
+	query, args, err := qb.Select(
+		"job_name",
+		"league_public_id",
+		"MAX(completed_at) AS completed_at",
+	).From("job_dispatches").
+		Where(
+			qb.In("job_name", stringSliceToAny(jobNames)),
+			qb.Expr("completed_at IS NOT NULL"),
+			qb.IsNull("deleted_at"),
+		).
+		GroupBy("job_name", "league_public_id").
+		OrderBy("league_public_id", "job_name").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list last job completions query: %w", err)
+	}
+
+	var rows []jobLastCompletionRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list last job completions: %w", err)
+	}
+
+	out := make([]jobscheduler.LastCompletion, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, jobscheduler.LastCompletion{
+			JobName:     row.JobName,
+			LeagueID:    row.LeagueID,
+			CompletedAt: row.CompletedAt,
+		})
+	}
+	return out, nil
+}
+
 func marshalPayload(payload map[string]any) (string, error) {
 	if len(payload) == 0 {
 		return "{}", nil
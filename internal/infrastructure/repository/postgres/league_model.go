@@ -6,16 +6,33 @@ import (
 )
 
 type leagueTableModel struct {
-	ID               int64         `db:"id"`
-	PublicID         string        `db:"public_id"`
-	Name             string        `db:"name"`
-	CountryCode      string        `db:"country_code"`
-	Season           string        `db:"season"`
-	IsDefault        bool          `db:"is_default"`
-	LeagueRefID      sql.NullInt64 `db:"external_league_id"`
-	ExternalSource   string        `db:"external_source"`
-	ExternalMetadata []byte        `db:"external_metadata"`
-	CreatedAt        time.Time     `db:"created_at"`
-	UpdatedAt        time.Time     `db:"updated_at"`
-	DeletedAt        *time.Time    `db:"deleted_at"`
+	ID               int64          `db:"id"`
+	PublicID         string         `db:"public_id"`
+	Name             string         `db:"name"`
+	CountryCode      string         `db:"country_code"`
+	Season           string         `db:"season"`
+	IsDefault        bool           `db:"is_default"`
+	LeagueRefID      sql.NullInt64  `db:"external_league_id"`
+	ExternalSource   string         `db:"external_source"`
+	ExternalMetadata []byte         `db:"external_metadata"`
+	SeasonRefID      sql.NullInt64  `db:"external_season_id"`
+	OriginLeagueID   sql.NullString `db:"origin_league_public_id"`
+	PreviousLeagueID sql.NullString `db:"previous_league_public_id"`
+	ArchivedAt       sql.NullTime   `db:"archived_at"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+	DeletedAt        *time.Time     `db:"deleted_at"`
+}
+
+type leagueInsertModel struct {
+	PublicID         string  `db:"public_id"`
+	Name             string  `db:"name"`
+	CountryCode      string  `db:"country_code"`
+	Season           string  `db:"season"`
+	IsDefault        bool    `db:"is_default"`
+	LeagueRefID      *int64  `db:"external_league_id"`
+	ExternalSource   string  `db:"external_source"`
+	SeasonRefID      *int64  `db:"external_season_id"`
+	OriginLeagueID   string  `db:"origin_league_public_id"`
+	PreviousLeagueID *string `db:"previous_league_public_id"`
 }
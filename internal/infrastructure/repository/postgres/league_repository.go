@@ -33,14 +33,7 @@ func (r *LeagueRepository) List(ctx context.Context) ([]league.League, error) {
 
 	out := make([]league.League, 0, len(rows))
 	for _, row := range rows {
-		out = append(out, league.League{
-			ID:          row.PublicID,
-			Name:        row.Name,
-			CountryCode: row.CountryCode,
-			Season:      row.Season,
-			IsDefault:   row.IsDefault,
-			LeagueRefID: nullInt64ToInt64(row.LeagueRefID),
-		})
+		out = append(out, leagueFromRow(row))
 	}
 
 	return out, nil
@@ -65,12 +58,20 @@ func (r *LeagueRepository) GetByID(ctx context.Context, leagueID string) (league
 		return league.League{}, false, fmt.Errorf("get league by id: %w", err)
 	}
 
+	return leagueFromRow(row), true, nil
+}
+
+func leagueFromRow(row leagueTableModel) league.League {
 	return league.League{
-		ID:          row.PublicID,
-		Name:        row.Name,
-		CountryCode: row.CountryCode,
-		Season:      row.Season,
-		IsDefault:   row.IsDefault,
-		LeagueRefID: nullInt64ToInt64(row.LeagueRefID),
-	}, true, nil
+		ID:               row.PublicID,
+		Name:             row.Name,
+		CountryCode:      row.CountryCode,
+		Season:           row.Season,
+		IsDefault:        row.IsDefault,
+		LeagueRefID:      nullInt64ToInt64(row.LeagueRefID),
+		SeasonRefID:      nullInt64ToInt64(row.SeasonRefID),
+		OriginLeagueID:   row.OriginLeagueID.String,
+		PreviousLeagueID: row.PreviousLeagueID.String,
+		ArchivedAt:       nullTimeToTimePtr(row.ArchivedAt),
+	}
 }
@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+)
+
+type teamOverrideTableModel struct {
+	LeagueID  string    `db:"league_public_id"`
+	TeamID    string    `db:"team_public_id"`
+	IsHidden  bool      `db:"is_hidden"`
+	Note      string    `db:"note"`
+	UpdatedBy string    `db:"updated_by"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type teamOverrideInsertModel struct {
+	LeagueID  string `db:"league_public_id"`
+	TeamID    string `db:"team_public_id"`
+	IsHidden  bool   `db:"is_hidden"`
+	Note      string `db:"note"`
+	UpdatedBy string `db:"updated_by"`
+}
+
+type playerOverrideTableModel struct {
+	LeagueID  string         `db:"league_public_id"`
+	PlayerID  string         `db:"player_public_id"`
+	Position  sql.NullString `db:"position"`
+	Price     sql.NullInt64  `db:"price"`
+	IsActive  sql.NullBool   `db:"is_active"`
+	IsHidden  bool           `db:"is_hidden"`
+	Note      string         `db:"note"`
+	UpdatedBy string         `db:"updated_by"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+type playerOverrideInsertModel struct {
+	LeagueID  string  `db:"league_public_id"`
+	PlayerID  string  `db:"player_public_id"`
+	Position  *string `db:"position"`
+	Price     *int64  `db:"price"`
+	IsActive  *bool   `db:"is_active"`
+	IsHidden  bool    `db:"is_hidden"`
+	Note      string  `db:"note"`
+	UpdatedBy string  `db:"updated_by"`
+}
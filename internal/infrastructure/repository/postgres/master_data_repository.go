@@ -0,0 +1,266 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/masterdata"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type MasterDataRepository struct {
+	db *sqlx.DB
+}
+
+func NewMasterDataRepository(db *sqlx.DB) *MasterDataRepository {
+	return &MasterDataRepository{db: db}
+}
+
+func (r *MasterDataRepository) ListOverrides(ctx context.Context, leagueID string) (masterdata.Overrides, error) {
+	teamQuery, teamArgs, err := qb.Select("league_public_id", "team_public_id", "is_hidden", "note", "updated_by", "updated_at").
+		From("team_overrides").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("team_public_id").
+		ToSQL()
+	if err != nil {
+		return masterdata.Overrides{}, fmt.Errorf("build select team overrides query: %w", err)
+	}
+	var teamRows []teamOverrideTableModel
+	if err := r.db.SelectContext(ctx, &teamRows, teamQuery, teamArgs...); err != nil {
+		return masterdata.Overrides{}, fmt.Errorf("select team overrides: %w", err)
+	}
+
+	playerQuery, playerArgs, err := qb.Select("league_public_id", "player_public_id", "position", "price", "is_active", "is_hidden", "note", "updated_by", "updated_at").
+		From("player_overrides").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("player_public_id").
+		ToSQL()
+	if err != nil {
+		return masterdata.Overrides{}, fmt.Errorf("build select player overrides query: %w", err)
+	}
+	var playerRows []playerOverrideTableModel
+	if err := r.db.SelectContext(ctx, &playerRows, playerQuery, playerArgs...); err != nil {
+		return masterdata.Overrides{}, fmt.Errorf("select player overrides: %w", err)
+	}
+
+	out := masterdata.Overrides{
+		Teams:   make([]masterdata.TeamOverride, 0, len(teamRows)),
+		Players: make([]masterdata.PlayerOverride, 0, len(playerRows)),
+	}
+	for _, row := range teamRows {
+		out.Teams = append(out.Teams, masterdata.TeamOverride{
+			LeagueID:  row.LeagueID,
+			TeamID:    row.TeamID,
+			Hidden:    row.IsHidden,
+			Note:      row.Note,
+			UpdatedBy: row.UpdatedBy,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+	for _, row := range playerRows {
+		item := masterdata.PlayerOverride{
+			LeagueID:  row.LeagueID,
+			PlayerID:  row.PlayerID,
+			Hidden:    row.IsHidden,
+			Note:      row.Note,
+			UpdatedBy: row.UpdatedBy,
+			UpdatedAt: row.UpdatedAt,
+		}
+		if row.Position.Valid {
+			position := player.Position(row.Position.String)
+			item.Position = &position
+		}
+		if row.Price.Valid {
+			price := row.Price.Int64
+			item.Price = &price
+		}
+		if row.IsActive.Valid {
+			available := row.IsActive.Bool
+			item.Available = &available
+		}
+		out.Players = append(out.Players, item)
+	}
+	return out, nil
+}
+
+func (r *MasterDataRepository) SaveTeamOverride(ctx context.Context, item masterdata.TeamOverride, entry auditlog.Entry) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx save team override: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	insertModel := teamOverrideInsertModel{
+		LeagueID:  item.LeagueID,
+		TeamID:    item.TeamID,
+		IsHidden:  item.Hidden,
+		Note:      item.Note,
+		UpdatedBy: item.UpdatedBy,
+	}
+	query, args, err := qb.InsertModel("team_overrides", insertModel, `ON CONFLICT (team_public_id) WHERE deleted_at IS NULL
+DO UPDATE SET
+    league_public_id = EXCLUDED.league_public_id,
+    is_hidden = EXCLUDED.is_hidden,
+    note = EXCLUDED.note,
+    updated_by = EXCLUDED.updated_by`)
+	if err != nil {
+		return fmt.Errorf("build upsert team override query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert team override team=%s: %w", item.TeamID, err)
+	}
+
+	updateQuery, updateArgs, err := qb.Update("teams").
+		Set("is_hidden", item.Hidden).
+		Where(
+			qb.Eq("league_public_id", item.LeagueID),
+			qb.Eq("public_id", item.TeamID),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("build apply team override query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, updateQuery, updateArgs...); err != nil {
+		return fmt.Errorf("apply team override team=%s: %w", item.TeamID, err)
+	}
+
+	if err := appendAuditEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit save team override tx: %w", err)
+	}
+	return nil
+}
+
+func (r *MasterDataRepository) SavePlayerOverride(ctx context.Context, item masterdata.PlayerOverride, entry auditlog.Entry) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx save player override: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	insertModel := playerOverrideInsertModel{
+		LeagueID:  item.LeagueID,
+		PlayerID:  item.PlayerID,
+		Price:     item.Price,
+		IsActive:  item.Available,
+		IsHidden:  item.Hidden,
+		Note:      item.Note,
+		UpdatedBy: item.UpdatedBy,
+	}
+	if item.Position != nil {
+		position := string(*item.Position)
+		insertModel.Position = &position
+	}
+	query, args, err := qb.InsertModel("player_overrides", insertModel, `ON CONFLICT (player_public_id) WHERE deleted_at IS NULL
+DO UPDATE SET
+    league_public_id = EXCLUDED.league_public_id,
+    position = EXCLUDED.position,
+    price = EXCLUDED.price,
+    is_active = EXCLUDED.is_active,
+    is_hidden = EXCLUDED.is_hidden,
+    note = EXCLUDED.note,
+    updated_by = EXCLUDED.updated_by`)
+	if err != nil {
+		return fmt.Errorf("build upsert player override query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert player override player=%s: %w", item.PlayerID, err)
+	}
+
+	// Cleared fields keep the current value until the next sync restores the
+	// provider value.
+	update := qb.Update("players").Set("is_hidden", item.Hidden)
+	if insertModel.Position != nil {
+		update = update.Set("position", *insertModel.Position)
+	}
+	if item.Price != nil {
+		update = update.Set("price", *item.Price)
+	}
+	if item.Available != nil {
+		update = update.Set("is_active", *item.Available)
+	}
+	updateQuery, updateArgs, err := update.
+		Where(
+			qb.Eq("league_public_id", item.LeagueID),
+			qb.Eq("public_id", item.PlayerID),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("build apply player override query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, updateQuery, updateArgs...); err != nil {
+		return fmt.Errorf("apply player override player=%s: %w", item.PlayerID, err)
+	}
+
+	if err := appendAuditEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit save player override tx: %w", err)
+	}
+	return nil
+}
+
+// SaveLeague creates the league or updates its editable fields. Season
+// rollover links are left untouched on update.
+func (r *MasterDataRepository) SaveLeague(ctx context.Context, item league.League, entry auditlog.Entry) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx save league: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	insertModel := leagueInsertModel{
+		PublicID:       item.ID,
+		Name:           item.Name,
+		CountryCode:    item.CountryCode,
+		Season:         item.Season,
+		IsDefault:      item.IsDefault,
+		LeagueRefID:    nullableInt64(item.LeagueRefID),
+		ExternalSource: externalSourceOrDefault(""),
+		SeasonRefID:    nullableInt64(item.SeasonRefID),
+		OriginLeagueID: item.ConfigKey(),
+	}
+	query, args, err := qb.InsertModel("leagues", insertModel, `ON CONFLICT (public_id)
+DO UPDATE SET
+    name = EXCLUDED.name,
+    country_code = EXCLUDED.country_code,
+    season = EXCLUDED.season,
+    is_default = EXCLUDED.is_default,
+    external_league_id = EXCLUDED.external_league_id,
+    external_season_id = EXCLUDED.external_season_id`)
+	if err != nil {
+		return fmt.Errorf("build upsert league query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert league id=%s: %w", item.ID, err)
+	}
+
+	if err := appendAuditEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit save league tx: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type notificationDeliveryInsertModel struct {
+	UserID   string         `db:"user_id"`
+	DedupKey string         `db:"dedup_key"`
+	Event    string         `db:"event"`
+	Channels pq.StringArray `db:"channels"`
+	SentAt   time.Time      `db:"sent_at"`
+}
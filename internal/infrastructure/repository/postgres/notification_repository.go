@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type NotificationRepository struct {
+	db *sqlx.DB
+}
+
+func NewNotificationRepository(db *sqlx.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) HasDelivery(ctx context.Context, userID, dedupKey string) (bool, error) {
+	query, args, err := qb.Select("COUNT(1)").
+		From("notification_deliveries").
+		Where(
+			qb.Eq("user_id", userID),
+			qb.Eq("dedup_key", dedupKey),
+		).
+		ToSQL()
+	if err != nil {
+		return false, fmt.Errorf("build has notification delivery query: %w", err)
+	}
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, args...); err != nil {
+		return false, fmt.Errorf("has notification delivery: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *NotificationRepository) RecordDelivery(ctx context.Context, delivery notification.Delivery) error {
+	channels := make([]string, 0, len(delivery.Channels))
+	for _, channel := range delivery.Channels {
+		channels = append(channels, string(channel))
+	}
+	insertModel := notificationDeliveryInsertModel{
+		UserID:   delivery.UserID,
+		DedupKey: delivery.DedupKey,
+		Event:    string(delivery.Event),
+		Channels: pq.StringArray(channels),
+		SentAt:   delivery.SentAt,
+	}
+
+	query, args, err := qb.InsertModel("notification_deliveries", insertModel, `ON CONFLICT (user_id, dedup_key) DO NOTHING`)
+	if err != nil {
+		return fmt.Errorf("build record notification delivery query: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("record notification delivery: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package postgres
 import (
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type onboardingProfileTableModel struct {
@@ -26,3 +28,33 @@ type onboardingProfileInsertModel struct {
 	IPAddress           *string `db:"ip_address"`
 	OnboardingCompleted bool    `db:"onboarding_completed"`
 }
+
+type notificationPreferencesTableModel struct {
+	ID             int64          `db:"id"`
+	UserID         string         `db:"user_id"`
+	Email          sql.NullString `db:"email"`
+	EmailEnabled   bool           `db:"email_enabled"`
+	PushEnabled    bool           `db:"push_enabled"`
+	PushEndpoint   sql.NullString `db:"push_endpoint"`
+	PushP256DH     sql.NullString `db:"push_p256dh"`
+	PushAuth       sql.NullString `db:"push_auth"`
+	WebhookURL     sql.NullString `db:"webhook_url"`
+	WebhookEnabled bool           `db:"webhook_enabled"`
+	MutedEvents    pq.StringArray `db:"muted_events"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+	DeletedAt      *time.Time     `db:"deleted_at"`
+}
+
+type notificationPreferencesInsertModel struct {
+	UserID         string         `db:"user_id"`
+	Email          *string        `db:"email"`
+	EmailEnabled   bool           `db:"email_enabled"`
+	PushEnabled    bool           `db:"push_enabled"`
+	PushEndpoint   *string        `db:"push_endpoint"`
+	PushP256DH     *string        `db:"push_p256dh"`
+	PushAuth       *string        `db:"push_auth"`
+	WebhookURL     *string        `db:"webhook_url"`
+	WebhookEnabled bool           `db:"webhook_enabled"`
+	MutedEvents    pq.StringArray `db:"muted_events"`
+}
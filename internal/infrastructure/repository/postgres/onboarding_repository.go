@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
 	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
 )
@@ -71,6 +72,118 @@ DO UPDATE SET
 	return nil
 }
 
+func (r *OnboardingRepository) GetNotificationPreferences(ctx context.Context, userID string) (onboarding.NotificationPreferences, bool, error) {
+	query, args, err := qb.Select("*").
+		From("user_notification_preferences").
+		Where(
+			qb.Eq("user_id", userID),
+			qb.IsNull("deleted_at"),
+		).
+		Limit(1).
+		ToSQL()
+	if err != nil {
+		return onboarding.NotificationPreferences{}, false, fmt.Errorf("build get notification preferences query: %w", err)
+	}
+
+	var row notificationPreferencesTableModel
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if isNotFound(err) {
+			return onboarding.NotificationPreferences{}, false, nil
+		}
+		return onboarding.NotificationPreferences{}, false, fmt.Errorf("get notification preferences: %w", err)
+	}
+
+	return notificationPreferencesFromRow(row), true, nil
+}
+
+func (r *OnboardingRepository) ListNotificationPreferencesByUsers(ctx context.Context, userIDs []string) ([]onboarding.NotificationPreferences, error) {
+	if len(userIDs) == 0 {
+		return []onboarding.NotificationPreferences{}, nil
+	}
+
+	query, args, err := qb.Select("*").
+		From("user_notification_preferences").
+		Where(
+			qb.In("user_id", stringSliceToAny(userIDs)),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list notification preferences query: %w", err)
+	}
+
+	var rows []notificationPreferencesTableModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list notification preferences: %w", err)
+	}
+
+	out := make([]onboarding.NotificationPreferences, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, notificationPreferencesFromRow(row))
+	}
+	return out, nil
+}
+
+func (r *OnboardingRepository) UpsertNotificationPreferences(ctx context.Context, prefs onboarding.NotificationPreferences) error {
+	mutedEvents := prefs.MutedEvents
+	if mutedEvents == nil {
+		mutedEvents = []string{}
+	}
+	insertModel := notificationPreferencesInsertModel{
+		UserID:         strings.TrimSpace(prefs.UserID),
+		Email:          optionalString(prefs.Email),
+		EmailEnabled:   prefs.EmailEnabled,
+		PushEnabled:    prefs.PushEnabled,
+		PushEndpoint:   optionalString(prefs.PushSubscription.Endpoint),
+		PushP256DH:     optionalString(prefs.PushSubscription.P256DH),
+		PushAuth:       optionalString(prefs.PushSubscription.Auth),
+		WebhookURL:     optionalString(prefs.WebhookURL),
+		WebhookEnabled: prefs.WebhookEnabled,
+		MutedEvents:    pq.StringArray(mutedEvents),
+	}
+
+	query, args, err := qb.InsertModel("user_notification_preferences", insertModel, `ON CONFLICT (user_id) WHERE deleted_at IS NULL
+DO UPDATE SET
+    email = EXCLUDED.email,
+    email_enabled = EXCLUDED.email_enabled,
+    push_enabled = EXCLUDED.push_enabled,
+    push_endpoint = EXCLUDED.push_endpoint,
+    push_p256dh = EXCLUDED.push_p256dh,
+    push_auth = EXCLUDED.push_auth,
+    webhook_url = EXCLUDED.webhook_url,
+    webhook_enabled = EXCLUDED.webhook_enabled,
+    muted_events = EXCLUDED.muted_events,
+    deleted_at = NULL`)
+	if err != nil {
+		return fmt.Errorf("build upsert notification preferences query: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert notification preferences: %w", err)
+	}
+
+	return nil
+}
+
+func notificationPreferencesFromRow(row notificationPreferencesTableModel) onboarding.NotificationPreferences {
+	return onboarding.NotificationPreferences{
+		UserID:       row.UserID,
+		Email:        strings.TrimSpace(row.Email.String),
+		EmailEnabled: row.EmailEnabled,
+		PushEnabled:  row.PushEnabled,
+		PushSubscription: onboarding.PushSubscription{
+			Endpoint: strings.TrimSpace(row.PushEndpoint.String),
+			P256DH:   strings.TrimSpace(row.PushP256DH.String),
+			Auth:     strings.TrimSpace(row.PushAuth.String),
+		},
+		WebhookURL:     strings.TrimSpace(row.WebhookURL.String),
+		WebhookEnabled: row.WebhookEnabled,
+		MutedEvents:    []string(row.MutedEvents),
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
+
 func onboardingProfileFromRow(row onboardingProfileTableModel) onboarding.Profile {
 	return onboarding.Profile{
 		UserID:              row.UserID,
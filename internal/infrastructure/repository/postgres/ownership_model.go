@@ -0,0 +1,39 @@
+package postgres
+
+import "time"
+
+type playerGameweekOwnershipTableModel struct {
+	ID              int64      `db:"id"`
+	LeagueID        string     `db:"league_public_id"`
+	Gameweek        int        `db:"gameweek"`
+	PlayerID        string     `db:"player_public_id"`
+	TotalManagers   int        `db:"total_managers"`
+	SelectedCount   int        `db:"selected_count"`
+	CaptainCount    int        `db:"captain_count"`
+	StarterCount    int        `db:"starter_count"`
+	TransfersIn     int        `db:"transfers_in"`
+	TransfersOut    int        `db:"transfers_out"`
+	SelectedPercent float64    `db:"selected_percent"`
+	CaptainPercent  float64    `db:"captain_percent"`
+	StarterPercent  float64    `db:"starter_percent"`
+	ComputedAt      time.Time  `db:"computed_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+	UpdatedAt       time.Time  `db:"updated_at"`
+	DeletedAt       *time.Time `db:"deleted_at"`
+}
+
+type playerGameweekOwnershipInsertModel struct {
+	LeagueID        string    `db:"league_public_id"`
+	Gameweek        int       `db:"gameweek"`
+	PlayerID        string    `db:"player_public_id"`
+	TotalManagers   int       `db:"total_managers"`
+	SelectedCount   int       `db:"selected_count"`
+	CaptainCount    int       `db:"captain_count"`
+	StarterCount    int       `db:"starter_count"`
+	TransfersIn     int       `db:"transfers_in"`
+	TransfersOut    int       `db:"transfers_out"`
+	SelectedPercent float64   `db:"selected_percent"`
+	CaptainPercent  float64   `db:"captain_percent"`
+	StarterPercent  float64   `db:"starter_percent"`
+	ComputedAt      time.Time `db:"computed_at"`
+}
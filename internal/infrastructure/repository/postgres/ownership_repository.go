@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/ownership"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type OwnershipRepository struct {
+	db *sqlx.DB
+}
+
+func NewOwnershipRepository(db *sqlx.DB) *OwnershipRepository {
+	return &OwnershipRepository{db: db}
+}
+
+func (r *OwnershipRepository) ReplaceGameweek(ctx context.Context, leagueID string, gameweek int, items []ownership.PlayerGameweek) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx replace gameweek ownership: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	clearQuery, clearArgs, err := qb.Update("player_gameweek_ownership").
+		SetExpr("deleted_at", "NOW()").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.Eq("gameweek", gameweek),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("build clear gameweek ownership query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, clearQuery, clearArgs...); err != nil {
+		return fmt.Errorf("clear gameweek ownership: %w", err)
+	}
+
+	for _, item := range items {
+		query, args, err := qb.InsertModel("player_gameweek_ownership", playerGameweekOwnershipInsertModel{
+			LeagueID:        leagueID,
+			Gameweek:        gameweek,
+			PlayerID:        item.PlayerID,
+			TotalManagers:   item.TotalManagers,
+			SelectedCount:   item.SelectedCount,
+			CaptainCount:    item.CaptainCount,
+			StarterCount:    item.StarterCount,
+			TransfersIn:     item.TransfersIn,
+			TransfersOut:    item.TransfersOut,
+			SelectedPercent: item.SelectedPercent,
+			CaptainPercent:  item.CaptainPercent,
+			StarterPercent:  item.StarterPercent,
+			ComputedAt:      item.ComputedAt.UTC(),
+		}, "")
+		if err != nil {
+			return fmt.Errorf("build insert gameweek ownership query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("insert gameweek ownership player=%s: %w", item.PlayerID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit replace gameweek ownership tx: %w", err)
+	}
+	return nil
+}
+
+func (r *OwnershipRepository) ListByLeagueGameweek(ctx context.Context, leagueID string, gameweek int) ([]ownership.PlayerGameweek, error) {
+	query, args, err := qb.Select("*").From("player_gameweek_ownership").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.Eq("gameweek", gameweek),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("selected_count DESC", "player_public_id").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list gameweek ownership query: %w", err)
+	}
+
+	var rows []playerGameweekOwnershipTableModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list gameweek ownership: %w", err)
+	}
+
+	out := make([]ownership.PlayerGameweek, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, ownership.PlayerGameweek{
+			LeagueID:        row.LeagueID,
+			Gameweek:        row.Gameweek,
+			PlayerID:        row.PlayerID,
+			TotalManagers:   row.TotalManagers,
+			SelectedCount:   row.SelectedCount,
+			CaptainCount:    row.CaptainCount,
+			StarterCount:    row.StarterCount,
+			TransfersIn:     row.TransfersIn,
+			TransfersOut:    row.TransfersOut,
+			SelectedPercent: row.SelectedPercent,
+			CaptainPercent:  row.CaptainPercent,
+			StarterPercent:  row.StarterPercent,
+			ComputedAt:      row.ComputedAt,
+		})
+	}
+	return out, nil
+}
+
+func (r *OwnershipRepository) GetLatestGameweek(ctx context.Context, leagueID string) (int, bool, error) {
+	query, args, err := qb.Select("MAX(gameweek)").From("player_gameweek_ownership").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return 0, false, fmt.Errorf("build get latest ownership gameweek query: %w", err)
+	}
+
+	var gameweek sql.NullInt64
+	if err := r.db.GetContext(ctx, &gameweek, query, args...); err != nil {
+		return 0, false, fmt.Errorf("get latest ownership gameweek: %w", err)
+	}
+	if !gameweek.Valid {
+		return 0, false, nil
+	}
+	return int(gameweek.Int64), true, nil
+}
@@ -18,19 +18,49 @@ type playerTableModel struct {
 	ImageURL         string        `db:"image_url"`
 	ExternalSource   string        `db:"external_source"`
 	ExternalMetadata []byte        `db:"external_metadata"`
+	IsHidden         bool          `db:"is_hidden"`
 	CreatedAt        time.Time     `db:"created_at"`
 	UpdatedAt        time.Time     `db:"updated_at"`
 	DeletedAt        *time.Time    `db:"deleted_at"`
 }
 
 type playerInsertModel struct {
-	PublicID    string `db:"public_id"`
-	LeagueID    string `db:"league_public_id"`
-	TeamID      string `db:"team_public_id"`
-	Name        string `db:"name"`
-	Position    string `db:"position"`
-	Price       int64  `db:"price"`
-	IsActive    bool   `db:"is_active"`
-	PlayerRefID *int64 `db:"external_player_id"`
-	ImageURL    string `db:"image_url"`
+	PublicID       string `db:"public_id"`
+	LeagueID       string `db:"league_public_id"`
+	TeamID         string `db:"team_public_id"`
+	Name           string `db:"name"`
+	Position       string `db:"position"`
+	Price          int64  `db:"price"`
+	IsActive       bool   `db:"is_active"`
+	PlayerRefID    *int64 `db:"external_player_id"`
+	ImageURL       string `db:"image_url"`
+	ExternalSource string `db:"external_source"`
+	IsHidden       bool   `db:"is_hidden"`
+}
+
+type playerMarketRow struct {
+	ID               int64         `db:"id"`
+	PublicID         string        `db:"public_id"`
+	LeagueID         string        `db:"league_public_id"`
+	TeamID           string        `db:"team_public_id"`
+	Name             string        `db:"name"`
+	Position         string        `db:"position"`
+	Price            int64         `db:"price"`
+	IsActive         bool          `db:"is_active"`
+	PlayerRefID      sql.NullInt64 `db:"external_player_id"`
+	ImageURL         string        `db:"image_url"`
+	ExternalSource   string        `db:"external_source"`
+	TotalPoints      int           `db:"total_points"`
+	MinutesPlayed    int           `db:"minutes_played"`
+	Form             float64       `db:"form"`
+	OwnershipPercent float64       `db:"ownership_percent"`
+}
+
+// playerMarketCursor is the keyset of the last row of a market page. Sort and
+// Desc pin the cursor to the ordering it was issued for.
+type playerMarketCursor struct {
+	Sort  string  `json:"s"`
+	Desc  bool    `json:"d"`
+	Value float64 `json:"v"`
+	ID    int64   `json:"i"`
 }
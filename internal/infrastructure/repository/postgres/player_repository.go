@@ -2,9 +2,11 @@ package postgres
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strings"
 
+	sonic "github.com/bytedance/sonic"
 	"github.com/jmoiron/sqlx"
 	"github.com/riskibarqy/fantasy-league/internal/domain/player"
 	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
@@ -25,6 +27,8 @@ var playerSelectColumns = []string{
 	"is_active",
 	"external_player_id",
 	"image_url",
+	"external_source",
+	"is_hidden",
 	"created_at",
 	"updated_at",
 	"deleted_at",
@@ -62,6 +66,9 @@ func (r *PlayerRepository) ListByLeague(ctx context.Context, leagueID string) ([
 			Price:       row.Price,
 			ImageURL:    row.ImageURL,
 			PlayerRefID: nullInt64ToInt64(row.PlayerRefID),
+			RefSource:   row.ExternalSource,
+			Hidden:      row.IsHidden,
+			Unavailable: !row.IsActive,
 		})
 	}
 
@@ -101,12 +108,257 @@ func (r *PlayerRepository) GetByIDs(ctx context.Context, leagueID string, player
 			Price:       row.Price,
 			ImageURL:    row.ImageURL,
 			PlayerRefID: nullInt64ToInt64(row.PlayerRefID),
+			RefSource:   row.ExternalSource,
+			Hidden:      row.IsHidden,
+			Unavailable: !row.IsActive,
 		})
 	}
 
 	return out, nil
 }
 
+// playerMarketSource joins every player with the season numbers the market
+// filters on, so the outer query can filter and order by plain columns.
+var playerMarketSource = fmt.Sprintf(`(
+    SELECT p.id, p.public_id, p.league_public_id, p.team_public_id, p.name, p.position, p.price,
+        p.is_active, p.external_player_id, p.image_url, p.external_source,
+        COALESCE(season.total_points, 0) AS total_points,
+        COALESCE(season.minutes_played, 0) AS minutes_played,
+        COALESCE(recent.form, 0)::float8 AS form,
+        CASE WHEN squads.total = 0 THEN 0
+            ELSE ROUND(owned.total * 100.0 / squads.total, 1)
+        END::float8 AS ownership_percent
+    FROM players p
+    JOIN teams t ON t.public_id = p.team_public_id
+    LEFT JOIN LATERAL (
+        SELECT SUM(pfs.fantasy_points) AS total_points, SUM(pfs.minutes_played) AS minutes_played
+        FROM player_fixture_stats pfs
+        JOIN fixtures f ON f.public_id = pfs.fixture_public_id
+        WHERE pfs.player_public_id = p.public_id
+            AND f.league_public_id = p.league_public_id
+            AND pfs.deleted_at IS NULL
+            AND f.deleted_at IS NULL
+    ) season ON TRUE
+    LEFT JOIN LATERAL (
+        SELECT ROUND(AVG(latest.fantasy_points), 1) AS form
+        FROM (
+            SELECT pfs.fantasy_points
+            FROM player_fixture_stats pfs
+            JOIN fixtures f ON f.public_id = pfs.fixture_public_id
+            WHERE pfs.player_public_id = p.public_id
+                AND f.league_public_id = p.league_public_id
+                AND pfs.deleted_at IS NULL
+                AND f.deleted_at IS NULL
+            ORDER BY f.kickoff_at DESC, f.id DESC
+            LIMIT %d
+        ) latest
+    ) recent ON TRUE
+    CROSS JOIN LATERAL (
+        SELECT COUNT(1) AS total
+        FROM fantasy_squad_picks sp
+        JOIN fantasy_squads fs ON fs.public_id = sp.squad_public_id
+        WHERE sp.player_public_id = p.public_id
+            AND sp.deleted_at IS NULL
+            AND fs.deleted_at IS NULL
+    ) owned
+    CROSS JOIN LATERAL (
+        SELECT COUNT(1) AS total
+        FROM fantasy_squads fs
+        WHERE fs.league_public_id = p.league_public_id
+            AND fs.deleted_at IS NULL
+    ) squads
+    WHERE p.deleted_at IS NULL
+        AND NOT p.is_hidden
+        AND NOT t.is_hidden
+) market`, player.MarketFormWindow)
+
+var playerMarketSelectColumns = []string{
+	"id",
+	"public_id",
+	"league_public_id",
+	"team_public_id",
+	"name",
+	"position",
+	"price",
+	"is_active",
+	"external_player_id",
+	"image_url",
+	"external_source",
+	"total_points",
+	"minutes_played",
+	"form",
+	"ownership_percent",
+}
+
+func (r *PlayerRepository) SearchMarket(ctx context.Context, query player.MarketQuery) (player.MarketPage, error) {
+	sortColumn := playerMarketSortColumn(query.Sort)
+	direction := "ASC"
+	cursorOp := ">"
+	if query.Descending {
+		direction = "DESC"
+		cursorOp = "<"
+	}
+
+	conditions := []qb.Condition{qb.Eq("league_public_id", query.LeagueID)}
+	if len(query.Positions) > 0 {
+		positions := make([]any, 0, len(query.Positions))
+		for _, position := range query.Positions {
+			positions = append(positions, string(position))
+		}
+		conditions = append(conditions, qb.In("position", positions))
+	}
+	if len(query.TeamIDs) > 0 {
+		conditions = append(conditions, qb.In("team_public_id", stringSliceToAny(query.TeamIDs)))
+	}
+	if query.MinPrice > 0 {
+		conditions = append(conditions, qb.Expr("price >= ?", query.MinPrice))
+	}
+	if query.MaxPrice > 0 {
+		conditions = append(conditions, qb.Expr("price <= ?", query.MaxPrice))
+	}
+	if name := strings.TrimSpace(query.Name); name != "" {
+		conditions = append(conditions, qb.Expr(
+			"unaccent(lower(name)) LIKE '%' || unaccent(lower(?)) || '%'",
+			escapeLikePattern(name),
+		))
+	}
+	if query.AvailableOnly {
+		conditions = append(conditions, qb.Eq("is_active", true))
+	}
+	if query.MinMinutes > 0 {
+		conditions = append(conditions, qb.Expr("minutes_played >= ?", query.MinMinutes))
+	}
+	if query.Cursor != "" {
+		cursor, err := decodePlayerMarketCursor(query.Cursor)
+		if err != nil {
+			return player.MarketPage{}, err
+		}
+		if cursor.Sort != string(query.Sort) || cursor.Desc != query.Descending {
+			return player.MarketPage{}, fmt.Errorf("%w: cursor was issued for another sort order", player.ErrInvalidCursor)
+		}
+		conditions = append(conditions, qb.Expr(
+			fmt.Sprintf("(%s, id) %s (?, ?)", sortColumn, cursorOp),
+			cursor.Value,
+			cursor.ID,
+		))
+	}
+
+	builder := qb.Select(playerMarketSelectColumns...).From(playerMarketSource).
+		Where(conditions...).
+		OrderBy(sortColumn+" "+direction, "id "+direction)
+	if query.Limit > 0 {
+		builder = builder.Limit(query.Limit + 1)
+	}
+	sqlQuery, args, err := builder.ToSQL()
+	if err != nil {
+		return player.MarketPage{}, fmt.Errorf("build search player market query: %w", err)
+	}
+
+	var rows []playerMarketRow
+	if err := r.db.SelectContext(ctx, &rows, sqlQuery, args...); err != nil {
+		return player.MarketPage{}, fmt.Errorf("search player market: %w", err)
+	}
+
+	page := player.MarketPage{}
+	if query.Limit > 0 && len(rows) > query.Limit {
+		rows = rows[:query.Limit]
+		last := rows[len(rows)-1]
+		page.NextCursor, err = encodePlayerMarketCursor(playerMarketCursor{
+			Sort:  string(query.Sort),
+			Desc:  query.Descending,
+			Value: playerMarketSortValue(query.Sort, last),
+			ID:    last.ID,
+		})
+		if err != nil {
+			return player.MarketPage{}, err
+		}
+	}
+
+	page.Items = make([]player.MarketPlayer, 0, len(rows))
+	for _, row := range rows {
+		page.Items = append(page.Items, player.MarketPlayer{
+			Player: player.Player{
+				ID:          row.PublicID,
+				LeagueID:    row.LeagueID,
+				TeamID:      row.TeamID,
+				Name:        row.Name,
+				Position:    player.Position(row.Position),
+				Price:       row.Price,
+				ImageURL:    row.ImageURL,
+				PlayerRefID: nullInt64ToInt64(row.PlayerRefID),
+				RefSource:   row.ExternalSource,
+				Unavailable: !row.IsActive,
+			},
+			Available:        row.IsActive,
+			TotalPoints:      row.TotalPoints,
+			MinutesPlayed:    row.MinutesPlayed,
+			Form:             row.Form,
+			OwnershipPercent: row.OwnershipPercent,
+		})
+	}
+
+	return page, nil
+}
+
+func playerMarketSortColumn(sort player.MarketSort) string {
+	switch sort {
+	case player.MarketSortPrice:
+		return "price"
+	case player.MarketSortTotalPoints:
+		return "total_points"
+	case player.MarketSortForm:
+		return "form"
+	case player.MarketSortOwnership:
+		return "ownership_percent"
+	default:
+		return "id"
+	}
+}
+
+func playerMarketSortValue(sort player.MarketSort, row playerMarketRow) float64 {
+	switch sort {
+	case player.MarketSortPrice:
+		return float64(row.Price)
+	case player.MarketSortTotalPoints:
+		return float64(row.TotalPoints)
+	case player.MarketSortForm:
+		return row.Form
+	case player.MarketSortOwnership:
+		return row.OwnershipPercent
+	default:
+		return float64(row.ID)
+	}
+}
+
+func encodePlayerMarketCursor(cursor playerMarketCursor) (string, error) {
+	raw, err := sonic.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("encode player market cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodePlayerMarketCursor(value string) (playerMarketCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return playerMarketCursor{}, fmt.Errorf("%w: %v", player.ErrInvalidCursor, err)
+	}
+
+	var cursor playerMarketCursor
+	if err := sonic.Unmarshal(raw, &cursor); err != nil {
+		return playerMarketCursor{}, fmt.Errorf("%w: %v", player.ErrInvalidCursor, err)
+	}
+	if cursor.ID <= 0 {
+		return playerMarketCursor{}, fmt.Errorf("%w: missing row id", player.ErrInvalidCursor)
+	}
+	return cursor, nil
+}
+
+// escapeLikePattern makes user input match literally inside a LIKE pattern.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
 func stringSliceToAny(items []string) []any {
 	out := make([]any, 0, len(items))
 	for _, item := range items {
@@ -130,15 +382,17 @@ func (r *PlayerRepository) UpsertPlayers(ctx context.Context, items []player.Pla
 
 	for _, item := range items {
 		insertModel := playerInsertModel{
-			PublicID:    strings.TrimSpace(item.ID),
-			LeagueID:    strings.TrimSpace(item.LeagueID),
-			TeamID:      strings.TrimSpace(item.TeamID),
-			Name:        strings.TrimSpace(item.Name),
-			Position:    strings.TrimSpace(string(item.Position)),
-			Price:       item.Price,
-			IsActive:    true,
-			PlayerRefID: nullableInt64(item.PlayerRefID),
-			ImageURL:    strings.TrimSpace(item.ImageURL),
+			PublicID:       strings.TrimSpace(item.ID),
+			LeagueID:       strings.TrimSpace(item.LeagueID),
+			TeamID:         strings.TrimSpace(item.TeamID),
+			Name:           strings.TrimSpace(item.Name),
+			Position:       strings.TrimSpace(string(item.Position)),
+			Price:          item.Price,
+			IsActive:       !item.Unavailable,
+			PlayerRefID:    nullableInt64(item.PlayerRefID),
+			ImageURL:       strings.TrimSpace(item.ImageURL),
+			ExternalSource: externalSourceOrDefault(item.RefSource),
+			IsHidden:       item.Hidden,
 		}
 		query, args, err := qb.InsertModel("players", insertModel, `ON CONFLICT (public_id)
 DO UPDATE SET
@@ -149,7 +403,9 @@ DO UPDATE SET
     price = EXCLUDED.price,
     is_active = EXCLUDED.is_active,
     external_player_id = EXCLUDED.external_player_id,
+    external_source = EXCLUDED.external_source,
     image_url = EXCLUDED.image_url,
+    is_hidden = EXCLUDED.is_hidden,
     deleted_at = NULL`)
 		if err != nil {
 			return fmt.Errorf("build upsert player query: %w", err)
@@ -32,6 +32,7 @@ func (r *PlayerStatsRepository) GetSeasonStatsByLeagueAndPlayer(ctx context.Cont
 		"COALESCE(SUM(pfs.yellow_cards), 0) AS yellow_cards",
 		"COALESCE(SUM(pfs.red_cards), 0) AS red_cards",
 		"COALESCE(SUM(pfs.saves), 0) AS saves",
+		"COALESCE(SUM(pfs.bonus_points), 0) AS bonus_points",
 		"COALESCE(COUNT(1), 0) AS appearances",
 		"COALESCE(SUM(pfs.fantasy_points), 0) AS total_points",
 	).From("player_fixture_stats pfs JOIN fixtures f ON f.public_id = pfs.fixture_public_id").
@@ -59,6 +60,7 @@ func (r *PlayerStatsRepository) GetSeasonStatsByLeagueAndPlayer(ctx context.Cont
 		YellowCards:   row.YellowCards,
 		RedCards:      row.RedCards,
 		Saves:         row.Saves,
+		BonusPoints:   row.BonusPoints,
 		Appearances:   row.Appearances,
 		TotalPoints:   row.TotalPoints,
 	}, nil
@@ -83,6 +85,7 @@ func (r *PlayerStatsRepository) ListMatchHistoryByLeagueAndPlayer(ctx context.Co
 		"pfs.yellow_cards",
 		"pfs.red_cards",
 		"pfs.saves",
+		"pfs.bonus_points",
 		"pfs.fantasy_points",
 		"pfs.advanced_stats",
 	).From("player_fixture_stats pfs JOIN fixtures f ON f.public_id = pfs.fixture_public_id").
@@ -178,6 +181,7 @@ func (r *PlayerStatsRepository) ListFixtureStatsByLeagueAndFixture(ctx context.C
 			YellowCards:       row.YellowCards,
 			RedCards:          row.RedCards,
 			Saves:             row.Saves,
+			BonusPoints:       row.BonusPoints,
 			FantasyPoints:     row.FantasyPoints,
 			AdvancedStats:     decodeJSONMap(row.AdvancedStats),
 		})
@@ -267,6 +271,7 @@ func (r *PlayerStatsRepository) UpsertFixtureStats(ctx context.Context, fixtureI
 			YellowCards:       stat.YellowCards,
 			RedCards:          stat.RedCards,
 			Saves:             stat.Saves,
+			BonusPoints:       stat.BonusPoints,
 			FantasyPoints:     stat.FantasyPoints,
 			AdvancedStats:     encodeJSONMap(stat.AdvancedStats),
 		}
@@ -290,6 +295,7 @@ DO UPDATE SET
     yellow_cards = EXCLUDED.yellow_cards,
     red_cards = EXCLUDED.red_cards,
     saves = EXCLUDED.saves,
+    bonus_points = EXCLUDED.bonus_points,
     fantasy_points = EXCLUDED.fantasy_points,
     advanced_stats = EXCLUDED.advanced_stats`, conflictTarget, conflictWhere)
 
@@ -301,6 +307,10 @@ DO UPDATE SET
 			return fmt.Errorf("upsert player fixture stat player=%s external_player_id=%d: %w", stat.PlayerID, stat.PlayerExternalID, err)
 		}
 	}
+	// Manual corrections win over provider values.
+	if _, err := tx.ExecContext(ctx, reapplyStatOverridesQuery, fixtureID); err != nil {
+		return fmt.Errorf("reapply stat corrections fixture=%s: %w", fixtureID, err)
+	}
 
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("commit upsert player fixture stats tx: %w", err)
@@ -375,7 +385,6 @@ func (r *PlayerStatsRepository) GetFantasyPointsByLeagueAndGameweek(ctx context.
 		Where(
 			qb.Eq("f.league_public_id", leagueID),
 			qb.Eq("f.gameweek", gameweek),
-			qb.Expr("pfs.player_public_id IS NOT NULL"),
 			qb.IsNull("pfs.deleted_at"),
 			qb.IsNull("f.deleted_at"),
 		).
@@ -397,6 +406,68 @@ func (r *PlayerStatsRepository) GetFantasyPointsByLeagueAndGameweek(ctx context.
 	return out, nil
 }
 
+func (r *PlayerStatsRepository) ListPlayerTotalsByLeague(ctx context.Context, leagueID string, fromGameweek, toGameweek int) ([]playerstats.PlayerTotals, error) {
+	conditions := []qb.Condition{
+		qb.Eq("f.league_public_id", leagueID),
+		qb.IsNull("pfs.deleted_at"),
+		qb.IsNull("f.deleted_at"),
+	}
+	if fromGameweek > 0 {
+		conditions = append(conditions, qb.Expr("f.gameweek >= ?", fromGameweek))
+	}
+	if toGameweek > 0 {
+		conditions = append(conditions, qb.Expr("f.gameweek <= ?", toGameweek))
+	}
+
+	// The latest team of a player wins when a transfer split the range.
+	query, args, err := qb.Select(
+		"pfs.player_public_id",
+		"COALESCE((ARRAY_AGG(pfs.team_public_id ORDER BY f.kickoff_at DESC, f.id DESC))[1], '') AS team_public_id",
+		"COALESCE(SUM(pfs.minutes_played), 0) AS minutes_played",
+		"COALESCE(SUM(pfs.goals), 0) AS goals",
+		"COALESCE(SUM(pfs.assists), 0) AS assists",
+		"COALESCE(SUM(CASE WHEN pfs.clean_sheet THEN 1 ELSE 0 END), 0) AS clean_sheets",
+		"COALESCE(SUM(pfs.yellow_cards), 0) AS yellow_cards",
+		"COALESCE(SUM(pfs.red_cards), 0) AS red_cards",
+		"COALESCE(SUM(pfs.saves), 0) AS saves",
+		"COALESCE(SUM(pfs.bonus_points), 0) AS bonus_points",
+		"COALESCE(COUNT(1), 0) AS appearances",
+		"COALESCE(SUM(pfs.fantasy_points), 0) AS total_points",
+	).From("player_fixture_stats pfs JOIN fixtures f ON f.public_id = pfs.fixture_public_id").
+		Where(conditions...).
+		GroupBy("pfs.player_public_id").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list player totals query: %w", err)
+	}
+
+	var rows []playerTotalsRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list player totals: %w", err)
+	}
+
+	out := make([]playerstats.PlayerTotals, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, playerstats.PlayerTotals{
+			PlayerID: row.PlayerID,
+			TeamID:   row.TeamID,
+			SeasonStats: playerstats.SeasonStats{
+				MinutesPlayed: row.MinutesPlayed,
+				Goals:         row.Goals,
+				Assists:       row.Assists,
+				CleanSheets:   row.CleanSheets,
+				YellowCards:   row.YellowCards,
+				RedCards:      row.RedCards,
+				Saves:         row.Saves,
+				BonusPoints:   row.BonusPoints,
+				Appearances:   row.Appearances,
+				TotalPoints:   row.TotalPoints,
+			},
+		})
+	}
+	return out, nil
+}
+
 type seasonStatsRow struct {
 	MinutesPlayed int `db:"minutes_played"`
 	Goals         int `db:"goals"`
@@ -405,10 +476,17 @@ type seasonStatsRow struct {
 	YellowCards   int `db:"yellow_cards"`
 	RedCards      int `db:"red_cards"`
 	Saves         int `db:"saves"`
+	BonusPoints   int `db:"bonus_points"`
 	Appearances   int `db:"appearances"`
 	TotalPoints   int `db:"total_points"`
 }
 
+type playerTotalsRow struct {
+	PlayerID string `db:"player_public_id"`
+	TeamID   string `db:"team_public_id"`
+	seasonStatsRow
+}
+
 type matchHistoryRow struct {
 	FixtureID     string         `db:"fixture_public_id"`
 	Gameweek      int            `db:"gameweek"`
@@ -457,6 +535,7 @@ type fixtureStatRow struct {
 	YellowCards       int            `db:"yellow_cards"`
 	RedCards          int            `db:"red_cards"`
 	Saves             int            `db:"saves"`
+	BonusPoints       int            `db:"bonus_points"`
 	FantasyPoints     int            `db:"fantasy_points"`
 	AdvancedStats     string         `db:"advanced_stats"`
 }
@@ -475,6 +554,7 @@ type playerFixtureStatInsertModel struct {
 	YellowCards       int     `db:"yellow_cards"`
 	RedCards          int     `db:"red_cards"`
 	Saves             int     `db:"saves"`
+	BonusPoints       int     `db:"bonus_points"`
 	FantasyPoints     int     `db:"fantasy_points"`
 	AdvancedStats     string  `db:"advanced_stats"`
 }
@@ -124,3 +124,31 @@ type userGameweekPointsInsertModel struct {
 	Points       int    `db:"points"`
 	CalculatedAt int64  `db:"calculated_at"`
 }
+
+type gameweekSummaryTableModel struct {
+	ID                    int64          `db:"id"`
+	LeagueID              string         `db:"league_public_id"`
+	Gameweek              int            `db:"gameweek"`
+	Managers              int            `db:"managers"`
+	AveragePoints         float64        `db:"average_points"`
+	HighestPoints         int            `db:"highest_points"`
+	HighestUserID         sql.NullString `db:"highest_user_id"`
+	MostCaptainedPlayerID sql.NullString `db:"most_captained_player_public_id"`
+	MostCaptainedCount    int            `db:"most_captained_count"`
+	CalculatedAt          int64          `db:"calculated_at"`
+	CreatedAt             time.Time      `db:"created_at"`
+	UpdatedAt             time.Time      `db:"updated_at"`
+	DeletedAt             *time.Time     `db:"deleted_at"`
+}
+
+type gameweekSummaryInsertModel struct {
+	LeagueID              string  `db:"league_public_id"`
+	Gameweek              int     `db:"gameweek"`
+	Managers              int     `db:"managers"`
+	AveragePoints         float64 `db:"average_points"`
+	HighestPoints         int     `db:"highest_points"`
+	HighestUserID         *string `db:"highest_user_id"`
+	MostCaptainedPlayerID *string `db:"most_captained_player_public_id"`
+	MostCaptainedCount    int     `db:"most_captained_count"`
+	CalculatedAt          int64   `db:"calculated_at"`
+}
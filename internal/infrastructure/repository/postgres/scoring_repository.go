@@ -171,6 +171,79 @@ func (r *ScoringRepository) GetSquadSnapshot(ctx context.Context, leagueID strin
 	}, true, nil
 }
 
+func (r *ScoringRepository) ListSquadSnapshotsByLeagueGameweek(ctx context.Context, leagueID string, gameweek int) ([]scoring.SquadSnapshot, error) {
+	query, args, err := qb.Select("*").
+		From("fantasy_squad_snapshots").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.Eq("gameweek", gameweek),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("id").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list squad snapshots query: %w", err)
+	}
+
+	var squadRows []squadSnapshotTableModel
+	if err := r.db.SelectContext(ctx, &squadRows, query, args...); err != nil {
+		return nil, fmt.Errorf("list squad snapshots: %w", err)
+	}
+	if len(squadRows) == 0 {
+		return []scoring.SquadSnapshot{}, nil
+	}
+
+	snapshotIDs := make([]any, 0, len(squadRows))
+	for _, row := range squadRows {
+		snapshotIDs = append(snapshotIDs, row.ID)
+	}
+	picksQuery, picksArgs, err := qb.Select("*").
+		From("fantasy_squad_snapshot_picks").
+		Where(
+			qb.In("squad_snapshot_id", snapshotIDs),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("id").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list squad snapshot picks query: %w", err)
+	}
+
+	var pickRows []squadSnapshotPickTableModel
+	if err := r.db.SelectContext(ctx, &pickRows, picksQuery, picksArgs...); err != nil {
+		return nil, fmt.Errorf("list squad snapshot picks: %w", err)
+	}
+	picksBySnapshot := make(map[int64][]fantasy.SquadPick, len(squadRows))
+	for _, row := range pickRows {
+		picksBySnapshot[row.SnapshotID] = append(picksBySnapshot[row.SnapshotID], fantasy.SquadPick{
+			PlayerID: row.PlayerID,
+			TeamID:   row.TeamID,
+			Position: player.Position(row.Position),
+			Price:    row.Price,
+		})
+	}
+
+	out := make([]scoring.SquadSnapshot, 0, len(squadRows))
+	for _, squadRow := range squadRows {
+		out = append(out, scoring.SquadSnapshot{
+			LeagueID: squadRow.LeagueID,
+			Gameweek: squadRow.Gameweek,
+			Squad: fantasy.Squad{
+				ID:        squadRow.SquadID,
+				UserID:    squadRow.UserID,
+				LeagueID:  squadRow.LeagueID,
+				Name:      squadRow.Name,
+				Picks:     picksBySnapshot[squadRow.ID],
+				BudgetCap: squadRow.BudgetCap,
+				CreatedAt: squadRow.CreatedAt,
+				UpdatedAt: squadRow.UpdatedAt,
+			},
+			CapturedAt: unixToTime(squadRow.CapturedAt),
+		})
+	}
+	return out, nil
+}
+
 func (r *ScoringRepository) UpsertSquadSnapshot(ctx context.Context, snapshot scoring.SquadSnapshot) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -433,3 +506,69 @@ func lineupSnapshotToDomain(row lineupSnapshotTableModel) lineup.Lineup {
 		UpdatedAt:     row.UpdatedAt,
 	}
 }
+
+func (r *ScoringRepository) UpsertGameweekSummary(ctx context.Context, summary scoring.GameweekSummary) error {
+	insertModel := gameweekSummaryInsertModel{
+		LeagueID:              summary.LeagueID,
+		Gameweek:              summary.Gameweek,
+		Managers:              summary.Managers,
+		AveragePoints:         summary.AveragePoints,
+		HighestPoints:         summary.HighestPoints,
+		HighestUserID:         nullableString(summary.HighestUserID),
+		MostCaptainedPlayerID: nullableString(summary.MostCaptainedPlayerID),
+		MostCaptainedCount:    summary.MostCaptainedCount,
+		CalculatedAt:          timeToUnix(summary.CalculatedAt),
+	}
+	query, args, err := qb.InsertModel("gameweek_summaries", insertModel, `ON CONFLICT (league_public_id, gameweek) WHERE deleted_at IS NULL
+DO UPDATE SET
+    managers = EXCLUDED.managers,
+    average_points = EXCLUDED.average_points,
+    highest_points = EXCLUDED.highest_points,
+    highest_user_id = EXCLUDED.highest_user_id,
+    most_captained_player_public_id = EXCLUDED.most_captained_player_public_id,
+    most_captained_count = EXCLUDED.most_captained_count,
+    calculated_at = EXCLUDED.calculated_at,
+    deleted_at = NULL`)
+	if err != nil {
+		return fmt.Errorf("build upsert gameweek summary query: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert gameweek summary: %w", err)
+	}
+	return nil
+}
+
+func (r *ScoringRepository) ListGameweekSummariesByLeague(ctx context.Context, leagueID string) ([]scoring.GameweekSummary, error) {
+	query, args, err := qb.Select("*").
+		From("gameweek_summaries").
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("gameweek").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list gameweek summaries query: %w", err)
+	}
+
+	var rows []gameweekSummaryTableModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list gameweek summaries: %w", err)
+	}
+
+	out := make([]scoring.GameweekSummary, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, scoring.GameweekSummary{
+			LeagueID:              row.LeagueID,
+			Gameweek:              row.Gameweek,
+			Managers:              row.Managers,
+			AveragePoints:         row.AveragePoints,
+			HighestPoints:         row.HighestPoints,
+			HighestUserID:         nullStringToString(row.HighestUserID),
+			MostCaptainedPlayerID: nullStringToString(row.MostCaptainedPlayerID),
+			MostCaptainedCount:    row.MostCaptainedCount,
+			CalculatedAt:          unixToTime(row.CalculatedAt),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+)
+
+type seasonRolloverTableModel struct {
+	ID                   int64         `db:"id"`
+	PublicID             string        `db:"public_id"`
+	FromLeagueID         string        `db:"from_league_public_id"`
+	ToLeagueID           string        `db:"to_league_public_id"`
+	FromSeason           string        `db:"from_season"`
+	ToSeason             string        `db:"to_season"`
+	SeasonRefID          sql.NullInt64 `db:"external_season_id"`
+	ArchivedSquads       int           `db:"archived_squads"`
+	ArchivedLineups      int           `db:"archived_lineups"`
+	ArchivedPoints       int           `db:"archived_points"`
+	ArchivedStandings    int           `db:"archived_standings"`
+	CarriedCustomLeagues int           `db:"carried_custom_leagues"`
+	CreatedAt            time.Time     `db:"created_at"`
+	UpdatedAt            time.Time     `db:"updated_at"`
+	DeletedAt            *time.Time    `db:"deleted_at"`
+}
+
+type seasonRolloverInsertModel struct {
+	PublicID             string `db:"public_id"`
+	FromLeagueID         string `db:"from_league_public_id"`
+	ToLeagueID           string `db:"to_league_public_id"`
+	FromSeason           string `db:"from_season"`
+	ToSeason             string `db:"to_season"`
+	SeasonRefID          *int64 `db:"external_season_id"`
+	ArchivedSquads       int    `db:"archived_squads"`
+	ArchivedLineups      int    `db:"archived_lineups"`
+	ArchivedPoints       int    `db:"archived_points"`
+	ArchivedStandings    int    `db:"archived_standings"`
+	CarriedCustomLeagues int    `db:"carried_custom_leagues"`
+}
+
+type userSeasonSummaryTableModel struct {
+	ID                 int64          `db:"id"`
+	LeagueID           string         `db:"league_public_id"`
+	Season             string         `db:"season"`
+	UserID             string         `db:"user_id"`
+	SquadID            sql.NullString `db:"squad_public_id"`
+	SquadName          string         `db:"squad_name"`
+	TotalPoints        int            `db:"total_points"`
+	OverallRank        int            `db:"overall_rank"`
+	TotalManagers      int            `db:"total_managers"`
+	GameweeksPlayed    int            `db:"gameweeks_played"`
+	BestGameweek       int            `db:"best_gameweek"`
+	BestGameweekPoints int            `db:"best_gameweek_points"`
+	FinalPicks         string         `db:"final_picks"`
+	CustomLeagues      string         `db:"custom_leagues"`
+	ComputedAt         time.Time      `db:"computed_at"`
+	CreatedAt          time.Time      `db:"created_at"`
+	UpdatedAt          time.Time      `db:"updated_at"`
+	DeletedAt          *time.Time     `db:"deleted_at"`
+}
+
+type userSeasonSummaryInsertModel struct {
+	LeagueID           string    `db:"league_public_id"`
+	Season             string    `db:"season"`
+	UserID             string    `db:"user_id"`
+	SquadID            *string   `db:"squad_public_id"`
+	SquadName          string    `db:"squad_name"`
+	TotalPoints        int       `db:"total_points"`
+	OverallRank        int       `db:"overall_rank"`
+	TotalManagers      int       `db:"total_managers"`
+	GameweeksPlayed    int       `db:"gameweeks_played"`
+	BestGameweek       int       `db:"best_gameweek"`
+	BestGameweekPoints int       `db:"best_gameweek_points"`
+	FinalPicks         string    `db:"final_picks"`
+	CustomLeagues      string    `db:"custom_leagues"`
+	ComputedAt         time.Time `db:"computed_at"`
+}
+
+type userSeasonSummaryPickJSON struct {
+	PlayerID string `json:"player_id"`
+	TeamID   string `json:"team_id"`
+	Position string `json:"position"`
+	Price    int64  `json:"price"`
+}
+
+type userSeasonSummaryCustomLeagueJSON struct {
+	GroupID   string `json:"group_id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"is_default"`
+	Rank      int    `json:"rank"`
+	Points    int    `json:"points"`
+	Members   int    `json:"members"`
+}
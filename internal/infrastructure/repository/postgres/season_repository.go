@@ -0,0 +1,384 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/season"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type SeasonRepository struct {
+	db *sqlx.DB
+}
+
+func NewSeasonRepository(db *sqlx.DB) *SeasonRepository {
+	return &SeasonRepository{db: db}
+}
+
+// Rollover archives plan.From and creates plan.To with its carried-over custom
+// leagues in one transaction. Rows keyed by the previous league are kept as
+// they are; archiving only freezes them and records how many were kept.
+func (r *SeasonRepository) Rollover(ctx context.Context, plan season.RolloverPlan) (season.Rollover, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return season.Rollover{}, fmt.Errorf("begin tx season rollover: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var from leagueTableModel
+	if err := tx.GetContext(ctx, &from, `SELECT * FROM leagues
+WHERE public_id = $1 AND deleted_at IS NULL
+FOR UPDATE`, plan.From.ID); err != nil {
+		if isNotFound(err) {
+			return season.Rollover{}, fmt.Errorf("lock league for rollover: league=%s not found", plan.From.ID)
+		}
+		return season.Rollover{}, fmt.Errorf("lock league for rollover: %w", err)
+	}
+	if from.ArchivedAt.Valid {
+		return season.Rollover{}, fmt.Errorf("lock league for rollover: league=%s already archived", plan.From.ID)
+	}
+
+	archiveQuery, archiveArgs, err := qb.Update("leagues").
+		SetExpr("archived_at", "NOW()").
+		Where(qb.Eq("public_id", plan.From.ID)).
+		ToSQL()
+	if err != nil {
+		return season.Rollover{}, fmt.Errorf("build archive league query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, archiveQuery, archiveArgs...); err != nil {
+		return season.Rollover{}, fmt.Errorf("archive league: %w", err)
+	}
+
+	leagueInsert := leagueInsertModel{
+		PublicID:       plan.To.ID,
+		Name:           plan.To.Name,
+		CountryCode:    plan.To.CountryCode,
+		Season:         plan.To.Season,
+		IsDefault:      plan.To.IsDefault,
+		ExternalSource: externalSourceOrDefault(from.ExternalSource),
+		OriginLeagueID: plan.To.ConfigKey(),
+	}
+	if from.LeagueRefID.Valid {
+		leagueInsert.LeagueRefID = &from.LeagueRefID.Int64
+	}
+	if plan.To.SeasonRefID > 0 {
+		leagueInsert.SeasonRefID = &plan.To.SeasonRefID
+	}
+	if plan.To.PreviousLeagueID != "" {
+		leagueInsert.PreviousLeagueID = &plan.To.PreviousLeagueID
+	}
+	leagueQuery, leagueArgs, err := qb.InsertModel("leagues", leagueInsert, "")
+	if err != nil {
+		return season.Rollover{}, fmt.Errorf("build insert season league query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, leagueQuery, leagueArgs...); err != nil {
+		return season.Rollover{}, fmt.Errorf("insert season league: %w", err)
+	}
+
+	for _, item := range plan.Groups {
+		group := item.Group
+		group.PreviousGroupID = item.PreviousGroupID
+		query, args, err := qb.InsertModel("custom_leagues", customLeagueInsertModelFromGroup(group), "")
+		if err != nil {
+			return season.Rollover{}, fmt.Errorf("build carry over custom league query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return season.Rollover{}, fmt.Errorf("carry over custom league=%s: %w", item.PreviousGroupID, err)
+		}
+	}
+
+	insertModel := seasonRolloverInsertModel{
+		PublicID:             plan.RolloverID,
+		FromLeagueID:         plan.From.ID,
+		ToLeagueID:           plan.To.ID,
+		FromSeason:           from.Season,
+		ToSeason:             plan.To.Season,
+		CarriedCustomLeagues: len(plan.Groups),
+	}
+	if plan.To.SeasonRefID > 0 {
+		insertModel.SeasonRefID = &plan.To.SeasonRefID
+	}
+	if insertModel.ArchivedSquads, err = countLeagueRows(ctx, tx, "fantasy_squads", plan.From.ID); err != nil {
+		return season.Rollover{}, err
+	}
+	if insertModel.ArchivedLineups, err = countLeagueRows(ctx, tx, "lineups", plan.From.ID); err != nil {
+		return season.Rollover{}, err
+	}
+	if insertModel.ArchivedPoints, err = countLeagueRows(ctx, tx, "user_gameweek_points", plan.From.ID); err != nil {
+		return season.Rollover{}, err
+	}
+	standingsQuery, standingsArgs, err := qb.Select("COUNT(*)").
+		From("custom_league_standings s JOIN custom_leagues cl ON cl.public_id = s.custom_league_public_id").
+		Where(
+			qb.Eq("cl.league_public_id", plan.From.ID),
+			qb.IsNull("cl.deleted_at"),
+			qb.IsNull("s.deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return season.Rollover{}, fmt.Errorf("build count custom league standings query: %w", err)
+	}
+	if err := tx.GetContext(ctx, &insertModel.ArchivedStandings, standingsQuery, standingsArgs...); err != nil {
+		return season.Rollover{}, fmt.Errorf("count custom league standings: %w", err)
+	}
+
+	rolloverQuery, rolloverArgs, err := qb.InsertModel("season_rollovers", insertModel, "RETURNING *")
+	if err != nil {
+		return season.Rollover{}, fmt.Errorf("build insert season rollover query: %w", err)
+	}
+	var row seasonRolloverTableModel
+	if err := tx.GetContext(ctx, &row, rolloverQuery, rolloverArgs...); err != nil {
+		return season.Rollover{}, fmt.Errorf("insert season rollover: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return season.Rollover{}, fmt.Errorf("commit season rollover tx: %w", err)
+	}
+
+	return seasonRolloverFromRow(row), nil
+}
+
+func (r *SeasonRepository) GetRolloverByFromLeague(ctx context.Context, leagueID string) (season.Rollover, bool, error) {
+	query, args, err := qb.Select("*").From("season_rollovers").
+		Where(
+			qb.Eq("from_league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return season.Rollover{}, false, fmt.Errorf("build get season rollover query: %w", err)
+	}
+
+	var row seasonRolloverTableModel
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if isNotFound(err) {
+			return season.Rollover{}, false, nil
+		}
+		return season.Rollover{}, false, fmt.Errorf("get season rollover: %w", err)
+	}
+
+	return seasonRolloverFromRow(row), true, nil
+}
+
+func (r *SeasonRepository) UpsertUserSummaries(ctx context.Context, items []season.UserSummary) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx upsert user season summaries: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, item := range items {
+		insertModel, err := userSeasonSummaryInsertModelFromDomain(item)
+		if err != nil {
+			return err
+		}
+		query, args, err := qb.InsertModel("user_season_summaries", insertModel, `ON CONFLICT (league_public_id, user_id) WHERE deleted_at IS NULL
+DO UPDATE SET
+    season = EXCLUDED.season,
+    squad_public_id = EXCLUDED.squad_public_id,
+    squad_name = EXCLUDED.squad_name,
+    total_points = EXCLUDED.total_points,
+    overall_rank = EXCLUDED.overall_rank,
+    total_managers = EXCLUDED.total_managers,
+    gameweeks_played = EXCLUDED.gameweeks_played,
+    best_gameweek = EXCLUDED.best_gameweek,
+    best_gameweek_points = EXCLUDED.best_gameweek_points,
+    final_picks = EXCLUDED.final_picks,
+    custom_leagues = EXCLUDED.custom_leagues,
+    computed_at = EXCLUDED.computed_at,
+    deleted_at = NULL`)
+		if err != nil {
+			return fmt.Errorf("build upsert user season summary query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("upsert user season summary league=%s user=%s: %w", item.LeagueID, item.UserID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit upsert user season summaries: %w", err)
+	}
+	return nil
+}
+
+func (r *SeasonRepository) ListUserSummariesByUser(ctx context.Context, userID string) ([]season.UserSummary, error) {
+	query, args, err := qb.Select("*").From("user_season_summaries").
+		Where(
+			qb.Eq("user_id", userID),
+			qb.IsNull("deleted_at"),
+		).
+		OrderBy("computed_at DESC", "id DESC").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list user season summaries query: %w", err)
+	}
+
+	var rows []userSeasonSummaryTableModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list user season summaries: %w", err)
+	}
+
+	out := make([]season.UserSummary, 0, len(rows))
+	for _, row := range rows {
+		item, err := userSeasonSummaryFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func countLeagueRows(ctx context.Context, tx *sqlx.Tx, table, leagueID string) (int, error) {
+	query, args, err := qb.Select("COUNT(*)").From(table).
+		Where(
+			qb.Eq("league_public_id", leagueID),
+			qb.IsNull("deleted_at"),
+		).
+		ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("build count %s query: %w", table, err)
+	}
+
+	var count int
+	if err := tx.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, fmt.Errorf("count %s: %w", table, err)
+	}
+	return count, nil
+}
+
+func seasonRolloverFromRow(row seasonRolloverTableModel) season.Rollover {
+	return season.Rollover{
+		ID:                   row.PublicID,
+		FromLeagueID:         row.FromLeagueID,
+		ToLeagueID:           row.ToLeagueID,
+		FromSeason:           row.FromSeason,
+		ToSeason:             row.ToSeason,
+		SeasonRefID:          nullInt64ToInt64(row.SeasonRefID),
+		ArchivedSquads:       row.ArchivedSquads,
+		ArchivedLineups:      row.ArchivedLineups,
+		ArchivedPoints:       row.ArchivedPoints,
+		ArchivedStandings:    row.ArchivedStandings,
+		CarriedCustomLeagues: row.CarriedCustomLeagues,
+		CreatedAt:            row.CreatedAt,
+	}
+}
+
+func userSeasonSummaryInsertModelFromDomain(item season.UserSummary) (userSeasonSummaryInsertModel, error) {
+	picks := make([]userSeasonSummaryPickJSON, 0, len(item.FinalPicks))
+	for _, pick := range item.FinalPicks {
+		picks = append(picks, userSeasonSummaryPickJSON{
+			PlayerID: pick.PlayerID,
+			TeamID:   pick.TeamID,
+			Position: string(pick.Position),
+			Price:    pick.Price,
+		})
+	}
+	groups := make([]userSeasonSummaryCustomLeagueJSON, 0, len(item.CustomLeagues))
+	for _, group := range item.CustomLeagues {
+		groups = append(groups, userSeasonSummaryCustomLeagueJSON{
+			GroupID:   group.GroupID,
+			Name:      group.Name,
+			IsDefault: group.IsDefault,
+			Rank:      group.Rank,
+			Points:    group.Points,
+			Members:   group.Members,
+		})
+	}
+
+	rawPicks, err := sonic.Marshal(picks)
+	if err != nil {
+		return userSeasonSummaryInsertModel{}, fmt.Errorf("marshal user season summary picks: %w", err)
+	}
+	rawGroups, err := sonic.Marshal(groups)
+	if err != nil {
+		return userSeasonSummaryInsertModel{}, fmt.Errorf("marshal user season summary custom leagues: %w", err)
+	}
+
+	var squadID *string
+	if value := strings.TrimSpace(item.SquadID); value != "" {
+		squadID = &value
+	}
+
+	return userSeasonSummaryInsertModel{
+		LeagueID:           item.LeagueID,
+		Season:             item.Season,
+		UserID:             item.UserID,
+		SquadID:            squadID,
+		SquadName:          item.SquadName,
+		TotalPoints:        item.TotalPoints,
+		OverallRank:        item.OverallRank,
+		TotalManagers:      item.TotalManagers,
+		GameweeksPlayed:    item.GameweeksPlayed,
+		BestGameweek:       item.BestGameweek,
+		BestGameweekPoints: item.BestGameweekPoints,
+		FinalPicks:         string(rawPicks),
+		CustomLeagues:      string(rawGroups),
+		ComputedAt:         item.ComputedAt.UTC(),
+	}, nil
+}
+
+func userSeasonSummaryFromRow(row userSeasonSummaryTableModel) (season.UserSummary, error) {
+	var picks []userSeasonSummaryPickJSON
+	if raw := strings.TrimSpace(row.FinalPicks); raw != "" {
+		if err := sonic.Unmarshal([]byte(raw), &picks); err != nil {
+			return season.UserSummary{}, fmt.Errorf("decode user season summary picks league=%s user=%s: %w", row.LeagueID, row.UserID, err)
+		}
+	}
+	var groups []userSeasonSummaryCustomLeagueJSON
+	if raw := strings.TrimSpace(row.CustomLeagues); raw != "" {
+		if err := sonic.Unmarshal([]byte(raw), &groups); err != nil {
+			return season.UserSummary{}, fmt.Errorf("decode user season summary custom leagues league=%s user=%s: %w", row.LeagueID, row.UserID, err)
+		}
+	}
+
+	item := season.UserSummary{
+		LeagueID:           row.LeagueID,
+		Season:             row.Season,
+		UserID:             row.UserID,
+		SquadID:            nullStringToString(row.SquadID),
+		SquadName:          row.SquadName,
+		TotalPoints:        row.TotalPoints,
+		OverallRank:        row.OverallRank,
+		TotalManagers:      row.TotalManagers,
+		GameweeksPlayed:    row.GameweeksPlayed,
+		BestGameweek:       row.BestGameweek,
+		BestGameweekPoints: row.BestGameweekPoints,
+		FinalPicks:         make([]fantasy.SquadPick, 0, len(picks)),
+		CustomLeagues:      make([]season.CustomLeagueFinish, 0, len(groups)),
+		ComputedAt:         row.ComputedAt,
+	}
+	for _, pick := range picks {
+		item.FinalPicks = append(item.FinalPicks, fantasy.SquadPick{
+			PlayerID: pick.PlayerID,
+			TeamID:   pick.TeamID,
+			Position: player.Position(pick.Position),
+			Price:    pick.Price,
+		})
+	}
+	for _, group := range groups {
+		item.CustomLeagues = append(item.CustomLeagues, season.CustomLeagueFinish{
+			GroupID:   group.GroupID,
+			Name:      group.Name,
+			IsDefault: group.IsDefault,
+			Rank:      group.Rank,
+			Points:    group.Points,
+			Members:   group.Members,
+		})
+	}
+	return item, nil
+}
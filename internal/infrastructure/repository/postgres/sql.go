@@ -36,6 +36,16 @@ func quoteLiteral(value string) string {
 	return "'" + escaped + "'"
 }
 
+// externalSourceOrDefault keeps rows written without a provider on the
+// original SportMonks source so existing external id indexes stay valid.
+func externalSourceOrDefault(source string) string {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return "sportmonks"
+	}
+	return source
+}
+
 func nullInt64ToInt64(v sql.NullInt64) int64 {
 	if !v.Valid {
 		return 0
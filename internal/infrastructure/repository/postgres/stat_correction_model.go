@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+)
+
+type statCorrectionInsertModel struct {
+	LeagueID     string  `db:"league_public_id"`
+	FixtureID    string  `db:"fixture_public_id"`
+	Gameweek     int     `db:"gameweek"`
+	Reason       string  `db:"reason"`
+	CreatedBy    string  `db:"created_by"`
+	TraceID      *string `db:"trace_id"`
+	Players      string  `db:"players"`
+	PointsBefore string  `db:"points_before"`
+}
+
+type statCorrectionTableModel struct {
+	ID           int64          `db:"id"`
+	LeagueID     string         `db:"league_public_id"`
+	FixtureID    string         `db:"fixture_public_id"`
+	Gameweek     int            `db:"gameweek"`
+	Reason       string         `db:"reason"`
+	CreatedBy    string         `db:"created_by"`
+	TraceID      sql.NullString `db:"trace_id"`
+	Players      string         `db:"players"`
+	PointsBefore string         `db:"points_before"`
+	RescoredAt   sql.NullTime   `db:"rescored_at"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+type playerFixtureStatOverrideInsertModel struct {
+	CorrectionID  int64  `db:"correction_id"`
+	FixtureID     string `db:"fixture_public_id"`
+	PlayerID      string `db:"player_public_id"`
+	TeamID        string `db:"team_public_id"`
+	MinutesPlayed int    `db:"minutes_played"`
+	Goals         int    `db:"goals"`
+	Assists       int    `db:"assists"`
+	CleanSheet    bool   `db:"clean_sheet"`
+	YellowCards   int    `db:"yellow_cards"`
+	RedCards      int    `db:"red_cards"`
+	Saves         int    `db:"saves"`
+	BonusPoints   int    `db:"bonus_points"`
+	FantasyPoints int    `db:"fantasy_points"`
+}
+
+type statCorrectionUserPointsInsertModel struct {
+	CorrectionID int64  `db:"correction_id"`
+	UserID       string `db:"user_id"`
+	PointsBefore int    `db:"points_before"`
+	PointsAfter  int    `db:"points_after"`
+}
+
+type statCorrectionUserPointsTableModel struct {
+	CorrectionID int64  `db:"correction_id"`
+	UserID       string `db:"user_id"`
+	PointsBefore int    `db:"points_before"`
+	PointsAfter  int    `db:"points_after"`
+}
+
+// statCorrectionPlayerJSON is one entry of stat_corrections.players.
+type statCorrectionPlayerJSON struct {
+	PlayerID string                 `json:"player_id"`
+	Before   statCorrectionStatJSON `json:"before"`
+	After    statCorrectionStatJSON `json:"after"`
+}
+
+type statCorrectionStatJSON struct {
+	TeamID        string `json:"team_id"`
+	MinutesPlayed int    `json:"minutes_played"`
+	Goals         int    `json:"goals"`
+	Assists       int    `json:"assists"`
+	CleanSheet    bool   `json:"clean_sheet"`
+	YellowCards   int    `json:"yellow_cards"`
+	RedCards      int    `json:"red_cards"`
+	Saves         int    `json:"saves"`
+	BonusPoints   int    `json:"bonus_points"`
+	FantasyPoints int    `json:"fantasy_points"`
+}
@@ -0,0 +1,353 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statcorrection"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+// reapplyStatOverridesQuery restores corrected values after a provider upsert
+// of one fixture.
+const reapplyStatOverridesQuery = `UPDATE player_fixture_stats pfs
+SET
+    team_public_id = o.team_public_id,
+    minutes_played = o.minutes_played,
+    goals = o.goals,
+    assists = o.assists,
+    clean_sheet = o.clean_sheet,
+    yellow_cards = o.yellow_cards,
+    red_cards = o.red_cards,
+    saves = o.saves,
+    bonus_points = o.bonus_points,
+    fantasy_points = o.fantasy_points
+FROM player_fixture_stat_overrides o
+WHERE o.fixture_public_id = pfs.fixture_public_id
+  AND o.player_public_id = pfs.player_public_id
+  AND o.deleted_at IS NULL
+  AND pfs.deleted_at IS NULL
+  AND pfs.fixture_public_id = $1`
+
+type StatCorrectionRepository struct {
+	db *sqlx.DB
+}
+
+func NewStatCorrectionRepository(db *sqlx.DB) *StatCorrectionRepository {
+	return &StatCorrectionRepository{db: db}
+}
+
+func (r *StatCorrectionRepository) Save(ctx context.Context, item statcorrection.Correction) (statcorrection.Correction, error) {
+	players := make([]statCorrectionPlayerJSON, 0, len(item.Players))
+	for _, change := range item.Players {
+		players = append(players, statCorrectionPlayerJSON{
+			PlayerID: change.PlayerID,
+			Before:   statCorrectionStatToJSON(change.Before),
+			After:    statCorrectionStatToJSON(change.After),
+		})
+	}
+	playersJSON, err := sonic.Marshal(players)
+	if err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("marshal stat correction players: %w", err)
+	}
+	pointsBefore := item.PointsBefore
+	if pointsBefore == nil {
+		pointsBefore = map[string]int{}
+	}
+	pointsBeforeJSON, err := sonic.Marshal(pointsBefore)
+	if err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("marshal stat correction points before: %w", err)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("begin tx save stat correction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	insertModel := statCorrectionInsertModel{
+		LeagueID:     item.LeagueID,
+		FixtureID:    item.FixtureID,
+		Gameweek:     item.Gameweek,
+		Reason:       item.Reason,
+		CreatedBy:    item.CreatedBy,
+		TraceID:      nullableString(item.TraceID),
+		Players:      string(playersJSON),
+		PointsBefore: string(pointsBeforeJSON),
+	}
+	query, args, err := qb.InsertModel("stat_corrections", insertModel, "RETURNING id, created_at")
+	if err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("build insert stat correction query: %w", err)
+	}
+	if err := tx.QueryRowxContext(ctx, query, args...).Scan(&item.ID, &item.CreatedAt); err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("insert stat correction fixture=%s: %w", item.FixtureID, err)
+	}
+
+	for _, change := range item.Players {
+		after := change.After
+		overrideModel := playerFixtureStatOverrideInsertModel{
+			CorrectionID:  item.ID,
+			FixtureID:     item.FixtureID,
+			PlayerID:      change.PlayerID,
+			TeamID:        after.TeamID,
+			MinutesPlayed: after.MinutesPlayed,
+			Goals:         after.Goals,
+			Assists:       after.Assists,
+			CleanSheet:    after.CleanSheet,
+			YellowCards:   after.YellowCards,
+			RedCards:      after.RedCards,
+			Saves:         after.Saves,
+			BonusPoints:   after.BonusPoints,
+			FantasyPoints: after.FantasyPoints,
+		}
+		overrideQuery, overrideArgs, err := qb.InsertModel("player_fixture_stat_overrides", overrideModel, `ON CONFLICT (fixture_public_id, player_public_id) WHERE deleted_at IS NULL
+DO UPDATE SET
+    correction_id = EXCLUDED.correction_id,
+    team_public_id = EXCLUDED.team_public_id,
+    minutes_played = EXCLUDED.minutes_played,
+    goals = EXCLUDED.goals,
+    assists = EXCLUDED.assists,
+    clean_sheet = EXCLUDED.clean_sheet,
+    yellow_cards = EXCLUDED.yellow_cards,
+    red_cards = EXCLUDED.red_cards,
+    saves = EXCLUDED.saves,
+    bonus_points = EXCLUDED.bonus_points,
+    fantasy_points = EXCLUDED.fantasy_points`)
+		if err != nil {
+			return statcorrection.Correction{}, fmt.Errorf("build upsert stat override query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, overrideQuery, overrideArgs...); err != nil {
+			return statcorrection.Correction{}, fmt.Errorf("upsert stat override player=%s: %w", change.PlayerID, err)
+		}
+
+		// Players the provider missed get a stats row of their own.
+		statModel := playerFixtureStatInsertModel{
+			FixtureID:     item.FixtureID,
+			PlayerID:      nullableString(change.PlayerID),
+			TeamID:        nullableString(after.TeamID),
+			MinutesPlayed: after.MinutesPlayed,
+			Goals:         after.Goals,
+			Assists:       after.Assists,
+			CleanSheet:    after.CleanSheet,
+			YellowCards:   after.YellowCards,
+			RedCards:      after.RedCards,
+			Saves:         after.Saves,
+			BonusPoints:   after.BonusPoints,
+			FantasyPoints: after.FantasyPoints,
+			AdvancedStats: encodeJSONMap(after.AdvancedStats),
+		}
+		statQuery, statArgs, err := qb.InsertModel("player_fixture_stats", statModel, `ON CONFLICT (fixture_public_id, player_public_id) WHERE deleted_at IS NULL
+DO UPDATE SET
+    team_public_id = EXCLUDED.team_public_id,
+    minutes_played = EXCLUDED.minutes_played,
+    goals = EXCLUDED.goals,
+    assists = EXCLUDED.assists,
+    clean_sheet = EXCLUDED.clean_sheet,
+    yellow_cards = EXCLUDED.yellow_cards,
+    red_cards = EXCLUDED.red_cards,
+    saves = EXCLUDED.saves,
+    bonus_points = EXCLUDED.bonus_points,
+    fantasy_points = EXCLUDED.fantasy_points`)
+		if err != nil {
+			return statcorrection.Correction{}, fmt.Errorf("build apply stat correction query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, statQuery, statArgs...); err != nil {
+			return statcorrection.Correction{}, fmt.Errorf("apply stat correction player=%s: %w", change.PlayerID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("commit save stat correction tx: %w", err)
+	}
+	return item, nil
+}
+
+func (r *StatCorrectionRepository) SaveUserPoints(ctx context.Context, correctionID int64, items []statcorrection.UserPointsChange) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx save stat correction user points: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// A retried rescore may change a different set of users, so the earlier
+	// rows are replaced rather than merged.
+	deleteQuery, deleteArgs, err := qb.DeleteFrom("stat_correction_user_points").
+		Where(qb.Eq("correction_id", correctionID)).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("build delete stat correction user points query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, deleteQuery, deleteArgs...); err != nil {
+		return fmt.Errorf("delete stat correction user points id=%d: %w", correctionID, err)
+	}
+
+	for _, item := range items {
+		insertModel := statCorrectionUserPointsInsertModel{
+			CorrectionID: correctionID,
+			UserID:       item.UserID,
+			PointsBefore: item.Before,
+			PointsAfter:  item.After,
+		}
+		query, args, err := qb.InsertModel("stat_correction_user_points", insertModel, "")
+		if err != nil {
+			return fmt.Errorf("build insert stat correction user points query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("insert stat correction user points user=%s: %w", item.UserID, err)
+		}
+	}
+
+	markQuery, markArgs, err := qb.Update("stat_corrections").
+		SetExpr("rescored_at", "NOW()").
+		Where(qb.Eq("id", correctionID)).
+		ToSQL()
+	if err != nil {
+		return fmt.Errorf("build mark stat correction rescored query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, markQuery, markArgs...); err != nil {
+		return fmt.Errorf("mark stat correction rescored id=%d: %w", correctionID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit save stat correction user points tx: %w", err)
+	}
+	return nil
+}
+
+func (r *StatCorrectionRepository) ListByFixture(ctx context.Context, leagueID, fixtureID string) ([]statcorrection.Correction, error) {
+	return r.list(ctx, []qb.Condition{
+		qb.Eq("league_public_id", leagueID),
+		qb.Eq("fixture_public_id", fixtureID),
+	}, "created_at DESC", "id DESC")
+}
+
+// ListPending returns the corrections of a league whose rescore has not been
+// recorded yet, oldest first.
+func (r *StatCorrectionRepository) ListPending(ctx context.Context, leagueID string) ([]statcorrection.Correction, error) {
+	return r.list(ctx, []qb.Condition{
+		qb.Eq("league_public_id", leagueID),
+		qb.IsNull("rescored_at"),
+	}, "id ASC")
+}
+
+func (r *StatCorrectionRepository) list(ctx context.Context, conditions []qb.Condition, orderBy ...string) ([]statcorrection.Correction, error) {
+	query, args, err := qb.Select("id", "league_public_id", "fixture_public_id", "gameweek", "reason", "created_by", "trace_id", "players", "points_before", "rescored_at", "created_at").
+		From("stat_corrections").
+		Where(conditions...).
+		OrderBy(orderBy...).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list stat corrections query: %w", err)
+	}
+	var rows []statCorrectionTableModel
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list stat corrections: %w", err)
+	}
+	if len(rows) == 0 {
+		return []statcorrection.Correction{}, nil
+	}
+
+	ids := make([]any, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	pointsQuery, pointsArgs, err := qb.Select("correction_id", "user_id", "points_before", "points_after").
+		From("stat_correction_user_points").
+		Where(qb.In("correction_id", ids)).
+		OrderBy("correction_id", "user_id").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list stat correction user points query: %w", err)
+	}
+	var pointRows []statCorrectionUserPointsTableModel
+	if err := r.db.SelectContext(ctx, &pointRows, pointsQuery, pointsArgs...); err != nil {
+		return nil, fmt.Errorf("list stat correction user points: %w", err)
+	}
+	pointsByCorrection := make(map[int64][]statcorrection.UserPointsChange, len(rows))
+	for _, row := range pointRows {
+		pointsByCorrection[row.CorrectionID] = append(pointsByCorrection[row.CorrectionID], statcorrection.UserPointsChange{
+			UserID: row.UserID,
+			Before: row.PointsBefore,
+			After:  row.PointsAfter,
+		})
+	}
+
+	out := make([]statcorrection.Correction, 0, len(rows))
+	for _, row := range rows {
+		var players []statCorrectionPlayerJSON
+		if raw := strings.TrimSpace(row.Players); raw != "" {
+			if err := sonic.Unmarshal([]byte(raw), &players); err != nil {
+				return nil, fmt.Errorf("decode stat correction players id=%d: %w", row.ID, err)
+			}
+		}
+		pointsBefore := make(map[string]int)
+		if raw := strings.TrimSpace(row.PointsBefore); raw != "" {
+			if err := sonic.Unmarshal([]byte(raw), &pointsBefore); err != nil {
+				return nil, fmt.Errorf("decode stat correction points before id=%d: %w", row.ID, err)
+			}
+		}
+		item := statcorrection.Correction{
+			ID:           row.ID,
+			LeagueID:     row.LeagueID,
+			FixtureID:    row.FixtureID,
+			Gameweek:     row.Gameweek,
+			Reason:       row.Reason,
+			CreatedBy:    row.CreatedBy,
+			TraceID:      nullStringToString(row.TraceID),
+			Players:      make([]statcorrection.PlayerChange, 0, len(players)),
+			PointsBefore: pointsBefore,
+			UserPoints:   pointsByCorrection[row.ID],
+			RescoredAt:   nullTimeToTimePtr(row.RescoredAt),
+			CreatedAt:    row.CreatedAt,
+		}
+		for _, player := range players {
+			item.Players = append(item.Players, statcorrection.PlayerChange{
+				PlayerID: player.PlayerID,
+				Before:   statCorrectionStatFromJSON(row.FixtureID, player.PlayerID, player.Before),
+				After:    statCorrectionStatFromJSON(row.FixtureID, player.PlayerID, player.After),
+			})
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func statCorrectionStatToJSON(item playerstats.FixtureStat) statCorrectionStatJSON {
+	return statCorrectionStatJSON{
+		TeamID:        item.TeamID,
+		MinutesPlayed: item.MinutesPlayed,
+		Goals:         item.Goals,
+		Assists:       item.Assists,
+		CleanSheet:    item.CleanSheet,
+		YellowCards:   item.YellowCards,
+		RedCards:      item.RedCards,
+		Saves:         item.Saves,
+		BonusPoints:   item.BonusPoints,
+		FantasyPoints: item.FantasyPoints,
+	}
+}
+
+func statCorrectionStatFromJSON(fixtureID, playerID string, item statCorrectionStatJSON) playerstats.FixtureStat {
+	return playerstats.FixtureStat{
+		FixtureID:     fixtureID,
+		PlayerID:      playerID,
+		TeamID:        item.TeamID,
+		MinutesPlayed: item.MinutesPlayed,
+		Goals:         item.Goals,
+		Assists:       item.Assists,
+		CleanSheet:    item.CleanSheet,
+		YellowCards:   item.YellowCards,
+		RedCards:      item.RedCards,
+		Saves:         item.Saves,
+		BonusPoints:   item.BonusPoints,
+		FantasyPoints: item.FantasyPoints,
+	}
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -214,6 +215,284 @@ DO UPDATE SET
 	return nil
 }
 
+func (r *StatValueRepository) ListTypes(ctx context.Context) ([]statvalue.Type, error) {
+	query, args, err := qb.Select(
+		"external_type_id",
+		"name",
+		"developer_name",
+		"code",
+		"model_type",
+		"stat_group",
+		"external_metadata::text AS external_metadata",
+	).From("stat_types").
+		Where(qb.IsNull("deleted_at")).
+		OrderBy("stat_group", "name", "external_type_id").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list stat types query: %w", err)
+	}
+
+	var rows []statTypeRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list stat types: %w", err)
+	}
+
+	out := make([]statvalue.Type, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, statvalue.Type{
+			ExternalTypeID: row.ExternalTypeID,
+			Name:           row.Name,
+			DeveloperName:  row.DeveloperName,
+			Code:           row.Code,
+			ModelType:      row.ModelType,
+			StatGroup:      row.StatGroup,
+			Metadata:       decodeJSONMap(row.Metadata),
+		})
+	}
+	return out, nil
+}
+
+func (r *StatValueRepository) ListPlayerValues(ctx context.Context, filter statvalue.PlayerValueFilter) ([]statvalue.PlayerValue, error) {
+	conditions := seasonStatValueConditions(filter.LeagueID, filter.SeasonRefID, filter.StatKeys, filter.Scope)
+	if len(filter.PlayerIDs) > 0 {
+		conditions = append(conditions, qb.In("player_public_id", stringSliceToAny(filter.PlayerIDs)))
+	}
+
+	query, args, err := qb.Select(playerStatValueSelectColumns...).From("player_stat_values").
+		Where(conditions...).
+		OrderBy("player_public_id", "stat_key", "scope", "season_ref_id DESC").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list player stat values query: %w", err)
+	}
+	return r.selectPlayerValues(ctx, query, args)
+}
+
+func (r *StatValueRepository) ListTeamValues(ctx context.Context, filter statvalue.TeamValueFilter) ([]statvalue.TeamValue, error) {
+	conditions := seasonStatValueConditions(filter.LeagueID, filter.SeasonRefID, filter.StatKeys, filter.Scope)
+	if len(filter.TeamIDs) > 0 {
+		conditions = append(conditions, qb.In("team_public_id", stringSliceToAny(filter.TeamIDs)))
+	}
+
+	query, args, err := qb.Select(teamStatValueSelectColumns...).From("team_stat_values").
+		Where(conditions...).
+		OrderBy("team_public_id", "stat_key", "scope", "season_ref_id DESC").
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build list team stat values query: %w", err)
+	}
+	return r.selectTeamValues(ctx, query, args)
+}
+
+func (r *StatValueRepository) RankPlayerValues(ctx context.Context, rank statvalue.RankQuery) ([]statvalue.PlayerValue, error) {
+	conditions := rankStatValueConditions(rank)
+	conditions = append(conditions, qb.Expr("player_public_id <> ''"))
+
+	query, args, err := qb.Select(playerStatValueSelectColumns...).From("player_stat_values").
+		Where(conditions...).
+		OrderBy(rankValueOrder(rank), "player_public_id").
+		Limit(rank.Limit).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build rank player stat values query: %w", err)
+	}
+	return r.selectPlayerValues(ctx, query, args)
+}
+
+func (r *StatValueRepository) RankTeamValues(ctx context.Context, rank statvalue.RankQuery) ([]statvalue.TeamValue, error) {
+	conditions := rankStatValueConditions(rank)
+	conditions = append(conditions, qb.Expr("team_public_id <> ''"))
+
+	query, args, err := qb.Select(teamStatValueSelectColumns...).From("team_stat_values").
+		Where(conditions...).
+		OrderBy(rankValueOrder(rank), "team_public_id").
+		Limit(rank.Limit).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("build rank team stat values query: %w", err)
+	}
+	return r.selectTeamValues(ctx, query, args)
+}
+
+func (r *StatValueRepository) selectPlayerValues(ctx context.Context, query string, args []any) ([]statvalue.PlayerValue, error) {
+	var rows []playerStatValueRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list player stat values: %w", err)
+	}
+
+	out := make([]statvalue.PlayerValue, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, statvalue.PlayerValue{
+			LeagueID:           row.LeagueID,
+			SeasonRefID:        row.SeasonRefID,
+			PlayerID:           row.PlayerID,
+			ExternalPlayerID:   row.ExternalPlayerID,
+			TeamID:             row.TeamID,
+			ExternalTeamID:     row.ExternalTeamID,
+			FixtureID:          row.FixtureID,
+			ExternalFixtureID:  row.ExternalFixtureID,
+			StatTypeExternalID: row.StatTypeExternalID,
+			StatKey:            row.StatKey,
+			Scope:              row.Scope,
+			ValueNum:           nullFloatToPtr(row.ValueNum),
+			ValueText:          row.ValueText,
+			ValueJSON:          decodeJSONMap(row.ValueJSON),
+			SourceUpdatedAt:    row.SourceUpdatedAt,
+			Metadata:           decodeJSONMap(row.Metadata),
+		})
+	}
+	return out, nil
+}
+
+func (r *StatValueRepository) selectTeamValues(ctx context.Context, query string, args []any) ([]statvalue.TeamValue, error) {
+	var rows []teamStatValueRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("list team stat values: %w", err)
+	}
+
+	out := make([]statvalue.TeamValue, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, statvalue.TeamValue{
+			LeagueID:           row.LeagueID,
+			SeasonRefID:        row.SeasonRefID,
+			TeamID:             row.TeamID,
+			ExternalTeamID:     row.ExternalTeamID,
+			FixtureID:          row.FixtureID,
+			ExternalFixtureID:  row.ExternalFixtureID,
+			StatTypeExternalID: row.StatTypeExternalID,
+			StatKey:            row.StatKey,
+			Scope:              row.Scope,
+			ValueNum:           nullFloatToPtr(row.ValueNum),
+			ValueText:          row.ValueText,
+			ValueJSON:          decodeJSONMap(row.ValueJSON),
+			SourceUpdatedAt:    row.SourceUpdatedAt,
+			Metadata:           decodeJSONMap(row.Metadata),
+		})
+	}
+	return out, nil
+}
+
+// seasonStatValueConditions matches season level rows, which carry no fixture.
+func seasonStatValueConditions(leagueID string, seasonRefID int64, statKeys []string, scope string) []qb.Condition {
+	conditions := []qb.Condition{
+		qb.Eq("league_public_id", strings.TrimSpace(leagueID)),
+		qb.Eq("fixture_public_id", ""),
+		qb.IsNull("deleted_at"),
+	}
+	if seasonRefID > 0 {
+		conditions = append(conditions, qb.Eq("season_ref_id", seasonRefID))
+	}
+	if len(statKeys) > 0 {
+		conditions = append(conditions, qb.In("stat_key", stringSliceToAny(statKeys)))
+	}
+	if scope = strings.TrimSpace(scope); scope != "" {
+		conditions = append(conditions, qb.Eq("scope", scope))
+	}
+	return conditions
+}
+
+func rankStatValueConditions(rank statvalue.RankQuery) []qb.Condition {
+	conditions := seasonStatValueConditions(rank.LeagueID, rank.SeasonRefID, []string{rank.StatKey}, rank.Scope)
+	return append(conditions, qb.Expr("value_num IS NOT NULL"))
+}
+
+func rankValueOrder(rank statvalue.RankQuery) string {
+	if rank.Ascending {
+		return "value_num ASC"
+	}
+	return "value_num DESC"
+}
+
+func nullFloatToPtr(value sql.NullFloat64) *float64 {
+	if !value.Valid {
+		return nil
+	}
+	v := value.Float64
+	return &v
+}
+
+var playerStatValueSelectColumns = []string{
+	"league_public_id",
+	"season_ref_id",
+	"player_public_id",
+	"external_player_id",
+	"team_public_id",
+	"external_team_id",
+	"fixture_public_id",
+	"external_fixture_id",
+	"stat_type_external_id",
+	"stat_key",
+	"scope",
+	"value_num::float8 AS value_num",
+	"value_text",
+	"value_json::text AS value_json",
+	"source_updated_at",
+	"external_metadata::text AS external_metadata",
+}
+
+var teamStatValueSelectColumns = []string{
+	"league_public_id",
+	"season_ref_id",
+	"team_public_id",
+	"external_team_id",
+	"fixture_public_id",
+	"external_fixture_id",
+	"stat_type_external_id",
+	"stat_key",
+	"scope",
+	"value_num::float8 AS value_num",
+	"value_text",
+	"value_json::text AS value_json",
+	"source_updated_at",
+	"external_metadata::text AS external_metadata",
+}
+
+type statTypeRow struct {
+	ExternalTypeID int64  `db:"external_type_id"`
+	Name           string `db:"name"`
+	DeveloperName  string `db:"developer_name"`
+	Code           string `db:"code"`
+	ModelType      string `db:"model_type"`
+	StatGroup      string `db:"stat_group"`
+	Metadata       string `db:"external_metadata"`
+}
+
+type playerStatValueRow struct {
+	LeagueID           string          `db:"league_public_id"`
+	SeasonRefID        int64           `db:"season_ref_id"`
+	PlayerID           string          `db:"player_public_id"`
+	ExternalPlayerID   int64           `db:"external_player_id"`
+	TeamID             string          `db:"team_public_id"`
+	ExternalTeamID     int64           `db:"external_team_id"`
+	FixtureID          string          `db:"fixture_public_id"`
+	ExternalFixtureID  int64           `db:"external_fixture_id"`
+	StatTypeExternalID int64           `db:"stat_type_external_id"`
+	StatKey            string          `db:"stat_key"`
+	Scope              string          `db:"scope"`
+	ValueNum           sql.NullFloat64 `db:"value_num"`
+	ValueText          string          `db:"value_text"`
+	ValueJSON          string          `db:"value_json"`
+	SourceUpdatedAt    *time.Time      `db:"source_updated_at"`
+	Metadata           string          `db:"external_metadata"`
+}
+
+type teamStatValueRow struct {
+	LeagueID           string          `db:"league_public_id"`
+	SeasonRefID        int64           `db:"season_ref_id"`
+	TeamID             string          `db:"team_public_id"`
+	ExternalTeamID     int64           `db:"external_team_id"`
+	FixtureID          string          `db:"fixture_public_id"`
+	ExternalFixtureID  int64           `db:"external_fixture_id"`
+	StatTypeExternalID int64           `db:"stat_type_external_id"`
+	StatKey            string          `db:"stat_key"`
+	Scope              string          `db:"scope"`
+	ValueNum           sql.NullFloat64 `db:"value_num"`
+	ValueText          string          `db:"value_text"`
+	ValueJSON          string          `db:"value_json"`
+	SourceUpdatedAt    *time.Time      `db:"source_updated_at"`
+	Metadata           string          `db:"external_metadata"`
+}
+
 type statTypeInsertModel struct {
 	ExternalTypeID int64  `db:"external_type_id"`
 	Name           string `db:"name"`
@@ -0,0 +1,17 @@
+package postgres
+
+type schemaMigrationRow struct {
+	Version int64 `db:"version"`
+	Dirty   bool  `db:"dirty"`
+}
+
+type leagueCountRow struct {
+	LeagueID string `db:"league_public_id"`
+	Count    int    `db:"total"`
+}
+
+type gameweekCountRow struct {
+	LeagueID string `db:"league_public_id"`
+	Gameweek int    `db:"gameweek"`
+	Count    int    `db:"total"`
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/riskibarqy/fantasy-league/internal/domain/systemstatus"
+	qb "github.com/riskibarqy/fantasy-league/internal/platform/querybuilder"
+)
+
+type SystemStatusRepository struct {
+	db *sqlx.DB
+}
+
+func NewSystemStatusRepository(db *sqlx.DB) *SystemStatusRepository {
+	return &SystemStatusRepository{db: db}
+}
+
+func (r *SystemStatusRepository) DatabaseStatus(ctx context.Context) (systemstatus.Database, error) {
+	startedAt := time.Now()
+	if err := r.db.PingContext(ctx); err != nil {
+		return systemstatus.Database{}, fmt.Errorf("ping database: %w", err)
+	}
+	stats := r.db.Stats()
+	out := systemstatus.Database{
+		PingLatency:     time.Since(startedAt),
+		OpenConnections: stats.OpenConnections,
+		InUse:           stats.InUse,
+		Idle:            stats.Idle,
+		MaxOpen:         stats.MaxOpenConnections,
+		WaitCount:       stats.WaitCount,
+		WaitDuration:    stats.WaitDuration,
+	}
+
+	query, args, err := qb.Select("version", "dirty").From("schema_migrations").Limit(1).ToSQL()
+	if err != nil {
+		return systemstatus.Database{}, fmt.Errorf("build migration version query: %w", err)
+	}
+	var rows []schemaMigrationRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return systemstatus.Database{}, fmt.Errorf("read migration version: %w", err)
+	}
+	if len(rows) > 0 {
+		out.MigrationVersion = rows[0].Version
+		out.MigrationDirty = rows[0].Dirty
+	}
+	return out, nil
+}
+
+func (r *SystemStatusRepository) CountActivity(ctx context.Context) (systemstatus.Activity, error) {
+	squadQuery, squadArgs, err := qb.Select("league_public_id", "COUNT(1) AS total").
+		From("fantasy_squads").
+		Where(qb.IsNull("deleted_at")).
+		GroupBy("league_public_id").
+		ToSQL()
+	if err != nil {
+		return systemstatus.Activity{}, fmt.Errorf("build squad count query: %w", err)
+	}
+	var squadRows []leagueCountRow
+	if err := r.db.SelectContext(ctx, &squadRows, squadQuery, squadArgs...); err != nil {
+		return systemstatus.Activity{}, fmt.Errorf("count squads: %w", err)
+	}
+
+	lineupQuery, lineupArgs, err := qb.Select("league_public_id", "gameweek", "COUNT(1) AS total").
+		From("lineup_snapshots").
+		Where(qb.IsNull("deleted_at")).
+		GroupBy("league_public_id", "gameweek").
+		OrderBy("league_public_id", "gameweek").
+		ToSQL()
+	if err != nil {
+		return systemstatus.Activity{}, fmt.Errorf("build lineup count query: %w", err)
+	}
+	var lineupRows []gameweekCountRow
+	if err := r.db.SelectContext(ctx, &lineupRows, lineupQuery, lineupArgs...); err != nil {
+		return systemstatus.Activity{}, fmt.Errorf("count lineups: %w", err)
+	}
+
+	out := systemstatus.Activity{
+		SquadsByLeague: make(map[string]int, len(squadRows)),
+		Lineups:        make([]systemstatus.GameweekLineups, 0, len(lineupRows)),
+	}
+	for _, row := range squadRows {
+		out.SquadsByLeague[row.LeagueID] = row.Count
+	}
+	for _, row := range lineupRows {
+		out.Lineups = append(out.Lineups, systemstatus.GameweekLineups{
+			LeagueID: row.LeagueID,
+			Gameweek: row.Gameweek,
+			Count:    row.Count,
+		})
+	}
+	return out, nil
+}
@@ -17,6 +17,7 @@ type teamTableModel struct {
 	SecondaryColor   sql.NullString `db:"secondary_color"`
 	ExternalSource   string         `db:"external_source"`
 	ExternalMetadata []byte         `db:"external_metadata"`
+	IsHidden         bool           `db:"is_hidden"`
 	CreatedAt        time.Time      `db:"created_at"`
 	UpdatedAt        time.Time      `db:"updated_at"`
 	DeletedAt        *time.Time     `db:"deleted_at"`
@@ -31,4 +32,6 @@ type teamInsertModel struct {
 	ImageURL       string  `db:"image_url"`
 	PrimaryColor   *string `db:"primary_color"`
 	SecondaryColor *string `db:"secondary_color"`
+	ExternalSource string  `db:"external_source"`
+	IsHidden       bool    `db:"is_hidden"`
 }
@@ -76,6 +76,8 @@ func mapTeamRow(row teamTableModel) team.Team {
 		PrimaryColor:   nullStringToString(row.PrimaryColor),
 		SecondaryColor: nullStringToString(row.SecondaryColor),
 		TeamRefID:      nullInt64ToInt64(row.TeamRefID),
+		RefSource:      row.ExternalSource,
+		Hidden:         row.IsHidden,
 	}
 }
 
@@ -102,6 +104,8 @@ func (r *TeamRepository) UpsertTeams(ctx context.Context, items []team.Team) err
 			ImageURL:       strings.TrimSpace(item.ImageURL),
 			PrimaryColor:   nullableString(strings.TrimSpace(item.PrimaryColor)),
 			SecondaryColor: nullableString(strings.TrimSpace(item.SecondaryColor)),
+			ExternalSource: externalSourceOrDefault(item.RefSource),
+			IsHidden:       item.Hidden,
 		}
 		query, args, err := qb.InsertModel("teams", insertModel, `ON CONFLICT (public_id)
 DO UPDATE SET
@@ -109,9 +113,11 @@ DO UPDATE SET
     name = EXCLUDED.name,
     short = EXCLUDED.short,
     external_team_id = EXCLUDED.external_team_id,
+    external_source = EXCLUDED.external_source,
     image_url = EXCLUDED.image_url,
     primary_color = EXCLUDED.primary_color,
     secondary_color = EXCLUDED.secondary_color,
+    is_hidden = EXCLUDED.is_hidden,
     deleted_at = NULL`)
 		if err != nil {
 			return fmt.Errorf("build upsert team query: %w", err)
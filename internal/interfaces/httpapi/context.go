@@ -6,15 +6,10 @@ import (
 	"github.com/riskibarqy/fantasy-league/internal/domain/user"
 )
 
-type contextKey string
-
-const principalContextKey contextKey = "auth_principal"
-
 func withPrincipal(ctx context.Context, p user.Principal) context.Context {
-	return context.WithValue(ctx, principalContextKey, p)
+	return user.WithPrincipal(ctx, p)
 }
 
 func principalFromContext(ctx context.Context) (user.Principal, bool) {
-	p, ok := ctx.Value(principalContextKey).(user.Principal)
-	return p, ok
+	return user.PrincipalFromContext(ctx)
 }
@@ -0,0 +1,133 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/account"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+const anubisEventAccountDeleted = "account.deleted"
+
+func (h *Handler) ExportMyData(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ExportMyData")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.accountService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: account service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	archive, err := h.accountService.Export(ctx, principal.UserID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "export user data failed", "user_id", principal.UserID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", `attachment; filename="fantasy-league-export.json"`)
+	writeSuccess(ctx, w, http.StatusOK, accountArchiveDTO{
+		UserID:         archive.UserID,
+		GeneratedAtUTC: archive.GeneratedAt.UTC().Format(time.RFC3339),
+		Tables:         archive.Tables,
+	})
+}
+
+func (h *Handler) DeleteMyAccount(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.DeleteMyAccount")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.accountService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: account service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	result, err := h.accountService.Delete(ctx, principal.UserID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "delete account failed", "user_id", principal.UserID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	h.logger.InfoContext(ctx, "account deleted", "user_id", principal.UserID, "source", "user")
+	writeSuccess(ctx, w, http.StatusOK, accountDeletionToDTO(ctx, result))
+}
+
+// HandleAnubisWebhook runs behind RequireWebhookSignature. Events other than
+// account deletion are acknowledged and ignored.
+func (h *Handler) HandleAnubisWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.HandleAnubisWebhook")
+	defer span.End()
+
+	if h.accountService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: account service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	var req anubisWebhookRequest
+	if err := sonic.ConfigDefault.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	if strings.TrimSpace(req.Event) != anubisEventAccountDeleted {
+		writeSuccess(ctx, w, http.StatusAccepted, anubisWebhookDTO{Event: req.Event, Handled: false})
+		return
+	}
+
+	result, err := h.accountService.Delete(ctx, req.UserID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "anubis account deletion failed", "user_id", req.UserID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	h.logger.InfoContext(ctx, "account deleted", "user_id", req.UserID, "source", "anubis_webhook")
+	dto := accountDeletionToDTO(ctx, result)
+	writeSuccess(ctx, w, http.StatusOK, anubisWebhookDTO{Event: req.Event, Handled: true, Deletion: &dto})
+}
+
+func accountDeletionToDTO(ctx context.Context, item account.DeletionResult) accountDeletionDTO {
+	_, span := startSpan(ctx, "httpapi.accountDeletionToDTO")
+	defer span.End()
+
+	dto := accountDeletionDTO{
+		UserID:       item.UserID,
+		RowsByTable:  item.RowsByTable,
+		NewOwners:    item.NewOwners,
+		ClosedGroups: item.ClosedGroups,
+		LeagueIDs:    item.LeagueIDs,
+	}
+	if dto.RowsByTable == nil {
+		dto.RowsByTable = map[string]int64{}
+	}
+	if dto.NewOwners == nil {
+		dto.NewOwners = map[string]string{}
+	}
+	if dto.ClosedGroups == nil {
+		dto.ClosedGroups = []string{}
+	}
+	if dto.LeagueIDs == nil {
+		dto.LeagueIDs = []string{}
+	}
+	return dto
+}
@@ -0,0 +1,281 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/masterdata"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+func (h *Handler) AdminCreateLeague(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.AdminCreateLeague")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.masterDataService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: master data service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	var req adminCreateLeagueRequest
+	decoder := sonic.ConfigDefault.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	item, err := h.masterDataService.CreateLeague(ctx, usecase.CreateLeagueInput{
+		ActorUserID: principal.UserID,
+		ID:          req.ID,
+		Name:        req.Name,
+		CountryCode: req.CountryCode,
+		Season:      req.Season,
+		IsDefault:   req.IsDefault,
+		LeagueRefID: req.LeagueRefID,
+		SeasonRefID: req.SeasonRefID,
+	})
+	if err != nil {
+		h.logger.WarnContext(ctx, "admin create league failed", "user_id", principal.UserID, "league_id", req.ID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeSuccess(ctx, w, http.StatusCreated, adminLeagueToDTO(ctx, item))
+}
+
+func (h *Handler) AdminUpdateLeague(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.AdminUpdateLeague")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.masterDataService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: master data service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	var req adminUpdateLeagueRequest
+	decoder := sonic.ConfigDefault.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	leagueID := r.PathValue("leagueID")
+	item, err := h.masterDataService.UpdateLeague(ctx, usecase.UpdateLeagueInput{
+		ActorUserID: principal.UserID,
+		LeagueID:    leagueID,
+		Name:        req.Name,
+		CountryCode: req.CountryCode,
+		Season:      req.Season,
+		IsDefault:   req.IsDefault,
+		LeagueRefID: req.LeagueRefID,
+		SeasonRefID: req.SeasonRefID,
+	})
+	if err != nil {
+		h.logger.WarnContext(ctx, "admin update league failed", "user_id", principal.UserID, "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, adminLeagueToDTO(ctx, item))
+}
+
+func (h *Handler) AdminListOverrides(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.AdminListOverrides")
+	defer span.End()
+
+	if h.masterDataService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: master data service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := r.PathValue("leagueID")
+	overrides, err := h.masterDataService.ListOverrides(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "admin list overrides failed", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	out := masterDataOverridesDTO{
+		Teams:   make([]teamOverrideDTO, 0, len(overrides.Teams)),
+		Players: make([]playerOverrideDTO, 0, len(overrides.Players)),
+	}
+	for _, item := range overrides.Teams {
+		out.Teams = append(out.Teams, teamOverrideToDTO(ctx, item))
+	}
+	for _, item := range overrides.Players {
+		out.Players = append(out.Players, playerOverrideToDTO(ctx, item))
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+func (h *Handler) AdminSetTeamOverride(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.AdminSetTeamOverride")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.masterDataService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: master data service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	var req teamOverrideRequest
+	decoder := sonic.ConfigDefault.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	leagueID := r.PathValue("leagueID")
+	teamID := r.PathValue("teamID")
+	item, err := h.masterDataService.SetTeamOverride(ctx, usecase.SetTeamOverrideInput{
+		ActorUserID: principal.UserID,
+		LeagueID:    leagueID,
+		TeamID:      teamID,
+		Hidden:      req.Hidden,
+		Note:        req.Note,
+	})
+	if err != nil {
+		h.logger.WarnContext(ctx, "admin set team override failed", "user_id", principal.UserID, "league_id", leagueID, "team_id", teamID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, teamOverrideToDTO(ctx, item))
+}
+
+func (h *Handler) AdminSetPlayerOverride(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.AdminSetPlayerOverride")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.masterDataService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: master data service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	var req playerOverrideRequest
+	decoder := sonic.ConfigDefault.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	leagueID := r.PathValue("leagueID")
+	playerID := r.PathValue("playerID")
+	item, err := h.masterDataService.SetPlayerOverride(ctx, usecase.SetPlayerOverrideInput{
+		ActorUserID: principal.UserID,
+		LeagueID:    leagueID,
+		PlayerID:    playerID,
+		Position:    req.Position,
+		Price:       req.Price,
+		Available:   req.Available,
+		Hidden:      req.Hidden,
+		Note:        req.Note,
+	})
+	if err != nil {
+		h.logger.WarnContext(ctx, "admin set player override failed", "user_id", principal.UserID, "league_id", leagueID, "player_id", playerID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, playerOverrideToDTO(ctx, item))
+}
+
+func adminLeagueToDTO(ctx context.Context, item league.League) adminLeagueDTO {
+	_, span := startSpan(ctx, "httpapi.adminLeagueToDTO")
+	defer span.End()
+
+	return adminLeagueDTO{
+		ID:          item.ID,
+		Name:        item.Name,
+		CountryCode: item.CountryCode,
+		Season:      item.Season,
+		IsDefault:   item.IsDefault,
+		LeagueRefID: item.LeagueRefID,
+		SeasonRefID: item.SeasonRefID,
+		IsArchived:  item.IsArchived(),
+	}
+}
+
+func teamOverrideToDTO(ctx context.Context, item masterdata.TeamOverride) teamOverrideDTO {
+	_, span := startSpan(ctx, "httpapi.teamOverrideToDTO")
+	defer span.End()
+
+	dto := teamOverrideDTO{
+		LeagueID:  item.LeagueID,
+		TeamID:    item.TeamID,
+		Hidden:    item.Hidden,
+		Note:      item.Note,
+		UpdatedBy: item.UpdatedBy,
+	}
+	if !item.UpdatedAt.IsZero() {
+		dto.UpdatedAtUTC = item.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return dto
+}
+
+func playerOverrideToDTO(ctx context.Context, item masterdata.PlayerOverride) playerOverrideDTO {
+	_, span := startSpan(ctx, "httpapi.playerOverrideToDTO")
+	defer span.End()
+
+	dto := playerOverrideDTO{
+		LeagueID:  item.LeagueID,
+		PlayerID:  item.PlayerID,
+		Price:     item.Price,
+		Available: item.Available,
+		Hidden:    item.Hidden,
+		Note:      item.Note,
+		UpdatedBy: item.UpdatedBy,
+	}
+	if item.Position != nil {
+		position := string(*item.Position)
+		dto.Position = &position
+	}
+	if !item.UpdatedAt.IsZero() {
+		dto.UpdatedAtUTC = item.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return dto
+}
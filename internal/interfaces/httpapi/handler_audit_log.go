@@ -0,0 +1,81 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+func (h *Handler) ListAuditLogs(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListAuditLogs")
+	defer span.End()
+
+	if h.auditLogService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: audit log service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	query := r.URL.Query()
+	input := usecase.ListAuditLogInput{
+		UserID:     query.Get("user_id"),
+		EntityType: query.Get("entity_type"),
+		EntityID:   query.Get("entity_id"),
+	}
+	var err error
+	if input.From, err = parseRFC3339Query(r, "from"); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if input.To, err = parseRFC3339Query(r, "to"); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if raw := strings.TrimSpace(query.Get("limit")); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			writeError(ctx, w, fmt.Errorf("%w: limit must be positive integer", usecase.ErrInvalidInput))
+			return
+		}
+		input.Limit = v
+	}
+
+	items, err := h.auditLogService.List(ctx, input)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list audit logs failed", "user_id", input.UserID, "entity_type", input.EntityType, "entity_id", input.EntityID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	out := make([]auditLogEntryDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, auditLogEntryDTO{
+			ID:           item.ID,
+			ActorUserID:  item.ActorUserID,
+			Action:       item.Action,
+			EntityType:   item.EntityType,
+			EntityID:     item.EntityID,
+			LeagueID:     item.LeagueID,
+			TraceID:      item.TraceID,
+			Before:       item.Before,
+			After:        item.After,
+			CreatedAtUTC: item.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+func parseRFC3339Query(r *http.Request, name string) (time.Time, error) {
+	raw := strings.TrimSpace(r.URL.Query().Get(name))
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	v, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s must be an RFC3339 timestamp", usecase.ErrInvalidInput, name)
+	}
+	return v, nil
+}
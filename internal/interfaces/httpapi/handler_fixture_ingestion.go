@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +22,12 @@ func (h *Handler) ListFixturesByLeague(w http.ResponseWriter, r *http.Request) {
 	defer span.End()
 
 	leagueID := r.PathValue("leagueID")
+	include, err := parseIncludes(r, usecase.IncludeTeam)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
 	fixtures, err := h.fixtureService.ListByLeague(ctx, leagueID)
 	if err != nil {
 		h.logger.WarnContext(ctx, "list fixtures failed", "league_id", leagueID, "error", err)
@@ -28,26 +35,99 @@ func (h *Handler) ListFixturesByLeague(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	teams, err := h.leagueService.ListTeamsByLeague(ctx, leagueID)
+	enrichment, err := h.enrichmentService.Load(ctx, leagueID, usecase.IncludeTeam)
 	if err != nil {
 		h.logger.WarnContext(ctx, "list teams failed while mapping fixtures", "league_id", leagueID, "error", err)
 		writeError(ctx, w, err)
 		return
 	}
 
-	teamLogoByID := make(map[string]string, len(teams))
-	for _, t := range teams {
+	teamLogoByID := make(map[string]string, len(enrichment.Teams))
+	for _, t := range enrichment.Teams {
 		teamLogoByID[t.ID] = teamLogoWithFallback(ctx, t.Name, t.ImageURL)
 	}
 
 	items := make([]fixtureDTO, 0, len(fixtures))
 	for _, f := range fixtures {
-		items = append(items, fixtureToDTO(ctx, f, teamLogoByID))
+		item := fixtureToDTO(ctx, f, teamLogoByID)
+		if include.has(usecase.IncludeTeam) {
+			item.Team = fixtureTeamsToDTO(ctx, f, enrichment.TeamsByID)
+		}
+		items = append(items, item)
 	}
 
 	writeSuccess(ctx, w, http.StatusOK, items)
 }
 
+// GetFixtureTicker lists each team's upcoming fixtures with their difficulty
+// over the next gameweeks, five by default.
+func (h *Handler) GetFixtureTicker(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetFixtureTicker")
+	defer span.End()
+
+	if h.difficultyService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: fixture difficulty service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := strings.TrimSpace(r.PathValue("leagueID"))
+	gameweeks := 0
+	if raw := strings.TrimSpace(r.URL.Query().Get("gameweeks")); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			writeError(ctx, w, fmt.Errorf("%w: gameweeks must be positive integer", usecase.ErrInvalidInput))
+			return
+		}
+		gameweeks = v
+	}
+
+	ticker, err := h.difficultyService.GetTicker(ctx, leagueID, gameweeks)
+	if err != nil {
+		h.logger.WarnContext(ctx, "get fixture ticker failed", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	teams, err := h.leagueService.ListTeamsByLeague(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list teams failed while mapping fixture ticker", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	teamNameByID := make(map[string]string, len(teams))
+	for _, t := range teams {
+		teamNameByID[t.ID] = t.Name
+	}
+
+	out := fixtureTickerDTO{
+		LeagueID:     ticker.LeagueID,
+		FromGameweek: ticker.FromGameweek,
+		ToGameweek:   ticker.ToGameweek,
+		Teams:        make([]teamFixtureTickerDTO, 0, len(ticker.Teams)),
+	}
+	for _, team := range ticker.Teams {
+		item := teamFixtureTickerDTO{
+			TeamID:   team.TeamID,
+			TeamName: teamNameByID[team.TeamID],
+			Fixtures: make([]tickerFixtureDTO, 0, len(team.Fixtures)),
+		}
+		for _, f := range team.Fixtures {
+			item.Fixtures = append(item.Fixtures, tickerFixtureDTO{
+				FixtureID:    f.FixtureID,
+				Gameweek:     f.Gameweek,
+				KickoffAt:    f.KickoffAt.UTC().Format(time.RFC3339),
+				OpponentID:   f.OpponentTeamID,
+				OpponentName: teamNameByID[f.OpponentTeamID],
+				IsHome:       f.IsHome,
+				Difficulty:   f.Difficulty,
+			})
+		}
+		out.Teams = append(out.Teams, item)
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
 func (h *Handler) GetFixtureDetailsByLeague(w http.ResponseWriter, r *http.Request) {
 	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetFixtureDetailsByLeague")
 	defer span.End()
@@ -258,6 +338,7 @@ func (h *Handler) IngestPlayerFixtureStats(w http.ResponseWriter, r *http.Reques
 			YellowCards:       item.YellowCards,
 			RedCards:          item.RedCards,
 			Saves:             item.Saves,
+			BonusPoints:       item.BonusPoints,
 			FantasyPoints:     item.FantasyPoints,
 			AdvancedStats:     item.AdvancedStats,
 		})
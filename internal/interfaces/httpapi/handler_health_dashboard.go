@@ -1,8 +1,10 @@
 package httpapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/riskibarqy/fantasy-league/internal/usecase"
 )
@@ -14,6 +16,129 @@ func (h *Handler) Healthz(w http.ResponseWriter, r *http.Request) {
 	writeSuccess(ctx, w, http.StatusOK, map[string]string{"status": "ok"})
 }
 
+// Readyz reports whether the instance can serve traffic. It answers 503 with
+// the failing dependencies when a critical one is down.
+func (h *Handler) Readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.Readyz")
+	defer span.End()
+
+	if h.systemStatusService == nil {
+		writeSuccess(ctx, w, http.StatusOK, map[string]string{"status": "ok"})
+		return
+	}
+
+	report := h.systemStatusService.Readiness(ctx)
+	dto := readinessToDTO(report)
+	if !report.Ready {
+		h.logger.WarnContext(ctx, "readiness check failed", "dependencies", dto.Dependencies)
+		writeNotReady(ctx, w, dto)
+		return
+	}
+	writeSuccess(ctx, w, http.StatusOK, dto)
+}
+
+// GetSystemStatus reports every dependency, the last successful syncs per
+// league and the cache size for operators.
+func (h *Handler) GetSystemStatus(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetSystemStatus")
+	defer span.End()
+
+	if h.systemStatusService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: system status service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	status := h.systemStatusService.Status(ctx)
+	dto := systemStatusDTO{
+		readinessDTO: readinessToDTO(status.ReadinessReport),
+		Circuits:     make([]circuitStatusDTO, 0, len(status.Circuits)),
+		LastSyncs:    make([]lastSyncStatusDTO, 0, len(status.LastSyncs)),
+		Cache:        cacheStatusDTO{Enabled: status.CacheEnabled, Entries: status.CacheEntries},
+	}
+	if db := status.Database; db != nil {
+		dto.Database = &databaseStatusDTO{
+			PingMS:           durationMS(db.PingLatency),
+			OpenConnections:  db.OpenConnections,
+			InUse:            db.InUse,
+			Idle:             db.Idle,
+			MaxOpen:          db.MaxOpen,
+			WaitCount:        db.WaitCount,
+			WaitMS:           durationMS(db.WaitDuration),
+			MigrationVersion: db.MigrationVersion,
+			MigrationDirty:   db.MigrationDirty,
+		}
+	}
+	for _, item := range status.Circuits {
+		dto.Circuits = append(dto.Circuits, circuitStatusDTO{Name: item.Name, State: string(item.State)})
+	}
+	for _, item := range status.LastSyncs {
+		dto.LastSyncs = append(dto.LastSyncs, lastSyncStatusDTO{
+			LeagueID:       item.LeagueID,
+			Job:            item.JobName,
+			CompletedAtUTC: item.CompletedAt.UTC().Format(time.RFC3339),
+			AgeSeconds:     int64(status.CheckedAt.Sub(item.CompletedAt).Seconds()),
+		})
+	}
+
+	code := http.StatusOK
+	if !status.Ready {
+		code = http.StatusServiceUnavailable
+	}
+	writeSuccess(ctx, w, code, dto)
+}
+
+func readinessToDTO(report usecase.ReadinessReport) readinessDTO {
+	out := readinessDTO{
+		Status:       "ok",
+		CheckedAtUTC: report.CheckedAt.UTC().Format(time.RFC3339),
+		Dependencies: make([]dependencyStatusDTO, 0, len(report.Dependencies)),
+	}
+	for _, item := range report.Dependencies {
+		if item.Status != usecase.DependencyOK && out.Status == "ok" {
+			out.Status = usecase.DependencyDegraded
+		}
+		out.Dependencies = append(out.Dependencies, dependencyStatusDTO{
+			Name:     item.Name,
+			Status:   item.Status,
+			Critical: item.Critical,
+			Detail:   item.Detail,
+		})
+	}
+	if !report.Ready {
+		out.Status = "not_ready"
+	}
+	return out
+}
+
+// writeNotReady answers 503 with the readiness report next to the error so
+// probes and operators see which dependency failed.
+func writeNotReady(ctx context.Context, w http.ResponseWriter, data readinessDTO) {
+	ctx, span := startSpan(ctx, "httpapi.writeNotReady")
+	defer span.End()
+
+	const msg = "service is not ready"
+	writeJSON(ctx, w, http.StatusServiceUnavailable, googleResponseEnvelope{
+		APIVersion: googleAPIVersion,
+		Data:       data,
+		Error: &googleErrorBody{
+			Code:    http.StatusServiceUnavailable,
+			Message: msg,
+			Status:  "UNAVAILABLE",
+			Errors: []googleErrorItem{
+				{
+					Domain:  errorDomain,
+					Reason:  "dependencyUnavailable",
+					Message: msg,
+				},
+			},
+		},
+	})
+}
+
+func durationMS(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
 func (h *Handler) GetDashboard(w http.ResponseWriter, r *http.Request) {
 	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetDashboard")
 	defer span.End()
@@ -366,6 +366,226 @@ func (h *Handler) RunBootstrapJob(w http.ResponseWriter, r *http.Request) {
 	writeSuccess(ctx, w, http.StatusOK, result)
 }
 
+// RunSeasonRollover archives the current season of a league, opens the next
+// one with carried-over custom leagues, and bootstraps its sync jobs.
+func (h *Handler) RunSeasonRollover(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.RunSeasonRollover")
+	defer span.End()
+
+	if h.seasonRolloverService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: season rollover service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	decoder := sonic.ConfigDefault.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	var req seasonRolloverRequest
+	if err := decoder.Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			writeError(ctx, w, fmt.Errorf("%w: request body is required", usecase.ErrInvalidInput))
+			return
+		}
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	dispatchReq := internalJobSyncRequest{
+		LeagueID:   req.LeagueID,
+		Force:      req.Force,
+		DispatchID: req.DispatchID,
+	}
+	payload := buildInternalJobPayload(dispatchReq)
+	payload["season"] = req.Season
+	payload["season_id"] = req.SeasonID
+	if req.NewLeagueID != "" {
+		payload["new_league_id"] = req.NewLeagueID
+	}
+
+	result, err := h.seasonRolloverService.Rollover(ctx, usecase.SeasonRolloverInput{
+		LeagueID:    req.LeagueID,
+		NewLeagueID: req.NewLeagueID,
+		Season:      req.Season,
+		SeasonRefID: req.SeasonID,
+		Name:        req.Name,
+		Force:       req.Force,
+	})
+	if err != nil {
+		h.recordInternalJobDispatch(ctx, dispatchReq, jobscheduler.DispatchEvent{
+			JobName:      "season-rollover",
+			JobPath:      "/v1/internal/jobs/season-rollover",
+			LeagueID:     req.LeagueID,
+			Status:       jobscheduler.StatusFailed,
+			Payload:      payload,
+			ErrorMessage: err.Error(),
+			OccurredAt:   time.Now().UTC(),
+		})
+		h.logger.WarnContext(ctx, "run season rollover failed", "league_id", req.LeagueID, "season", req.Season, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	h.recordInternalJobDispatch(ctx, dispatchReq, jobscheduler.DispatchEvent{
+		JobName:    "season-rollover",
+		JobPath:    "/v1/internal/jobs/season-rollover",
+		LeagueID:   req.LeagueID,
+		Status:     jobscheduler.StatusCompleted,
+		Payload:    payload,
+		OccurredAt: time.Now().UTC(),
+	})
+
+	out := seasonRolloverToDTO(result)
+	if h.jobOrchestrator != nil {
+		bootstrap, err := h.jobOrchestrator.Bootstrap(ctx, usecase.JobSyncInput{LeagueID: result.League.ID})
+		if err != nil {
+			h.logger.WarnContext(ctx, "bootstrap jobs for new season failed", "league_id", result.League.ID, "error", err)
+		} else {
+			out.Bootstrap = &bootstrap
+		}
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+func seasonRolloverToDTO(v usecase.SeasonRolloverResult) seasonRolloverDTO {
+	out := seasonRolloverDTO{
+		RolloverID:           v.Rollover.ID,
+		FromLeagueID:         v.Rollover.FromLeagueID,
+		ToLeagueID:           v.Rollover.ToLeagueID,
+		FromSeason:           v.Rollover.FromSeason,
+		ToSeason:             v.Rollover.ToSeason,
+		SeasonID:             v.Rollover.SeasonRefID,
+		ArchivedSquads:       v.Rollover.ArchivedSquads,
+		ArchivedLineups:      v.Rollover.ArchivedLineups,
+		ArchivedPoints:       v.Rollover.ArchivedPoints,
+		ArchivedStandings:    v.Rollover.ArchivedStandings,
+		CarriedCustomLeagues: v.Rollover.CarriedCustomLeagues,
+		SummarizedUsers:      v.SummarizedUsers,
+		MasterDataSynced:     v.MasterDataSynced,
+		SyncError:            v.SyncError,
+		CreatedAt:            v.Rollover.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if v.MasterData.TaskCount > 0 {
+		masterData := v.MasterData
+		out.MasterData = &masterData
+	}
+	return out
+}
+
+// RunSeasonSummaryJob recomputes the stored season summaries of a league,
+// e.g. to backfill history for a season archived before summaries existed.
+func (h *Handler) RunSeasonSummaryJob(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.RunSeasonSummaryJob")
+	defer span.End()
+
+	if h.seasonHistoryService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: season history service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	req, err := decodeInternalJobSyncRequest(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	count, err := h.seasonHistoryService.SummarizeSeason(ctx, req.LeagueID)
+	if err != nil {
+		h.recordInternalJobDispatch(ctx, req, jobscheduler.DispatchEvent{
+			JobName:      "season-summary",
+			JobPath:      "/v1/internal/jobs/season-summary",
+			LeagueID:     req.LeagueID,
+			Status:       jobscheduler.StatusFailed,
+			Payload:      buildInternalJobPayload(req),
+			ErrorMessage: err.Error(),
+			OccurredAt:   time.Now().UTC(),
+		})
+		h.logger.WarnContext(ctx, "run season summary job failed", "league_id", req.LeagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	h.recordInternalJobDispatch(ctx, req, jobscheduler.DispatchEvent{
+		JobName:    "season-summary",
+		JobPath:    "/v1/internal/jobs/season-summary",
+		LeagueID:   req.LeagueID,
+		Status:     jobscheduler.StatusCompleted,
+		Payload:    buildInternalJobPayload(req),
+		OccurredAt: time.Now().UTC(),
+	})
+
+	writeSuccess(ctx, w, http.StatusOK, seasonSummaryJobDTO{
+		LeagueID:        req.LeagueID,
+		SummarizedUsers: count,
+	})
+}
+
+// RunOwnershipJob recomputes player ownership of a locked gameweek. Ownership
+// is aggregated when a gameweek locks; this backfills or repairs it.
+func (h *Handler) RunOwnershipJob(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.RunOwnershipJob")
+	defer span.End()
+
+	if h.ownershipService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: ownership service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	decoder := sonic.ConfigDefault.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	var req ownershipJobRequest
+	if err := decoder.Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			writeError(ctx, w, fmt.Errorf("%w: request body is required", usecase.ErrInvalidInput))
+			return
+		}
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	dispatchReq := internalJobSyncRequest{
+		LeagueID:   req.LeagueID,
+		DispatchID: req.DispatchID,
+	}
+	payload := buildInternalJobPayload(dispatchReq)
+	payload["gameweek"] = req.Gameweek
+
+	count, err := h.ownershipService.AggregateGameweek(ctx, req.LeagueID, req.Gameweek)
+	if err != nil {
+		h.recordInternalJobDispatch(ctx, dispatchReq, jobscheduler.DispatchEvent{
+			JobName:      "ownership",
+			JobPath:      "/v1/internal/jobs/ownership",
+			LeagueID:     req.LeagueID,
+			Status:       jobscheduler.StatusFailed,
+			Payload:      payload,
+			ErrorMessage: err.Error(),
+			OccurredAt:   time.Now().UTC(),
+		})
+		h.logger.WarnContext(ctx, "run ownership job failed", "league_id", req.LeagueID, "gameweek", req.Gameweek, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	h.recordInternalJobDispatch(ctx, dispatchReq, jobscheduler.DispatchEvent{
+		JobName:    "ownership",
+		JobPath:    "/v1/internal/jobs/ownership",
+		LeagueID:   req.LeagueID,
+		Status:     jobscheduler.StatusCompleted,
+		Payload:    payload,
+		OccurredAt: time.Now().UTC(),
+	})
+
+	writeSuccess(ctx, w, http.StatusOK, ownershipJobDTO{
+		LeagueID: req.LeagueID,
+		Gameweek: req.Gameweek,
+		Players:  count,
+	})
+}
+
 func (h *Handler) RunSyncLiveJob(w http.ResponseWriter, r *http.Request) {
 	ctx, span := startSpan(r.Context(), "httpapi.Handler.RunSyncLiveJob")
 	defer span.End()
@@ -0,0 +1,89 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+// ListLeaderboards ranks the league players by our own fixture stats, one
+// leaderboard per category, optionally within a gameweek range.
+func (h *Handler) ListLeaderboards(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListLeaderboards")
+	defer span.End()
+
+	if h.leaderboardService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: leaderboard service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	query := usecase.LeaderboardQuery{
+		LeagueID: strings.TrimSpace(r.PathValue("leagueID")),
+		Category: strings.TrimSpace(r.URL.Query().Get("category")),
+	}
+	var err error
+	if query.FromGameweek, err = parsePositiveIntQuery(r, "from_gameweek"); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if query.ToGameweek, err = parsePositiveIntQuery(r, "to_gameweek"); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	if query.Limit, err = parsePositiveIntQuery(r, "limit"); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	boards, err := h.leaderboardService.List(ctx, query)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list leaderboards failed", "league_id", query.LeagueID, "category", query.Category, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	playerByID, teamNameByID, err := h.leaguePlayersAndTeamNames(ctx, query.LeagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list players failed while mapping leaderboards", "league_id", query.LeagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	out := make([]leaderboardDTO, 0, len(boards))
+	for _, board := range boards {
+		entries := make([]leaderboardEntryDTO, 0, len(board.Entries))
+		for _, item := range board.Entries {
+			entry := leaderboardEntryDTO{
+				Rank:          item.Rank,
+				PlayerID:      item.PlayerID,
+				Club:          teamNameByID[item.TeamID],
+				Value:         item.Value,
+				Appearances:   item.Stats.Appearances,
+				MinutesPlayed: item.Stats.MinutesPlayed,
+				FantasyPoints: item.Stats.TotalPoints,
+			}
+			if p, ok := playerByID[item.PlayerID]; ok {
+				entry.Name = p.Name
+				entry.Position = string(p.Position)
+			}
+			entries = append(entries, entry)
+		}
+		out = append(out, leaderboardDTO{Category: board.Category, Entries: entries})
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+func parsePositiveIntQuery(r *http.Request, name string) (int, error) {
+	raw := strings.TrimSpace(r.URL.Query().Get(name))
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("%w: %s must be positive integer", usecase.ErrInvalidInput, name)
+	}
+	return v, nil
+}
@@ -0,0 +1,124 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+func (h *Handler) GetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetNotificationPreferences")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.notificationService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: notification service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	prefs, err := h.notificationService.GetPreferences(ctx, principal.UserID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "get notification preferences failed", "user_id", principal.UserID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, notificationPreferencesToDTO(ctx, prefs, h.notificationService.PushPublicKey()))
+}
+
+func (h *Handler) UpdateNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.UpdateNotificationPreferences")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.notificationService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: notification service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	var req notificationPreferencesRequest
+	decoder := sonic.ConfigDefault.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	input := usecase.UpdateNotificationPreferencesInput{
+		UserID:         principal.UserID,
+		DefaultEmail:   principal.Email,
+		Email:          req.Email,
+		EmailEnabled:   req.EmailEnabled,
+		PushEnabled:    req.PushEnabled,
+		WebhookURL:     req.WebhookURL,
+		WebhookEnabled: req.WebhookEnabled,
+		MutedEvents:    req.MutedEvents,
+	}
+	if req.PushSubscription != nil {
+		input.PushSubscription = onboarding.PushSubscription{
+			Endpoint: req.PushSubscription.Endpoint,
+			P256DH:   req.PushSubscription.Keys.P256DH,
+			Auth:     req.PushSubscription.Keys.Auth,
+		}
+	}
+
+	prefs, err := h.notificationService.UpdatePreferences(ctx, input)
+	if err != nil {
+		h.logger.WarnContext(ctx, "update notification preferences failed", "user_id", principal.UserID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, notificationPreferencesToDTO(ctx, prefs, h.notificationService.PushPublicKey()))
+}
+
+func notificationPreferencesToDTO(ctx context.Context, item onboarding.NotificationPreferences, vapidPublicKey string) notificationPreferencesDTO {
+	_, span := startSpan(ctx, "httpapi.notificationPreferencesToDTO")
+	defer span.End()
+
+	events := make([]string, 0, len(notification.AllEvents))
+	for event := range notification.AllEvents {
+		events = append(events, string(event))
+	}
+	sort.Strings(events)
+
+	muted := item.MutedEvents
+	if muted == nil {
+		muted = []string{}
+	}
+	dto := notificationPreferencesDTO{
+		UserID:          item.UserID,
+		Email:           item.Email,
+		EmailEnabled:    item.EmailEnabled,
+		PushEnabled:     item.PushEnabled,
+		PushSubscribed:  !item.PushSubscription.IsZero(),
+		WebhookURL:      item.WebhookURL,
+		WebhookEnabled:  item.WebhookEnabled,
+		MutedEvents:     muted,
+		AvailableEvents: events,
+		VAPIDPublicKey:  vapidPublicKey,
+	}
+	if !item.UpdatedAt.IsZero() {
+		dto.UpdatedAtUTC = item.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return dto
+}
@@ -1,58 +1,269 @@
 package httpapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/ownership"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
 	"github.com/riskibarqy/fantasy-league/internal/usecase"
 )
 
+// nextCursorHeader carries the cursor of the next page on paginated lists, so
+// the response body keeps its plain array shape.
+const nextCursorHeader = "X-Next-Cursor"
+
 func (h *Handler) ListPlayersByLeague(w http.ResponseWriter, r *http.Request) {
 	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListPlayersByLeague")
 	defer span.End()
 
 	leagueID := r.PathValue("leagueID")
-	players, err := h.playerService.ListPlayersByLeague(ctx, leagueID)
+	query, err := parsePlayerMarketQuery(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	query.LeagueID = leagueID
+	include, err := parseIncludes(r, usecase.IncludeTeam, usecase.IncludeSeasonStats, usecase.IncludeNextFixtures)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	page, err := h.playerService.SearchMarket(ctx, query)
 	if err != nil {
 		h.logger.WarnContext(ctx, "list players failed", "league_id", leagueID, "error", err)
 		writeError(ctx, w, err)
 		return
 	}
 
-	teams, err := h.leagueService.ListTeamsByLeague(ctx, leagueID)
+	// Teams are always loaded for the name and logo of every player.
+	enrichment, err := h.enrichmentService.Load(ctx, leagueID, append(includes{usecase.IncludeTeam}, include...)...)
 	if err != nil {
-		h.logger.WarnContext(ctx, "list teams failed while mapping players", "league_id", leagueID, "error", err)
+		h.logger.WarnContext(ctx, "load relations failed while mapping players", "league_id", leagueID, "error", err)
 		writeError(ctx, w, err)
 		return
 	}
 
-	teamNameByID := make(map[string]string, len(teams))
-	teamLogoByID := make(map[string]string, len(teams))
-	teamColorByID := make(map[string][]string, len(teams))
-	for _, t := range teams {
+	teamNameByID := make(map[string]string, len(enrichment.Teams))
+	teamLogoByID := make(map[string]string, len(enrichment.Teams))
+	teamColorByID := make(map[string][]string, len(enrichment.Teams))
+	for _, t := range enrichment.Teams {
 		teamNameByID[t.ID] = t.Name
 		teamLogoByID[t.ID] = teamLogoWithFallback(ctx, t.Name, t.ImageURL)
 		teamColorByID[t.ID] = teamColorArray(t.PrimaryColor, t.SecondaryColor)
 	}
 
-	items := make([]playerPublicDTO, 0, len(players))
-	for _, p := range players {
+	ownershipByPlayer, err := h.latestOwnershipByPlayer(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list ownership failed while mapping players", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	items := make([]playerMarketDTO, 0, len(page.Items))
+	for _, p := range page.Items {
 		teamName := teamNameByID[p.TeamID]
-		items = append(items, playerToPublicDTO(
+		item := playerToMarketDTO(
 			ctx,
 			p,
 			teamName,
-			p.ImageURL,
 			teamLogoByID[p.TeamID],
 			teamColorByID[p.TeamID],
-		))
+		)
+		owned, ok := ownershipByPlayer[p.ID]
+		item.Ownership = playerOwnershipToDTO(owned, ok)
+		if t, ok := enrichment.TeamsByID[p.TeamID]; ok && include.has(usecase.IncludeTeam) {
+			dto := teamToDTO(ctx, t)
+			item.Team = &dto
+		}
+		if include.has(usecase.IncludeSeasonStats) {
+			dto := seasonStatsToDTO(ctx, enrichment.SeasonStatsByID[p.ID])
+			item.SeasonStats = &dto
+		}
+		if include.has(usecase.IncludeNextFixtures) {
+			item.NextFixtures = nextFixturesToDTO(p.TeamID, enrichment.NextFixturesByTeam[p.TeamID])
+		}
+		items = append(items, item)
 	}
 
+	if page.NextCursor != "" {
+		w.Header().Set(nextCursorHeader, page.NextCursor)
+	}
 	writeSuccess(ctx, w, http.StatusOK, items)
 }
 
+// GetOwnershipLeaders lists the most selected, captained and transferred
+// players of a locked gameweek, the latest one by default.
+func (h *Handler) GetOwnershipLeaders(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetOwnershipLeaders")
+	defer span.End()
+
+	if h.ownershipService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: ownership service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := strings.TrimSpace(r.PathValue("leagueID"))
+	gameweek := 0
+	if raw := strings.TrimSpace(r.URL.Query().Get("gameweek")); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			writeError(ctx, w, fmt.Errorf("%w: gameweek must be positive integer", usecase.ErrInvalidInput))
+			return
+		}
+		gameweek = v
+	}
+	limit := 0
+	if raw := strings.TrimSpace(r.URL.Query().Get("limit")); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			writeError(ctx, w, fmt.Errorf("%w: limit must be positive integer", usecase.ErrInvalidInput))
+			return
+		}
+		limit = v
+	}
+
+	leaders, err := h.ownershipService.GetLeaders(ctx, leagueID, gameweek, limit)
+	if err != nil {
+		h.logger.WarnContext(ctx, "get ownership leaders failed", "league_id", leagueID, "gameweek", gameweek, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	playerByID, teamNameByID, err := h.leaguePlayersAndTeamNames(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list players failed while mapping ownership leaders", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, ownershipLeadersDTO{
+		LeagueID:           leaders.LeagueID,
+		Gameweek:           leaders.Gameweek,
+		TotalManagers:      leaders.TotalManagers,
+		MostSelected:       ownershipLeadersToDTO(leaders.MostSelected, playerByID, teamNameByID),
+		MostCaptained:      ownershipLeadersToDTO(leaders.MostCaptained, playerByID, teamNameByID),
+		MostTransferredIn:  ownershipLeadersToDTO(leaders.MostTransferredIn, playerByID, teamNameByID),
+		MostTransferredOut: ownershipLeadersToDTO(leaders.MostTransferredOut, playerByID, teamNameByID),
+	})
+}
+
+// leaguePlayersAndTeamNames indexes the league players by id and its team
+// names by team id, for responses that only carry ids.
+func (h *Handler) leaguePlayersAndTeamNames(ctx context.Context, leagueID string) (map[string]player.Player, map[string]string, error) {
+	players, err := h.playerService.ListPlayersByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, nil, err
+	}
+	teams, err := h.leagueService.ListTeamsByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	playerByID := make(map[string]player.Player, len(players))
+	for _, p := range players {
+		playerByID[p.ID] = p
+	}
+	teamNameByID := make(map[string]string, len(teams))
+	for _, t := range teams {
+		teamNameByID[t.ID] = t.Name
+	}
+	return playerByID, teamNameByID, nil
+}
+
+func (h *Handler) latestOwnershipByPlayer(ctx context.Context, leagueID string) (map[string]ownership.PlayerGameweek, error) {
+	if h.ownershipService == nil {
+		return map[string]ownership.PlayerGameweek{}, nil
+	}
+	return h.ownershipService.ListLatestByLeague(ctx, leagueID)
+}
+
+// parsePlayerMarketQuery reads the transfer market filters. Prices use the
+// same display unit as the player DTO (8.5 means 85 stored units); lists are
+// comma separated.
+func parsePlayerMarketQuery(r *http.Request) (player.MarketQuery, error) {
+	values := r.URL.Query()
+	query := player.MarketQuery{
+		TeamIDs: splitQueryList(values.Get("team_id")),
+		Name:    strings.TrimSpace(values.Get("q")),
+		Sort:    player.MarketSort(strings.ToLower(strings.TrimSpace(values.Get("sort")))),
+		Cursor:  strings.TrimSpace(values.Get("cursor")),
+	}
+	for _, position := range splitQueryList(values.Get("position")) {
+		query.Positions = append(query.Positions, player.Position(strings.ToUpper(position)))
+	}
+
+	var err error
+	if query.MinPrice, err = parseQueryPrice(values.Get("min_price"), "min_price"); err != nil {
+		return player.MarketQuery{}, err
+	}
+	if query.MaxPrice, err = parseQueryPrice(values.Get("max_price"), "max_price"); err != nil {
+		return player.MarketQuery{}, err
+	}
+	if raw := strings.TrimSpace(values.Get("available")); raw != "" {
+		query.AvailableOnly, err = strconv.ParseBool(raw)
+		if err != nil {
+			return player.MarketQuery{}, fmt.Errorf("%w: available must be boolean", usecase.ErrInvalidInput)
+		}
+	}
+	if raw := strings.TrimSpace(values.Get("min_minutes")); raw != "" {
+		query.MinMinutes, err = strconv.Atoi(raw)
+		if err != nil || query.MinMinutes < 0 {
+			return player.MarketQuery{}, fmt.Errorf("%w: min_minutes must be non-negative integer", usecase.ErrInvalidInput)
+		}
+	}
+	if raw := strings.TrimSpace(values.Get("limit")); raw != "" {
+		query.Limit, err = strconv.Atoi(raw)
+		if err != nil || query.Limit <= 0 {
+			return player.MarketQuery{}, fmt.Errorf("%w: limit must be positive integer", usecase.ErrInvalidInput)
+		}
+	}
+
+	switch order := strings.ToLower(strings.TrimSpace(values.Get("order"))); order {
+	case "":
+		// Stat sorts default to the best players first.
+		query.Descending = query.Sort != player.MarketSortDefault
+	case "asc":
+		query.Descending = false
+	case "desc":
+		query.Descending = true
+	default:
+		return player.MarketQuery{}, fmt.Errorf("%w: order must be asc or desc", usecase.ErrInvalidInput)
+	}
+
+	return query, nil
+}
+
+func parseQueryPrice(raw, name string) (int64, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil || v < 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, fmt.Errorf("%w: %s must be non-negative number", usecase.ErrInvalidInput, name)
+	}
+	return int64(math.Round(v * 10)), nil
+}
+
+func splitQueryList(raw string) []string {
+	parts := strings.Split(raw, ",")
+	out := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
 func (h *Handler) GetPlayerDetailsByLeague(w http.ResponseWriter, r *http.Request) {
 	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetPlayerDetailsByLeague")
 	defer span.End()
@@ -96,18 +307,104 @@ func (h *Handler) GetPlayerDetailsByLeague(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	ownershipByPlayer, err := h.latestOwnershipByPlayer(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "get ownership failed while getting player details", "league_id", leagueID, "player_id", playerID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
 	teamName := teamNameByID[item.TeamID]
 	teamLogo := teamLogoByID[item.TeamID]
 	teamColor := teamColorByID[item.TeamID]
 	history := historyToDTO(ctx, item.TeamID, historyItems, teamNameByID)
+	playerDTO := playerToPublicDTO(ctx, item, teamName, item.ImageURL, teamLogo, teamColor)
+	owned, ok := ownershipByPlayer[item.ID]
+	playerDTO.Ownership = playerOwnershipToDTO(owned, ok)
 
 	writeSuccess(ctx, w, http.StatusOK, playerDetailDTO{
-		Player:     playerToPublicDTO(ctx, item, teamName, item.ImageURL, teamLogo, teamColor),
+		Player:     playerDTO,
 		Statistics: seasonStatsToDTO(ctx, stats),
 		History:    history,
 	})
 }
 
+// ComparePlayers returns season stats, rates, form, upcoming fixtures and
+// provider stats of two to five players side by side.
+func (h *Handler) ComparePlayers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ComparePlayers")
+	defer span.End()
+
+	if h.comparisonService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: player comparison service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := strings.TrimSpace(r.PathValue("leagueID"))
+	playerIDs := splitQueryList(r.URL.Query().Get("ids"))
+	statKeys := splitQueryList(r.URL.Query().Get("stats"))
+
+	comparison, err := h.comparisonService.Compare(ctx, leagueID, playerIDs, statKeys)
+	if err != nil {
+		h.logger.WarnContext(ctx, "compare players failed", "league_id", leagueID, "player_ids", playerIDs, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	teams, err := h.leagueService.ListTeamsByLeague(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list teams failed while comparing players", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	teamNameByID := make(map[string]string, len(teams))
+	for _, t := range teams {
+		teamNameByID[t.ID] = t.Name
+	}
+
+	out := playerComparisonDTO{
+		LeagueID: comparison.LeagueID,
+		StatKeys: comparison.StatKeys,
+		Players:  make([]comparedPlayerDTO, 0, len(comparison.Players)),
+	}
+	for _, item := range comparison.Players {
+		fixtures := make([]tickerFixtureDTO, 0, len(item.UpcomingFixtures))
+		for _, f := range item.UpcomingFixtures {
+			fixtures = append(fixtures, tickerFixtureDTO{
+				FixtureID:    f.FixtureID,
+				Gameweek:     f.Gameweek,
+				KickoffAt:    f.KickoffAt.UTC().Format(time.RFC3339),
+				OpponentID:   f.OpponentTeamID,
+				OpponentName: teamNameByID[f.OpponentTeamID],
+				IsHome:       f.IsHome,
+				Difficulty:   f.Difficulty,
+			})
+		}
+		out.Players = append(out.Players, comparedPlayerDTO{
+			ID:         item.Player.ID,
+			Name:       item.Player.Name,
+			Club:       teamNameByID[item.Player.TeamID],
+			Position:   string(item.Player.Position),
+			Price:      float64(item.Player.Price) / 10.0,
+			Statistics: seasonStatsToDTO(ctx, item.Season),
+			Per90: playerPer90DTO{
+				Goals:         item.Per90.Goals,
+				Assists:       item.Per90.Assists,
+				Saves:         item.Per90.Saves,
+				FantasyPoints: item.Per90.FantasyPoints,
+			},
+			PointsPerMillion:  item.PointsPerMillion,
+			Form:              item.Form,
+			RecentPoints:      item.RecentPoints,
+			UpcomingFixtures:  fixtures,
+			AverageDifficulty: item.AverageDifficulty,
+			AdvancedStats:     item.AdvancedStats,
+		})
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
 func (h *Handler) GetPlayerHistoryByLeague(w http.ResponseWriter, r *http.Request) {
 	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetPlayerHistoryByLeague")
 	defer span.End()
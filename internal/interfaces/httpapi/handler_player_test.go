@@ -0,0 +1,48 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+func TestParsePlayerMarketQuery(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/leagues/idn-liga-1-2025/players?position=fwd,mid&team_id=idn-persija&min_price=7.5&max_price=10&q=simic&available=true&min_minutes=90&sort=form&limit=20&cursor=abc", nil)
+
+	query, err := parsePlayerMarketQuery(req)
+	if err != nil {
+		t.Fatalf("parsePlayerMarketQuery error: %v", err)
+	}
+	if len(query.Positions) != 2 || query.Positions[0] != player.PositionForward || query.Positions[1] != player.PositionMidfielder {
+		t.Fatalf("unexpected positions: %v", query.Positions)
+	}
+	if len(query.TeamIDs) != 1 || query.MinPrice != 75 || query.MaxPrice != 100 {
+		t.Fatalf("unexpected team or price filters: %+v", query)
+	}
+	if query.Name != "simic" || !query.AvailableOnly || query.MinMinutes != 90 {
+		t.Fatalf("unexpected name, availability or minutes filters: %+v", query)
+	}
+	if query.Sort != player.MarketSortForm || !query.Descending || query.Limit != 20 || query.Cursor != "abc" {
+		t.Fatalf("unexpected sort or paging: %+v", query)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/leagues/idn-liga-1-2025/players", nil)
+	query, err = parsePlayerMarketQuery(req)
+	if err != nil {
+		t.Fatalf("parsePlayerMarketQuery error: %v", err)
+	}
+	if query.Sort != player.MarketSortDefault || query.Descending || query.Limit != 0 {
+		t.Fatalf("expected unfiltered catalog order without params, got %+v", query)
+	}
+
+	for _, raw := range []string{"limit=0", "min_price=abc", "order=up", "available=maybe"} {
+		req = httptest.NewRequest(http.MethodGet, "/v1/leagues/idn-liga-1-2025/players?"+raw, nil)
+		if _, err := parsePlayerMarketQuery(req); !errors.Is(err, usecase.ErrInvalidInput) {
+			t.Fatalf("expected invalid input for %q, got %v", raw, err)
+		}
+	}
+}
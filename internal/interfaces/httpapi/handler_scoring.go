@@ -1,11 +1,16 @@
 package httpapi
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/awards"
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/season"
 	"github.com/riskibarqy/fantasy-league/internal/usecase"
 )
 
@@ -59,6 +64,251 @@ func (h *Handler) ListMyPlayerPointsByGameweek(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	h.writeUserPlayerPoints(ctx, w, r, leagueID, principal.UserID)
+}
+
+func (h *Handler) GetMySeasonHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetMySeasonHistory")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.seasonHistoryService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: season history service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	items, err := h.seasonHistoryService.ListUserHistory(ctx, principal.UserID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list user season history failed", "user_id", principal.UserID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	h.writeUserSeasonHistory(ctx, w, principal.UserID, items)
+}
+
+// ListGameweekSummaries returns the league-wide average, highest score and
+// most captained player of every calculated gameweek.
+func (h *Handler) ListGameweekSummaries(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListGameweekSummaries")
+	defer span.End()
+
+	if h.scoringService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: scoring service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := strings.TrimSpace(r.PathValue("leagueID"))
+	playerByID, _, err := h.leaguePlayersAndTeamNames(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list players failed while mapping gameweek summaries", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	items, err := h.scoringService.ListGameweekSummaries(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list gameweek summaries failed", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	playerNameByID := make(map[string]string, len(playerByID))
+	for id, p := range playerByID {
+		playerNameByID[id] = p.Name
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, gameweekSummariesToDTO(ctx, items, playerNameByID))
+}
+
+// GetGameweekDreamTeam returns the best XI, top player and top manager of a
+// finalized gameweek, the latest one by default.
+func (h *Handler) GetGameweekDreamTeam(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetGameweekDreamTeam")
+	defer span.End()
+
+	leagueID := strings.TrimSpace(r.PathValue("leagueID"))
+	item, ok := h.loadGameweekAwards(w, r, leagueID)
+	if !ok {
+		return
+	}
+
+	playerByID, teamNameByID, err := h.leaguePlayersAndTeamNames(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list players failed while mapping dream team", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	players, total := dreamTeamToDTO(item.DreamTeam, playerByID, teamNameByID)
+	out := gameweekDreamTeamDTO{
+		LeagueID:    item.LeagueID,
+		Gameweek:    item.Gameweek,
+		TotalPoints: total,
+		Players:     players,
+		ComputedAt:  item.ComputedAt,
+	}
+	if item.TopPlayerID != "" {
+		p := playerByID[item.TopPlayerID]
+		club := teamNameByID[p.TeamID]
+		if club == "" {
+			club = p.TeamID
+		}
+		out.TopPlayer = &gameweekTopPlayerDTO{
+			PlayerID: item.TopPlayerID,
+			Name:     p.Name,
+			Club:     club,
+			Points:   item.TopPlayerPoints,
+		}
+	}
+	if item.TopManagerUserID != "" {
+		out.TopManager = &gameweekTopManagerDTO{
+			UserID: item.TopManagerUserID,
+			Points: item.TopManagerPoints,
+		}
+		if h.squadService != nil {
+			squad, err := h.squadService.GetUserSquad(ctx, item.TopManagerUserID, leagueID)
+			switch {
+			case err == nil:
+				out.TopManager.SquadName = squad.Name
+			case !errors.Is(err, usecase.ErrNotFound):
+				h.logger.WarnContext(ctx, "get top manager squad failed", "league_id", leagueID, "user_id", item.TopManagerUserID, "error", err)
+				writeError(ctx, w, err)
+				return
+			}
+		}
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+// GetSeasonDreamTeam returns the best XI on points summed through a finalized
+// gameweek, the latest one by default.
+func (h *Handler) GetSeasonDreamTeam(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetSeasonDreamTeam")
+	defer span.End()
+
+	leagueID := strings.TrimSpace(r.PathValue("leagueID"))
+	item, ok := h.loadGameweekAwards(w, r, leagueID)
+	if !ok {
+		return
+	}
+
+	playerByID, teamNameByID, err := h.leaguePlayersAndTeamNames(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list players failed while mapping season dream team", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	players, total := dreamTeamToDTO(item.SeasonDreamTeam, playerByID, teamNameByID)
+	writeSuccess(ctx, w, http.StatusOK, seasonDreamTeamDTO{
+		LeagueID:        item.LeagueID,
+		ThroughGameweek: item.Gameweek,
+		TotalPoints:     total,
+		Players:         players,
+		ComputedAt:      item.ComputedAt,
+	})
+}
+
+func (h *Handler) loadGameweekAwards(w http.ResponseWriter, r *http.Request, leagueID string) (awards.GameweekAwards, bool) {
+	ctx := r.Context()
+	if h.awardsService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: awards service is not configured", usecase.ErrDependencyUnavailable))
+		return awards.GameweekAwards{}, false
+	}
+
+	gameweek := 0
+	if raw := strings.TrimSpace(r.URL.Query().Get("gameweek")); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			writeError(ctx, w, fmt.Errorf("%w: gameweek must be positive integer", usecase.ErrInvalidInput))
+			return awards.GameweekAwards{}, false
+		}
+		gameweek = v
+	}
+
+	item, err := h.awardsService.GetGameweekAwards(ctx, leagueID, gameweek)
+	if err != nil {
+		h.logger.WarnContext(ctx, "get gameweek awards failed", "league_id", leagueID, "gameweek", gameweek, "error", err)
+		writeError(ctx, w, err)
+		return awards.GameweekAwards{}, false
+	}
+	return item, true
+}
+
+// GetManagerPlayerPoints shows another member of a custom league the lineups
+// and per-player points of their locked gameweeks. Unlocked lineups have no
+// snapshot yet and stay private until the deadline.
+func (h *Handler) GetManagerPlayerPoints(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetManagerPlayerPoints")
+	defer span.End()
+
+	group, ok := h.authorizeManagerView(ctx, w, r)
+	if !ok {
+		return
+	}
+	if h.scoringService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: scoring service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	h.writeUserPlayerPoints(ctx, w, r, group.LeagueID, strings.TrimSpace(r.PathValue("userID")))
+}
+
+// GetManagerSeasonHistory shows another member of a custom league the
+// manager's past seasons they played together in a private custom league,
+// limited to the custom leagues both were in.
+func (h *Handler) GetManagerSeasonHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.GetManagerSeasonHistory")
+	defer span.End()
+
+	if _, ok := h.authorizeManagerView(ctx, w, r); !ok {
+		return
+	}
+	if h.seasonHistoryService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: season history service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	principal, _ := principalFromContext(ctx)
+	managerUserID := strings.TrimSpace(r.PathValue("userID"))
+	items, err := h.seasonHistoryService.ListSharedHistory(ctx, principal.UserID, managerUserID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list shared season history failed", "user_id", principal.UserID, "manager_user_id", managerUserID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	h.writeUserSeasonHistory(ctx, w, managerUserID, items)
+}
+
+func (h *Handler) authorizeManagerView(ctx context.Context, w http.ResponseWriter, r *http.Request) (customleague.Group, bool) {
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return customleague.Group{}, false
+	}
+	if h.customLeagueService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: custom league service is not configured", usecase.ErrDependencyUnavailable))
+		return customleague.Group{}, false
+	}
+
+	groupID := strings.TrimSpace(r.PathValue("groupID"))
+	managerUserID := strings.TrimSpace(r.PathValue("userID"))
+	group, err := h.customLeagueService.AuthorizeManagerView(ctx, principal.UserID, groupID, managerUserID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "authorize manager view failed", "user_id", principal.UserID, "group_id", groupID, "manager_user_id", managerUserID, "error", err)
+		writeError(ctx, w, err)
+		return customleague.Group{}, false
+	}
+	return group, true
+}
+
+// writeUserPlayerPoints writes the per-player points of every locked gameweek
+// of a user, optionally filtered by the gameweek query parameter.
+func (h *Handler) writeUserPlayerPoints(ctx context.Context, w http.ResponseWriter, r *http.Request, leagueID, userID string) {
 	var gameweekFilter *int
 	rawGameweek := strings.TrimSpace(r.URL.Query().Get("gameweek"))
 	if rawGameweek != "" {
@@ -70,9 +320,9 @@ func (h *Handler) ListMyPlayerPointsByGameweek(w http.ResponseWriter, r *http.Re
 		gameweekFilter = &value
 	}
 
-	items, err := h.scoringService.ListUserPlayerPointsByLeague(ctx, leagueID, principal.UserID, gameweekFilter)
+	items, err := h.scoringService.ListUserPlayerPointsByLeague(ctx, leagueID, userID, gameweekFilter)
 	if err != nil {
-		h.logger.WarnContext(ctx, "list user player points by gameweek failed", "user_id", principal.UserID, "league_id", leagueID, "gameweek", rawGameweek, "error", err)
+		h.logger.WarnContext(ctx, "list user player points by gameweek failed", "user_id", userID, "league_id", leagueID, "gameweek", rawGameweek, "error", err)
 		writeError(ctx, w, err)
 		return
 	}
@@ -97,8 +347,37 @@ func (h *Handler) ListMyPlayerPointsByGameweek(w http.ResponseWriter, r *http.Re
 
 	writeSuccess(ctx, w, http.StatusOK, userPlayerPointsResponseDTO{
 		LeagueID:         leagueID,
-		UserID:           principal.UserID,
+		UserID:           userID,
 		FilteredGameweek: gameweekFilter,
 		Items:            responseItems,
 	})
 }
+
+// writeUserSeasonHistory writes the stored season summaries of a user.
+func (h *Handler) writeUserSeasonHistory(ctx context.Context, w http.ResponseWriter, userID string, items []season.UserSummary) {
+	playerNamesByLeague := make(map[string]map[string]string)
+	seasons := make([]userSeasonHistoryDTO, 0, len(items))
+	for _, item := range items {
+		playerNameByID, loaded := playerNamesByLeague[item.LeagueID]
+		if !loaded {
+			playerNameByID = make(map[string]string)
+			if h.playerService != nil && len(item.FinalPicks) > 0 {
+				players, listErr := h.playerService.ListPlayersByLeague(ctx, item.LeagueID)
+				if listErr != nil {
+					h.logger.WarnContext(ctx, "list players failed while mapping season history", "league_id", item.LeagueID, "error", listErr)
+				} else {
+					for _, row := range players {
+						playerNameByID[row.ID] = row.Name
+					}
+				}
+			}
+			playerNamesByLeague[item.LeagueID] = playerNameByID
+		}
+		seasons = append(seasons, userSeasonHistoryToDTO(ctx, item, playerNameByID))
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, userSeasonHistoryResponseDTO{
+		UserID:  userID,
+		Seasons: seasons,
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/riskibarqy/fantasy-league/internal/domain/awards"
 	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
@@ -19,8 +20,11 @@ import (
 	"github.com/riskibarqy/fantasy-league/internal/domain/leaguestanding"
 	"github.com/riskibarqy/fantasy-league/internal/domain/lineup"
 	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+	"github.com/riskibarqy/fantasy-league/internal/domain/ownership"
 	"github.com/riskibarqy/fantasy-league/internal/domain/player"
 	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+	"github.com/riskibarqy/fantasy-league/internal/domain/season"
 	"github.com/riskibarqy/fantasy-league/internal/domain/team"
 	"github.com/riskibarqy/fantasy-league/internal/domain/teamstats"
 	"github.com/riskibarqy/fantasy-league/internal/usecase"
@@ -48,6 +52,22 @@ type Handler struct {
 	scoringService        *usecase.ScoringService
 	onboardingService     *usecase.OnboardingService
 	topScoreService       *usecase.TopScoreService
+	seasonRolloverService *usecase.SeasonRolloverService
+	seasonHistoryService  *usecase.SeasonHistoryService
+	ownershipService      *usecase.OwnershipService
+	awardsService         *usecase.AwardsService
+	difficultyService     *usecase.FixtureDifficultyService
+	comparisonService     *usecase.PlayerComparisonService
+	statValueService      *usecase.StatValueService
+	leaderboardService    *usecase.LeaderboardService
+	notificationService   *usecase.NotificationService
+	systemStatusService   *usecase.SystemStatusService
+	masterDataService     *usecase.MasterDataService
+	statCorrectionService *usecase.StatCorrectionService
+	auditLogService       *usecase.AuditLogService
+	accountService        *usecase.AccountService
+	idempotencyService    *usecase.IdempotencyService
+	enrichmentService     *usecase.EnrichmentService
 	jobDispatchRepo       jobscheduler.Repository
 	logger                *logging.Logger
 	validator             *validator.Validate
@@ -75,6 +95,22 @@ func NewHandler(
 	onboardingService *usecase.OnboardingService,
 	jobDispatchRepo jobscheduler.Repository,
 	topScoreService *usecase.TopScoreService,
+	seasonRolloverService *usecase.SeasonRolloverService,
+	seasonHistoryService *usecase.SeasonHistoryService,
+	ownershipService *usecase.OwnershipService,
+	awardsService *usecase.AwardsService,
+	difficultyService *usecase.FixtureDifficultyService,
+	comparisonService *usecase.PlayerComparisonService,
+	statValueService *usecase.StatValueService,
+	leaderboardService *usecase.LeaderboardService,
+	notificationService *usecase.NotificationService,
+	systemStatusService *usecase.SystemStatusService,
+	masterDataService *usecase.MasterDataService,
+	statCorrectionService *usecase.StatCorrectionService,
+	auditLogService *usecase.AuditLogService,
+	accountService *usecase.AccountService,
+	idempotencyService *usecase.IdempotencyService,
+	enrichmentService *usecase.EnrichmentService,
 	logger *logging.Logger,
 ) *Handler {
 	if logger == nil {
@@ -99,6 +135,22 @@ func NewHandler(
 		onboardingService:     onboardingService,
 		jobDispatchRepo:       jobDispatchRepo,
 		topScoreService:       topScoreService,
+		seasonRolloverService: seasonRolloverService,
+		seasonHistoryService:  seasonHistoryService,
+		ownershipService:      ownershipService,
+		awardsService:         awardsService,
+		difficultyService:     difficultyService,
+		comparisonService:     comparisonService,
+		statValueService:      statValueService,
+		leaderboardService:    leaderboardService,
+		notificationService:   notificationService,
+		systemStatusService:   systemStatusService,
+		masterDataService:     masterDataService,
+		statCorrectionService: statCorrectionService,
+		auditLogService:       auditLogService,
+		accountService:        accountService,
+		idempotencyService:    idempotencyService,
+		enrichmentService:     enrichmentService,
 		logger:                logger,
 		validator:             validator.New(),
 		syncRuns:              make(map[string]syncRunRecord),
@@ -147,6 +199,79 @@ type onboardingPickSquadRequest struct {
 	ViceCaptainID string   `json:"vice_captain_id" validate:"required"`
 }
 
+type notificationPreferencesRequest struct {
+	Email            string                   `json:"email" validate:"omitempty,email"`
+	EmailEnabled     bool                     `json:"email_enabled"`
+	PushEnabled      bool                     `json:"push_enabled"`
+	PushSubscription *pushSubscriptionRequest `json:"push_subscription"`
+	WebhookURL       string                   `json:"webhook_url" validate:"omitempty,url"`
+	WebhookEnabled   bool                     `json:"webhook_enabled"`
+	MutedEvents      []string                 `json:"muted_events" validate:"omitempty,dive,required"`
+}
+
+type adminCreateLeagueRequest struct {
+	ID          string `json:"id" validate:"required,max=100"`
+	Name        string `json:"name" validate:"required,max=100"`
+	CountryCode string `json:"country_code" validate:"required,min=2,max=3"`
+	Season      string `json:"season" validate:"required,max=20"`
+	IsDefault   bool   `json:"is_default"`
+	LeagueRefID int64  `json:"league_ref_id" validate:"gte=0"`
+	SeasonRefID int64  `json:"season_ref_id" validate:"gte=0"`
+}
+
+type adminUpdateLeagueRequest struct {
+	Name        *string `json:"name" validate:"omitempty,max=100"`
+	CountryCode *string `json:"country_code" validate:"omitempty,min=2,max=3"`
+	Season      *string `json:"season" validate:"omitempty,max=20"`
+	IsDefault   *bool   `json:"is_default"`
+	LeagueRefID *int64  `json:"league_ref_id" validate:"omitempty,gte=0"`
+	SeasonRefID *int64  `json:"season_ref_id" validate:"omitempty,gte=0"`
+}
+
+type teamOverrideRequest struct {
+	Hidden bool   `json:"hidden"`
+	Note   string `json:"note" validate:"max=500"`
+}
+
+type playerOverrideRequest struct {
+	Position  *string `json:"position" validate:"omitempty,oneof=GK DEF MID FWD"`
+	Price     *int64  `json:"price" validate:"omitempty,gt=0"`
+	Available *bool   `json:"available"`
+	Hidden    bool    `json:"hidden"`
+	Note      string  `json:"note" validate:"max=500"`
+}
+
+type statCorrectionRequest struct {
+	Reason  string                        `json:"reason" validate:"required,max=500"`
+	Players []playerStatCorrectionRequest `json:"players" validate:"required,min=1,dive"`
+}
+
+type playerStatCorrectionRequest struct {
+	PlayerID      string `json:"player_id" validate:"required"`
+	MinutesPlayed *int   `json:"minutes_played" validate:"omitempty,gte=0,lte=150"`
+	Goals         *int   `json:"goals" validate:"omitempty,gte=0"`
+	Assists       *int   `json:"assists" validate:"omitempty,gte=0"`
+	CleanSheet    *bool  `json:"clean_sheet"`
+	YellowCards   *int   `json:"yellow_cards" validate:"omitempty,gte=0,lte=2"`
+	RedCards      *int   `json:"red_cards" validate:"omitempty,gte=0,lte=1"`
+	Saves         *int   `json:"saves" validate:"omitempty,gte=0"`
+	BonusPoints   *int   `json:"bonus_points" validate:"omitempty,gte=0"`
+	FantasyPoints *int   `json:"fantasy_points"`
+}
+
+type anubisWebhookRequest struct {
+	Event  string `json:"event" validate:"required"`
+	UserID string `json:"user_id" validate:"required_if=Event account.deleted"`
+}
+
+type pushSubscriptionRequest struct {
+	Endpoint string `json:"endpoint" validate:"required,url"`
+	Keys     struct {
+		P256DH string `json:"p256dh" validate:"required"`
+		Auth   string `json:"auth" validate:"required"`
+	} `json:"keys"`
+}
+
 type addPlayerToSquadRequest struct {
 	LeagueID  string `json:"league_id" validate:"required"`
 	SquadName string `json:"squad_name" validate:"omitempty,max=100"`
@@ -194,6 +319,7 @@ type ingestPlayerFixtureStatRecord struct {
 	YellowCards       int            `json:"yellow_cards"`
 	RedCards          int            `json:"red_cards"`
 	Saves             int            `json:"saves"`
+	BonusPoints       int            `json:"bonus_points"`
 	FantasyPoints     int            `json:"fantasy_points"`
 	AdvancedStats     map[string]any `json:"advanced_stats,omitempty"`
 	Payload           map[string]any `json:"payload,omitempty"`
@@ -309,6 +435,48 @@ type internalJobSyncRequest struct {
 	DispatchID string `json:"dispatch_id"`
 }
 
+type ownershipJobRequest struct {
+	LeagueID   string `json:"league_id" validate:"required"`
+	Gameweek   int    `json:"gameweek" validate:"required,gt=0"`
+	DispatchID string `json:"dispatch_id"`
+}
+
+type ownershipJobDTO struct {
+	LeagueID string `json:"league_id"`
+	Gameweek int    `json:"gameweek"`
+	Players  int    `json:"players"`
+}
+
+type seasonRolloverRequest struct {
+	LeagueID    string `json:"league_id" validate:"required"`
+	NewLeagueID string `json:"new_league_id" validate:"omitempty,max=100"`
+	Season      string `json:"season" validate:"required,max=50"`
+	SeasonID    int64  `json:"season_id" validate:"required,gt=0"`
+	Name        string `json:"name" validate:"omitempty,max=100"`
+	Force       bool   `json:"force"`
+	DispatchID  string `json:"dispatch_id"`
+}
+
+type seasonRolloverDTO struct {
+	RolloverID           string                 `json:"rollover_id"`
+	FromLeagueID         string                 `json:"from_league_id"`
+	ToLeagueID           string                 `json:"to_league_id"`
+	FromSeason           string                 `json:"from_season"`
+	ToSeason             string                 `json:"to_season"`
+	SeasonID             int64                  `json:"season_id"`
+	ArchivedSquads       int                    `json:"archived_squads"`
+	ArchivedLineups      int                    `json:"archived_lineups"`
+	ArchivedPoints       int                    `json:"archived_points"`
+	ArchivedStandings    int                    `json:"archived_standings"`
+	CarriedCustomLeagues int                    `json:"carried_custom_leagues"`
+	SummarizedUsers      int                    `json:"summarized_users"`
+	MasterDataSynced     bool                   `json:"master_data_synced"`
+	MasterData           *usecase.ResyncResult  `json:"master_data,omitempty"`
+	SyncError            string                 `json:"sync_error,omitempty"`
+	Bootstrap            *usecase.JobSyncResult `json:"bootstrap,omitempty"`
+	CreatedAt            string                 `json:"created_at"`
+}
+
 type getSquadRequest struct {
 	LeagueID string `validate:"required"`
 }
@@ -331,6 +499,75 @@ type userSeasonPointsSummaryDTO struct {
 	Gameweeks             int     `json:"gameweeks"`
 	CurrentGameweek       int     `json:"current_gameweek"`
 	CurrentGameweekPoints int     `json:"current_gameweek_points"`
+
+	GameweekComparisons []userGameweekComparisonDTO `json:"gameweek_comparisons"`
+}
+
+type userGameweekComparisonDTO struct {
+	Gameweek              int     `json:"gameweek"`
+	Points                int     `json:"points"`
+	AveragePoints         float64 `json:"average_points"`
+	HighestPoints         int     `json:"highest_points"`
+	DifferenceFromAverage float64 `json:"difference_from_average"`
+}
+
+type gameweekSummaryDTO struct {
+	Gameweek      int                     `json:"gameweek"`
+	Managers      int                     `json:"managers"`
+	AveragePoints float64                 `json:"averagePoints"`
+	HighestPoints int                     `json:"highestPoints"`
+	HighestUserID string                  `json:"highestUserId,omitempty"`
+	MostCaptained *gameweekMostCaptainDTO `json:"mostCaptained,omitempty"`
+	CalculatedAt  time.Time               `json:"calculatedAt"`
+}
+
+type gameweekMostCaptainDTO struct {
+	PlayerID string `json:"playerId"`
+	Name     string `json:"name"`
+	Count    int    `json:"count"`
+}
+
+type seasonSummaryJobDTO struct {
+	LeagueID        string `json:"league_id"`
+	SummarizedUsers int    `json:"summarized_users"`
+}
+
+type userSeasonHistoryPickDTO struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name,omitempty"`
+	TeamID     string `json:"team_id"`
+	Position   string `json:"position"`
+	Price      int64  `json:"price"`
+}
+
+type userSeasonHistoryCustomLeagueDTO struct {
+	GroupID   string `json:"group_id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"is_default"`
+	Rank      int    `json:"rank"`
+	Points    int    `json:"points"`
+	Members   int    `json:"members"`
+}
+
+type userSeasonHistoryDTO struct {
+	LeagueID           string                             `json:"league_id"`
+	Season             string                             `json:"season"`
+	SquadID            string                             `json:"squad_id,omitempty"`
+	SquadName          string                             `json:"squad_name,omitempty"`
+	TotalPoints        int                                `json:"total_points"`
+	OverallRank        int                                `json:"overall_rank"`
+	TotalManagers      int                                `json:"total_managers"`
+	GameweeksPlayed    int                                `json:"gameweeks_played"`
+	BestGameweek       int                                `json:"best_gameweek"`
+	BestGameweekPoints int                                `json:"best_gameweek_points"`
+	FinalSquad         []userSeasonHistoryPickDTO         `json:"final_squad"`
+	CustomLeagues      []userSeasonHistoryCustomLeagueDTO `json:"custom_leagues"`
+	ComputedAt         string                             `json:"computed_at"`
+}
+
+type userSeasonHistoryResponseDTO struct {
+	UserID  string                 `json:"user_id"`
+	Seasons []userSeasonHistoryDTO `json:"seasons"`
 }
 
 type userPlayerPointsDTO struct {
@@ -361,10 +598,13 @@ type userPlayerPointsResponseDTO struct {
 }
 
 type leaguePublicDTO struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	CountryCode string `json:"countryCode"`
-	LogoURL     string `json:"logoUrl"`
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	CountryCode      string `json:"countryCode"`
+	LogoURL          string `json:"logoUrl"`
+	Season           string `json:"season"`
+	IsArchived       bool   `json:"isArchived"`
+	PreviousLeagueID string `json:"previousLeagueId,omitempty"`
 }
 
 type teamDTO struct {
@@ -421,6 +661,185 @@ type playerPublicDTO struct {
 	ImageURL        string   `json:"imageUrl"`
 	TeamLogoURL     string   `json:"teamLogoUrl"`
 	TeamColor       []string `json:"teamColor,omitempty"`
+	// Ownership is set from the latest locked gameweek, when there is one.
+	Ownership *playerOwnershipDTO `json:"ownership,omitempty"`
+}
+
+type playerComparisonDTO struct {
+	LeagueID string              `json:"leagueId"`
+	StatKeys []string            `json:"statKeys"`
+	Players  []comparedPlayerDTO `json:"players"`
+}
+
+type comparedPlayerDTO struct {
+	ID                string              `json:"id"`
+	Name              string              `json:"name"`
+	Club              string              `json:"club"`
+	Position          string              `json:"position"`
+	Price             float64             `json:"price"`
+	Statistics        playerStatisticsDTO `json:"statistics"`
+	Per90             playerPer90DTO      `json:"per90"`
+	PointsPerMillion  float64             `json:"pointsPerMillion"`
+	Form              float64             `json:"form"`
+	RecentPoints      []int               `json:"recentPoints"`
+	UpcomingFixtures  []tickerFixtureDTO  `json:"upcomingFixtures"`
+	AverageDifficulty float64             `json:"averageDifficulty"`
+	AdvancedStats     map[string]float64  `json:"advancedStats"`
+}
+
+type playerPer90DTO struct {
+	Goals         float64 `json:"goals"`
+	Assists       float64 `json:"assists"`
+	Saves         float64 `json:"saves"`
+	FantasyPoints float64 `json:"fantasyPoints"`
+}
+
+type statTypeGroupDTO struct {
+	Group string        `json:"group"`
+	Types []statTypeDTO `json:"types"`
+}
+
+type statTypeDTO struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	DeveloperName string `json:"developerName"`
+	Code          string `json:"code,omitempty"`
+	ModelType     string `json:"modelType,omitempty"`
+}
+
+type statValueDTO struct {
+	StatKey     string   `json:"statKey"`
+	StatTypeID  int64    `json:"statTypeId"`
+	Scope       string   `json:"scope"`
+	SeasonRefID int64    `json:"seasonRefId"`
+	Value       *float64 `json:"value,omitempty"`
+	ValueText   string   `json:"valueText,omitempty"`
+}
+
+type playerStatLeaderDTO struct {
+	Rank     int     `json:"rank"`
+	PlayerID string  `json:"playerId"`
+	Name     string  `json:"name"`
+	Club     string  `json:"club"`
+	Position string  `json:"position"`
+	Value    float64 `json:"value"`
+}
+
+type teamStatLeaderDTO struct {
+	Rank   int     `json:"rank"`
+	TeamID string  `json:"teamId"`
+	Name   string  `json:"name"`
+	Value  float64 `json:"value"`
+}
+
+type leaderboardDTO struct {
+	Category string                `json:"category"`
+	Entries  []leaderboardEntryDTO `json:"entries"`
+}
+
+type leaderboardEntryDTO struct {
+	Rank          int    `json:"rank"`
+	PlayerID      string `json:"playerId"`
+	Name          string `json:"name"`
+	Club          string `json:"club"`
+	Position      string `json:"position"`
+	Value         int    `json:"value"`
+	Appearances   int    `json:"appearances"`
+	MinutesPlayed int    `json:"minutesPlayed"`
+	FantasyPoints int    `json:"fantasyPoints"`
+}
+
+type playerOwnershipDTO struct {
+	Gameweek          int     `json:"gameweek"`
+	SelectedByPercent float64 `json:"selectedByPercent"`
+	CaptainPercent    float64 `json:"captainPercent"`
+	StartingPercent   float64 `json:"startingPercent"`
+	TransfersIn       int     `json:"transfersIn"`
+	TransfersOut      int     `json:"transfersOut"`
+}
+
+type ownershipLeaderDTO struct {
+	PlayerID          string  `json:"playerId"`
+	Name              string  `json:"name"`
+	Club              string  `json:"club"`
+	Position          string  `json:"position"`
+	SelectedByPercent float64 `json:"selectedByPercent"`
+	CaptainPercent    float64 `json:"captainPercent"`
+	StartingPercent   float64 `json:"startingPercent"`
+	TransfersIn       int     `json:"transfersIn"`
+	TransfersOut      int     `json:"transfersOut"`
+}
+
+type ownershipLeadersDTO struct {
+	LeagueID           string               `json:"leagueId"`
+	Gameweek           int                  `json:"gameweek"`
+	TotalManagers      int                  `json:"totalManagers"`
+	MostSelected       []ownershipLeaderDTO `json:"mostSelected"`
+	MostCaptained      []ownershipLeaderDTO `json:"mostCaptained"`
+	MostTransferredIn  []ownershipLeaderDTO `json:"mostTransferredIn"`
+	MostTransferredOut []ownershipLeaderDTO `json:"mostTransferredOut"`
+}
+
+type dreamTeamPlayerDTO struct {
+	PlayerID string `json:"playerId"`
+	Name     string `json:"name"`
+	Club     string `json:"club"`
+	Position string `json:"position"`
+	Points   int    `json:"points"`
+}
+
+type gameweekTopPlayerDTO struct {
+	PlayerID string `json:"playerId"`
+	Name     string `json:"name"`
+	Club     string `json:"club"`
+	Points   int    `json:"points"`
+}
+
+type gameweekTopManagerDTO struct {
+	UserID    string `json:"userId"`
+	SquadName string `json:"squadName"`
+	Points    int    `json:"points"`
+}
+
+type gameweekDreamTeamDTO struct {
+	LeagueID    string                 `json:"leagueId"`
+	Gameweek    int                    `json:"gameweek"`
+	TotalPoints int                    `json:"totalPoints"`
+	Players     []dreamTeamPlayerDTO   `json:"players"`
+	TopPlayer   *gameweekTopPlayerDTO  `json:"topPlayer,omitempty"`
+	TopManager  *gameweekTopManagerDTO `json:"topManager,omitempty"`
+	ComputedAt  time.Time              `json:"computedAt"`
+}
+
+type seasonDreamTeamDTO struct {
+	LeagueID        string               `json:"leagueId"`
+	ThroughGameweek int                  `json:"throughGameweek"`
+	TotalPoints     int                  `json:"totalPoints"`
+	Players         []dreamTeamPlayerDTO `json:"players"`
+	ComputedAt      time.Time            `json:"computedAt"`
+}
+
+type playerMarketDTO struct {
+	playerPublicDTO
+	IsAvailable      bool    `json:"isAvailable"`
+	TotalPoints      int     `json:"totalPoints"`
+	MinutesPlayed    int     `json:"minutesPlayed"`
+	OwnershipPercent float64 `json:"ownershipPercent"`
+	// Team, SeasonStats and NextFixtures are only set when asked for with
+	// include=.
+	Team         *teamDTO             `json:"team,omitempty"`
+	SeasonStats  *playerStatisticsDTO `json:"seasonStats,omitempty"`
+	NextFixtures []nextFixtureDTO     `json:"nextFixtures,omitempty"`
+}
+
+type nextFixtureDTO struct {
+	FixtureID    string `json:"fixtureId"`
+	Gameweek     int    `json:"gameweek"`
+	KickoffAt    string `json:"kickoffAt"`
+	OpponentID   string `json:"opponentTeamId"`
+	OpponentName string `json:"opponentTeamName"`
+	IsHome       bool   `json:"isHome"`
+	Status       string `json:"status"`
 }
 
 type TopScorePublicDTO struct {
@@ -457,6 +876,29 @@ type squadPlayerDTO struct {
 	TeamColor       []string `json:"teamColor,omitempty"`
 }
 
+type fixtureTickerDTO struct {
+	LeagueID     string                 `json:"leagueId"`
+	FromGameweek int                    `json:"fromGameweek"`
+	ToGameweek   int                    `json:"toGameweek"`
+	Teams        []teamFixtureTickerDTO `json:"teams"`
+}
+
+type teamFixtureTickerDTO struct {
+	TeamID   string             `json:"teamId"`
+	TeamName string             `json:"teamName"`
+	Fixtures []tickerFixtureDTO `json:"fixtures"`
+}
+
+type tickerFixtureDTO struct {
+	FixtureID    string `json:"fixtureId"`
+	Gameweek     int    `json:"gameweek"`
+	KickoffAt    string `json:"kickoffAt"`
+	OpponentID   string `json:"opponentTeamId"`
+	OpponentName string `json:"opponentTeamName"`
+	IsHome       bool   `json:"isHome"`
+	Difficulty   int    `json:"difficulty"`
+}
+
 type fixtureDTO struct {
 	ID              string `json:"id"`
 	LeagueID        string `json:"leagueId"`
@@ -472,6 +914,13 @@ type fixtureDTO struct {
 	Status          string `json:"status"`
 	WinnerTeamID    string `json:"winnerTeamId,omitempty"`
 	FinishedAt      string `json:"finishedAt,omitempty"`
+	// Team is only set when asked for with include=team.
+	Team *fixtureTeamsDTO `json:"team,omitempty"`
+}
+
+type fixtureTeamsDTO struct {
+	Home *teamDTO `json:"home,omitempty"`
+	Away *teamDTO `json:"away,omitempty"`
 }
 
 type fixtureEventDTO struct {
@@ -602,6 +1051,178 @@ type onboardingProfileDTO struct {
 	UpdatedAtUTC        string `json:"updated_at_utc,omitempty"`
 }
 
+type readinessDTO struct {
+	Status       string                `json:"status"`
+	CheckedAtUTC string                `json:"checked_at_utc"`
+	Dependencies []dependencyStatusDTO `json:"dependencies"`
+}
+
+type dependencyStatusDTO struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Critical bool   `json:"critical"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+type systemStatusDTO struct {
+	readinessDTO
+	Database  *databaseStatusDTO  `json:"database,omitempty"`
+	Circuits  []circuitStatusDTO  `json:"circuits"`
+	LastSyncs []lastSyncStatusDTO `json:"last_syncs"`
+	Cache     cacheStatusDTO      `json:"cache"`
+}
+
+type databaseStatusDTO struct {
+	PingMS           float64 `json:"ping_ms"`
+	OpenConnections  int     `json:"open_connections"`
+	InUse            int     `json:"in_use"`
+	Idle             int     `json:"idle"`
+	MaxOpen          int     `json:"max_open"`
+	WaitCount        int64   `json:"wait_count"`
+	WaitMS           float64 `json:"wait_ms"`
+	MigrationVersion int64   `json:"migration_version"`
+	MigrationDirty   bool    `json:"migration_dirty"`
+}
+
+type circuitStatusDTO struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+type lastSyncStatusDTO struct {
+	LeagueID       string `json:"league_id"`
+	Job            string `json:"job"`
+	CompletedAtUTC string `json:"completed_at_utc"`
+	AgeSeconds     int64  `json:"age_seconds"`
+}
+
+type cacheStatusDTO struct {
+	Enabled bool `json:"enabled"`
+	Entries int  `json:"entries"`
+}
+
+type adminLeagueDTO struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	CountryCode string `json:"country_code"`
+	Season      string `json:"season"`
+	IsDefault   bool   `json:"is_default"`
+	LeagueRefID int64  `json:"league_ref_id"`
+	SeasonRefID int64  `json:"season_ref_id"`
+	IsArchived  bool   `json:"is_archived"`
+}
+
+type masterDataOverridesDTO struct {
+	Teams   []teamOverrideDTO   `json:"teams"`
+	Players []playerOverrideDTO `json:"players"`
+}
+
+type teamOverrideDTO struct {
+	LeagueID     string `json:"league_id"`
+	TeamID       string `json:"team_id"`
+	Hidden       bool   `json:"hidden"`
+	Note         string `json:"note,omitempty"`
+	UpdatedBy    string `json:"updated_by,omitempty"`
+	UpdatedAtUTC string `json:"updated_at_utc,omitempty"`
+}
+
+type playerOverrideDTO struct {
+	LeagueID     string  `json:"league_id"`
+	PlayerID     string  `json:"player_id"`
+	Position     *string `json:"position"`
+	Price        *int64  `json:"price"`
+	Available    *bool   `json:"available"`
+	Hidden       bool    `json:"hidden"`
+	Note         string  `json:"note,omitempty"`
+	UpdatedBy    string  `json:"updated_by,omitempty"`
+	UpdatedAtUTC string  `json:"updated_at_utc,omitempty"`
+}
+
+type statCorrectionDTO struct {
+	ID            int64                         `json:"id"`
+	LeagueID      string                        `json:"league_id"`
+	FixtureID     string                        `json:"fixture_id"`
+	Gameweek      int                           `json:"gameweek"`
+	Reason        string                        `json:"reason"`
+	CreatedBy     string                        `json:"created_by"`
+	TraceID       string                        `json:"trace_id,omitempty"`
+	Players       []statCorrectionPlayerDTO     `json:"players"`
+	UserPoints    []statCorrectionUserPointsDTO `json:"user_points"`
+	RescoredAtUTC string                        `json:"rescored_at_utc,omitempty"`
+	CreatedAtUTC  string                        `json:"created_at_utc,omitempty"`
+}
+
+type statCorrectionPlayerDTO struct {
+	PlayerID string                  `json:"player_id"`
+	Before   correctedFixtureStatDTO `json:"before"`
+	After    correctedFixtureStatDTO `json:"after"`
+}
+
+type correctedFixtureStatDTO struct {
+	MinutesPlayed int  `json:"minutes_played"`
+	Goals         int  `json:"goals"`
+	Assists       int  `json:"assists"`
+	CleanSheet    bool `json:"clean_sheet"`
+	YellowCards   int  `json:"yellow_cards"`
+	RedCards      int  `json:"red_cards"`
+	Saves         int  `json:"saves"`
+	BonusPoints   int  `json:"bonus_points"`
+	FantasyPoints int  `json:"fantasy_points"`
+}
+
+type statCorrectionUserPointsDTO struct {
+	UserID string `json:"user_id"`
+	Before int    `json:"before"`
+	After  int    `json:"after"`
+}
+
+type auditLogEntryDTO struct {
+	ID           int64          `json:"id"`
+	ActorUserID  string         `json:"actor_user_id"`
+	Action       string         `json:"action"`
+	EntityType   string         `json:"entity_type"`
+	EntityID     string         `json:"entity_id"`
+	LeagueID     string         `json:"league_id,omitempty"`
+	TraceID      string         `json:"trace_id,omitempty"`
+	Before       map[string]any `json:"before,omitempty"`
+	After        map[string]any `json:"after,omitempty"`
+	CreatedAtUTC string         `json:"created_at_utc"`
+}
+
+type accountArchiveDTO struct {
+	UserID         string                      `json:"user_id"`
+	GeneratedAtUTC string                      `json:"generated_at_utc"`
+	Tables         map[string][]map[string]any `json:"tables"`
+}
+
+type accountDeletionDTO struct {
+	UserID       string            `json:"user_id"`
+	RowsByTable  map[string]int64  `json:"rows_by_table"`
+	NewOwners    map[string]string `json:"new_owners"`
+	ClosedGroups []string          `json:"closed_groups"`
+	LeagueIDs    []string          `json:"league_ids"`
+}
+
+type anubisWebhookDTO struct {
+	Event    string              `json:"event"`
+	Handled  bool                `json:"handled"`
+	Deletion *accountDeletionDTO `json:"deletion,omitempty"`
+}
+
+type notificationPreferencesDTO struct {
+	UserID          string   `json:"user_id"`
+	Email           string   `json:"email,omitempty"`
+	EmailEnabled    bool     `json:"email_enabled"`
+	PushEnabled     bool     `json:"push_enabled"`
+	PushSubscribed  bool     `json:"push_subscribed"`
+	WebhookURL      string   `json:"webhook_url,omitempty"`
+	WebhookEnabled  bool     `json:"webhook_enabled"`
+	MutedEvents     []string `json:"muted_events"`
+	AvailableEvents []string `json:"available_events"`
+	VAPIDPublicKey  string   `json:"vapid_public_key,omitempty"`
+	UpdatedAtUTC    string   `json:"updated_at_utc,omitempty"`
+}
+
 type onboardingCompleteResponseDTO struct {
 	Profile onboardingProfileDTO `json:"profile"`
 	Squad   squadDTO             `json:"squad"`
@@ -667,10 +1288,13 @@ func leagueToPublicDTO(ctx context.Context, v league.League) leaguePublicDTO {
 	defer span.End()
 
 	return leaguePublicDTO{
-		ID:          v.ID,
-		Name:        v.Name,
-		CountryCode: v.CountryCode,
-		LogoURL:     leagueLogoURL(ctx, v.Name, v.CountryCode),
+		ID:               v.ID,
+		Name:             v.Name,
+		CountryCode:      v.CountryCode,
+		LogoURL:          leagueLogoURL(ctx, v.Name, v.CountryCode),
+		Season:           v.Season,
+		IsArchived:       v.IsArchived(),
+		PreviousLeagueID: v.PreviousLeagueID,
 	}
 }
 
@@ -713,6 +1337,49 @@ func teamSeasonStatsToDTO(ctx context.Context, stats teamstats.SeasonStats) team
 	}
 }
 
+func userSeasonHistoryToDTO(ctx context.Context, item season.UserSummary, playerNameByID map[string]string) userSeasonHistoryDTO {
+	_, span := startSpan(ctx, "httpapi.userSeasonHistoryToDTO")
+	defer span.End()
+
+	picks := make([]userSeasonHistoryPickDTO, 0, len(item.FinalPicks))
+	for _, pick := range item.FinalPicks {
+		picks = append(picks, userSeasonHistoryPickDTO{
+			PlayerID:   pick.PlayerID,
+			PlayerName: playerNameByID[pick.PlayerID],
+			TeamID:     pick.TeamID,
+			Position:   string(pick.Position),
+			Price:      pick.Price,
+		})
+	}
+	groups := make([]userSeasonHistoryCustomLeagueDTO, 0, len(item.CustomLeagues))
+	for _, group := range item.CustomLeagues {
+		groups = append(groups, userSeasonHistoryCustomLeagueDTO{
+			GroupID:   group.GroupID,
+			Name:      group.Name,
+			IsDefault: group.IsDefault,
+			Rank:      group.Rank,
+			Points:    group.Points,
+			Members:   group.Members,
+		})
+	}
+
+	return userSeasonHistoryDTO{
+		LeagueID:           item.LeagueID,
+		Season:             item.Season,
+		SquadID:            item.SquadID,
+		SquadName:          item.SquadName,
+		TotalPoints:        item.TotalPoints,
+		OverallRank:        item.OverallRank,
+		TotalManagers:      item.TotalManagers,
+		GameweeksPlayed:    item.GameweeksPlayed,
+		BestGameweek:       item.BestGameweek,
+		BestGameweekPoints: item.BestGameweekPoints,
+		FinalSquad:         picks,
+		CustomLeagues:      groups,
+		ComputedAt:         item.ComputedAt.UTC().Format(time.RFC3339),
+	}
+}
+
 func userSeasonPointsSummaryToDTO(ctx context.Context, item usecase.UserSeasonPointsSummary) userSeasonPointsSummaryDTO {
 	ctx, span := startSpan(ctx, "httpapi.userSeasonPointsSummaryToDTO")
 	defer span.End()
@@ -726,7 +1393,45 @@ func userSeasonPointsSummaryToDTO(ctx context.Context, item usecase.UserSeasonPo
 		Gameweeks:             item.Gameweeks,
 		CurrentGameweek:       item.CurrentGameweek,
 		CurrentGameweekPoints: item.CurrentGameweekPoints,
+		GameweekComparisons:   userGameweekComparisonsToDTO(ctx, item.Comparisons),
+	}
+}
+
+func userGameweekComparisonsToDTO(ctx context.Context, items []usecase.UserGameweekComparison) []userGameweekComparisonDTO {
+	out := make([]userGameweekComparisonDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, userGameweekComparisonDTO{
+			Gameweek:              item.Gameweek,
+			Points:                item.Points,
+			AveragePoints:         round2(ctx, item.AveragePoints),
+			HighestPoints:         item.HighestPoints,
+			DifferenceFromAverage: round2(ctx, item.DifferenceFromAverage),
+		})
+	}
+	return out
+}
+
+func gameweekSummariesToDTO(ctx context.Context, items []scoring.GameweekSummary, playerNameByID map[string]string) []gameweekSummaryDTO {
+	out := make([]gameweekSummaryDTO, 0, len(items))
+	for _, item := range items {
+		dto := gameweekSummaryDTO{
+			Gameweek:      item.Gameweek,
+			Managers:      item.Managers,
+			AveragePoints: round2(ctx, item.AveragePoints),
+			HighestPoints: item.HighestPoints,
+			HighestUserID: item.HighestUserID,
+			CalculatedAt:  item.CalculatedAt,
+		}
+		if item.MostCaptainedPlayerID != "" {
+			dto.MostCaptained = &gameweekMostCaptainDTO{
+				PlayerID: item.MostCaptainedPlayerID,
+				Name:     playerNameByID[item.MostCaptainedPlayerID],
+				Count:    item.MostCaptainedCount,
+			}
+		}
+		out = append(out, dto)
 	}
+	return out
 }
 
 func userGameweekPlayerPointsToDTO(
@@ -824,6 +1529,87 @@ func playerToPublicDTO(
 	}
 }
 
+// playerToMarketDTO reports the form computed from fixture stats, since the
+// market is sorted on it.
+func playerToMarketDTO(
+	ctx context.Context,
+	v player.MarketPlayer,
+	teamName,
+	teamLogo string,
+	teamColor []string,
+) playerMarketDTO {
+	ctx, span := startSpan(ctx, "httpapi.playerToMarketDTO")
+	defer span.End()
+
+	dto := playerMarketDTO{
+		playerPublicDTO:  playerToPublicDTO(ctx, v.Player, teamName, v.ImageURL, teamLogo, teamColor),
+		IsAvailable:      v.Available,
+		TotalPoints:      v.TotalPoints,
+		MinutesPlayed:    v.MinutesPlayed,
+		OwnershipPercent: v.OwnershipPercent,
+	}
+	dto.Form = v.Form
+	return dto
+}
+
+func playerOwnershipToDTO(item ownership.PlayerGameweek, ok bool) *playerOwnershipDTO {
+	if !ok {
+		return nil
+	}
+	return &playerOwnershipDTO{
+		Gameweek:          item.Gameweek,
+		SelectedByPercent: item.SelectedPercent,
+		CaptainPercent:    item.CaptainPercent,
+		StartingPercent:   item.StarterPercent,
+		TransfersIn:       item.TransfersIn,
+		TransfersOut:      item.TransfersOut,
+	}
+}
+
+func ownershipLeadersToDTO(items []ownership.PlayerGameweek, playerByID map[string]player.Player, teamNameByID map[string]string) []ownershipLeaderDTO {
+	out := make([]ownershipLeaderDTO, 0, len(items))
+	for _, item := range items {
+		p := playerByID[item.PlayerID]
+		club := teamNameByID[p.TeamID]
+		if club == "" {
+			club = p.TeamID
+		}
+		out = append(out, ownershipLeaderDTO{
+			PlayerID:          item.PlayerID,
+			Name:              p.Name,
+			Club:              club,
+			Position:          string(p.Position),
+			SelectedByPercent: item.SelectedPercent,
+			CaptainPercent:    item.CaptainPercent,
+			StartingPercent:   item.StarterPercent,
+			TransfersIn:       item.TransfersIn,
+			TransfersOut:      item.TransfersOut,
+		})
+	}
+	return out
+}
+
+func dreamTeamToDTO(items []awards.DreamTeamPlayer, playerByID map[string]player.Player, teamNameByID map[string]string) ([]dreamTeamPlayerDTO, int) {
+	out := make([]dreamTeamPlayerDTO, 0, len(items))
+	total := 0
+	for _, item := range items {
+		p := playerByID[item.PlayerID]
+		club := teamNameByID[p.TeamID]
+		if club == "" {
+			club = p.TeamID
+		}
+		out = append(out, dreamTeamPlayerDTO{
+			PlayerID: item.PlayerID,
+			Name:     p.Name,
+			Club:     club,
+			Position: string(item.Position),
+			Points:   item.Points,
+		})
+		total += item.Points
+	}
+	return out, total
+}
+
 func teamColorArray(primary, secondary string) []string {
 	primary = strings.TrimSpace(primary)
 	secondary = strings.TrimSpace(secondary)
@@ -876,6 +1662,44 @@ func fixtureToDTO(ctx context.Context, v fixture.Fixture, teamLogoByID map[strin
 	}
 }
 
+func fixtureTeamsToDTO(ctx context.Context, v fixture.Fixture, teamsByID map[string]team.Team) *fixtureTeamsDTO {
+	ctx, span := startSpan(ctx, "httpapi.fixtureTeamsToDTO")
+	defer span.End()
+
+	out := &fixtureTeamsDTO{}
+	if home, ok := teamsByID[v.HomeTeamID]; ok {
+		dto := teamToDTO(ctx, home)
+		out.Home = &dto
+	}
+	if away, ok := teamsByID[v.AwayTeamID]; ok {
+		dto := teamToDTO(ctx, away)
+		out.Away = &dto
+	}
+	return out
+}
+
+// nextFixturesToDTO describes each fixture from the side of teamID.
+func nextFixturesToDTO(teamID string, fixtures []fixture.Fixture) []nextFixtureDTO {
+	out := make([]nextFixtureDTO, 0, len(fixtures))
+	for _, item := range fixtures {
+		isHome := item.HomeTeamID == teamID
+		opponentID, opponentName := item.AwayTeamID, item.AwayTeam
+		if !isHome {
+			opponentID, opponentName = item.HomeTeamID, item.HomeTeam
+		}
+		out = append(out, nextFixtureDTO{
+			FixtureID:    item.ID,
+			Gameweek:     item.Gameweek,
+			KickoffAt:    item.KickoffAt.UTC().Format(time.RFC3339),
+			OpponentID:   opponentID,
+			OpponentName: opponentName,
+			IsHome:       isHome,
+			Status:       fixture.NormalizeStatus(item.Status),
+		})
+	}
+	return out
+}
+
 func lineupToDTO(ctx context.Context, item lineup.Lineup) lineupDTO {
 	ctx, span := startSpan(ctx, "httpapi.lineupToDTO")
 	defer span.End()
@@ -0,0 +1,174 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statcorrection"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+func (h *Handler) AdminApplyStatCorrection(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.AdminApplyStatCorrection")
+	defer span.End()
+
+	principal, ok := principalFromContext(ctx)
+	if !ok {
+		writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+		return
+	}
+	if h.statCorrectionService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: stat correction service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	var req statCorrectionRequest
+	decoder := sonic.ConfigDefault.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(ctx, w, fmt.Errorf("%w: invalid JSON payload: %v", usecase.ErrInvalidInput, err))
+		return
+	}
+	if err := h.validateRequest(ctx, req); err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	leagueID := r.PathValue("leagueID")
+	fixtureID := r.PathValue("fixtureID")
+	input := usecase.ApplyStatCorrectionInput{
+		ActorUserID: principal.UserID,
+		LeagueID:    leagueID,
+		FixtureID:   fixtureID,
+		Reason:      req.Reason,
+		Players:     make([]usecase.PlayerStatCorrection, 0, len(req.Players)),
+	}
+	for _, item := range req.Players {
+		input.Players = append(input.Players, usecase.PlayerStatCorrection{
+			PlayerID:      item.PlayerID,
+			MinutesPlayed: item.MinutesPlayed,
+			Goals:         item.Goals,
+			Assists:       item.Assists,
+			CleanSheet:    item.CleanSheet,
+			YellowCards:   item.YellowCards,
+			RedCards:      item.RedCards,
+			Saves:         item.Saves,
+			BonusPoints:   item.BonusPoints,
+			FantasyPoints: item.FantasyPoints,
+		})
+	}
+
+	item, err := h.statCorrectionService.Apply(ctx, input)
+	if err != nil {
+		h.logger.WarnContext(ctx, "admin apply stat correction failed", "user_id", principal.UserID, "league_id", leagueID, "fixture_id", fixtureID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	writeSuccess(ctx, w, http.StatusCreated, statCorrectionToDTO(ctx, item))
+}
+
+func (h *Handler) AdminListStatCorrections(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.AdminListStatCorrections")
+	defer span.End()
+
+	if h.statCorrectionService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: stat correction service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := r.PathValue("leagueID")
+	fixtureID := r.PathValue("fixtureID")
+	items, err := h.statCorrectionService.ListByFixture(ctx, leagueID, fixtureID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "admin list stat corrections failed", "league_id", leagueID, "fixture_id", fixtureID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	out := make([]statCorrectionDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, statCorrectionToDTO(ctx, item))
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+func (h *Handler) AdminRescoreStatCorrections(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.AdminRescoreStatCorrections")
+	defer span.End()
+
+	if h.statCorrectionService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: stat correction service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := r.PathValue("leagueID")
+	items, err := h.statCorrectionService.RescorePending(ctx, leagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "admin rescore stat corrections failed", "league_id", leagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	out := make([]statCorrectionDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, statCorrectionToDTO(ctx, item))
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+func statCorrectionToDTO(ctx context.Context, item statcorrection.Correction) statCorrectionDTO {
+	_, span := startSpan(ctx, "httpapi.statCorrectionToDTO")
+	defer span.End()
+
+	dto := statCorrectionDTO{
+		ID:         item.ID,
+		LeagueID:   item.LeagueID,
+		FixtureID:  item.FixtureID,
+		Gameweek:   item.Gameweek,
+		Reason:     item.Reason,
+		CreatedBy:  item.CreatedBy,
+		TraceID:    item.TraceID,
+		Players:    make([]statCorrectionPlayerDTO, 0, len(item.Players)),
+		UserPoints: make([]statCorrectionUserPointsDTO, 0, len(item.UserPoints)),
+	}
+	for _, change := range item.Players {
+		dto.Players = append(dto.Players, statCorrectionPlayerDTO{
+			PlayerID: change.PlayerID,
+			Before:   correctedFixtureStatToDTO(change.Before),
+			After:    correctedFixtureStatToDTO(change.After),
+		})
+	}
+	for _, change := range item.UserPoints {
+		dto.UserPoints = append(dto.UserPoints, statCorrectionUserPointsDTO{
+			UserID: change.UserID,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+	if item.RescoredAt != nil {
+		dto.RescoredAtUTC = item.RescoredAt.UTC().Format(time.RFC3339)
+	}
+	if !item.CreatedAt.IsZero() {
+		dto.CreatedAtUTC = item.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	return dto
+}
+
+func correctedFixtureStatToDTO(item playerstats.FixtureStat) correctedFixtureStatDTO {
+	return correctedFixtureStatDTO{
+		MinutesPlayed: item.MinutesPlayed,
+		Goals:         item.Goals,
+		Assists:       item.Assists,
+		CleanSheet:    item.CleanSheet,
+		YellowCards:   item.YellowCards,
+		RedCards:      item.RedCards,
+		Saves:         item.Saves,
+		BonusPoints:   item.BonusPoints,
+		FantasyPoints: item.FantasyPoints,
+	}
+}
@@ -0,0 +1,265 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+// ListStatTypes returns the provider stat type catalog grouped by stat group,
+// optionally limited to one model type.
+func (h *Handler) ListStatTypes(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListStatTypes")
+	defer span.End()
+
+	if h.statValueService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: stat value service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	groups, err := h.statValueService.ListTypeGroups(ctx, r.URL.Query().Get("model_type"))
+	if err != nil {
+		h.logger.WarnContext(ctx, "list stat types failed", "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	items := make([]statTypeGroupDTO, 0, len(groups))
+	for _, group := range groups {
+		types := make([]statTypeDTO, 0, len(group.Types))
+		for _, item := range group.Types {
+			types = append(types, statTypeDTO{
+				ID:            item.ExternalTypeID,
+				Name:          item.Name,
+				DeveloperName: item.DeveloperName,
+				Code:          item.Code,
+				ModelType:     item.ModelType,
+			})
+		}
+		items = append(items, statTypeGroupDTO{Group: group.Group, Types: types})
+	}
+
+	writeSuccess(ctx, w, http.StatusOK, items)
+}
+
+func (h *Handler) ListPlayerStatValues(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListPlayerStatValues")
+	defer span.End()
+
+	if h.statValueService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: stat value service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := strings.TrimSpace(r.PathValue("leagueID"))
+	playerID := strings.TrimSpace(r.PathValue("playerID"))
+	seasonRefID, err := parseSeasonRefIDQuery(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	items, err := h.statValueService.ListPlayerValues(ctx, leagueID, playerID, seasonRefID, r.URL.Query().Get("scope"))
+	if err != nil {
+		h.logger.WarnContext(ctx, "list player stat values failed", "league_id", leagueID, "player_id", playerID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	out := make([]statValueDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, statValueToDTO(item.StatKey, item.StatTypeExternalID, item.Scope, item.SeasonRefID, item.ValueNum, item.ValueText))
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+func (h *Handler) ListTeamStatValues(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListTeamStatValues")
+	defer span.End()
+
+	if h.statValueService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: stat value service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	leagueID := strings.TrimSpace(r.PathValue("leagueID"))
+	teamID := strings.TrimSpace(r.PathValue("teamID"))
+	seasonRefID, err := parseSeasonRefIDQuery(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	items, err := h.statValueService.ListTeamValues(ctx, leagueID, teamID, seasonRefID, r.URL.Query().Get("scope"))
+	if err != nil {
+		h.logger.WarnContext(ctx, "list team stat values failed", "league_id", leagueID, "team_id", teamID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	out := make([]statValueDTO, 0, len(items))
+	for _, item := range items {
+		out = append(out, statValueToDTO(item.StatKey, item.StatTypeExternalID, item.Scope, item.SeasonRefID, item.ValueNum, item.ValueText))
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+// ListPlayerStatLeaders ranks the league players by one provider stat key.
+func (h *Handler) ListPlayerStatLeaders(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListPlayerStatLeaders")
+	defer span.End()
+
+	if h.statValueService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: stat value service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	query, err := parseStatLeadersQuery(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	items, err := h.statValueService.RankPlayers(ctx, query)
+	if err != nil {
+		h.logger.WarnContext(ctx, "rank player stat values failed", "league_id", query.LeagueID, "stat", query.StatKey, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	playerByID, teamNameByID, err := h.leaguePlayersAndTeamNames(ctx, query.LeagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list players failed while mapping stat leaders", "league_id", query.LeagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	out := make([]playerStatLeaderDTO, 0, len(items))
+	for idx, item := range items {
+		leader := playerStatLeaderDTO{
+			Rank:     idx + 1,
+			PlayerID: item.PlayerID,
+			Value:    derefFloat(item.ValueNum),
+		}
+		if p, ok := playerByID[item.PlayerID]; ok {
+			leader.Name = p.Name
+			leader.Club = teamNameByID[p.TeamID]
+			leader.Position = string(p.Position)
+		}
+		out = append(out, leader)
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+// ListTeamStatLeaders ranks the league teams by one provider stat key.
+func (h *Handler) ListTeamStatLeaders(w http.ResponseWriter, r *http.Request) {
+	ctx, span := startSpan(r.Context(), "httpapi.Handler.ListTeamStatLeaders")
+	defer span.End()
+
+	if h.statValueService == nil {
+		writeError(ctx, w, fmt.Errorf("%w: stat value service is not configured", usecase.ErrDependencyUnavailable))
+		return
+	}
+
+	query, err := parseStatLeadersQuery(r)
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	items, err := h.statValueService.RankTeams(ctx, query)
+	if err != nil {
+		h.logger.WarnContext(ctx, "rank team stat values failed", "league_id", query.LeagueID, "stat", query.StatKey, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+
+	teams, err := h.leagueService.ListTeamsByLeague(ctx, query.LeagueID)
+	if err != nil {
+		h.logger.WarnContext(ctx, "list teams failed while mapping stat leaders", "league_id", query.LeagueID, "error", err)
+		writeError(ctx, w, err)
+		return
+	}
+	teamNameByID := make(map[string]string, len(teams))
+	for _, t := range teams {
+		teamNameByID[t.ID] = t.Name
+	}
+
+	out := make([]teamStatLeaderDTO, 0, len(items))
+	for idx, item := range items {
+		out = append(out, teamStatLeaderDTO{
+			Rank:   idx + 1,
+			TeamID: item.TeamID,
+			Name:   teamNameByID[item.TeamID],
+			Value:  derefFloat(item.ValueNum),
+		})
+	}
+	writeSuccess(ctx, w, http.StatusOK, out)
+}
+
+func parseStatLeadersQuery(r *http.Request) (usecase.StatLeadersQuery, error) {
+	query := usecase.StatLeadersQuery{
+		LeagueID: strings.TrimSpace(r.PathValue("leagueID")),
+		StatKey:  strings.TrimSpace(r.URL.Query().Get("stat")),
+		Scope:    strings.TrimSpace(r.URL.Query().Get("scope")),
+	}
+	if query.StatKey == "" {
+		return usecase.StatLeadersQuery{}, fmt.Errorf("%w: stat is required", usecase.ErrInvalidInput)
+	}
+
+	seasonRefID, err := parseSeasonRefIDQuery(r)
+	if err != nil {
+		return usecase.StatLeadersQuery{}, err
+	}
+	query.SeasonRefID = seasonRefID
+
+	switch order := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("order"))); order {
+	case "", "desc":
+	case "asc":
+		query.Ascending = true
+	default:
+		return usecase.StatLeadersQuery{}, fmt.Errorf("%w: order must be asc or desc", usecase.ErrInvalidInput)
+	}
+
+	if raw := strings.TrimSpace(r.URL.Query().Get("limit")); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			return usecase.StatLeadersQuery{}, fmt.Errorf("%w: limit must be positive integer", usecase.ErrInvalidInput)
+		}
+		query.Limit = v
+	}
+	return query, nil
+}
+
+func parseSeasonRefIDQuery(r *http.Request) (int64, error) {
+	raw := strings.TrimSpace(r.URL.Query().Get("season_ref_id"))
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("%w: season_ref_id must be positive integer", usecase.ErrInvalidInput)
+	}
+	return v, nil
+}
+
+func statValueToDTO(statKey string, statTypeID int64, scope string, seasonRefID int64, value *float64, valueText string) statValueDTO {
+	return statValueDTO{
+		StatKey:     statKey,
+		StatTypeID:  statTypeID,
+		Scope:       scope,
+		SeasonRefID: seasonRefID,
+		Value:       value,
+		ValueText:   valueText,
+	}
+}
+
+func derefFloat(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
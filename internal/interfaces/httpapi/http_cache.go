@@ -0,0 +1,131 @@
+package httpapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HTTPCacheConfig holds the Cache-Control max-age of each public route class.
+// A zero max-age makes clients revalidate with If-None-Match on every request.
+type HTTPCacheConfig struct {
+	// Live covers live standings and fixture scores and events.
+	Live time.Duration
+	// Standard covers reads that change when a sync runs.
+	Standard time.Duration
+	// Catalog covers leagues, teams and stat types.
+	Catalog time.Duration
+}
+
+type routeCachePolicies struct {
+	live     string
+	standard string
+	catalog  string
+}
+
+func newRouteCachePolicies(cfg HTTPCacheConfig) routeCachePolicies {
+	return routeCachePolicies{
+		live:     cacheControlValue(cfg.Live),
+		standard: cacheControlValue(cfg.Standard),
+		catalog:  cacheControlValue(cfg.Catalog),
+	}
+}
+
+func cacheControlValue(maxAge time.Duration) string {
+	seconds := int64(maxAge / time.Second)
+	if seconds <= 0 {
+		return "public, no-cache"
+	}
+	return "public, max-age=" + strconv.FormatInt(seconds, 10)
+}
+
+// Cacheable tags successful GET responses with an ETag hashed from the body
+// and the route's Cache-Control, and answers 304 when If-None-Match already
+// holds that ETag. The body is buffered, so it only wraps public reads.
+func Cacheable(cacheControl string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, span := startSpan(r.Context(), "httpapi.Cacheable")
+		defer span.End()
+
+		buffer := &bufferedResponse{header: w.Header()}
+		next.ServeHTTP(buffer, r.WithContext(ctx))
+
+		status := buffer.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			_, _ = w.Write(buffer.body.Bytes())
+			return
+		}
+
+		etag := contentETag(buffer.body.Bytes())
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", cacheControl)
+		if etagMatches(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write(buffer.body.Bytes())
+	})
+}
+
+// contentETag is weak because the CDN may re-encode the body.
+func contentETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches applies the weak comparison If-None-Match calls for.
+func etagMatches(ifNoneMatch, etag string) bool {
+	ifNoneMatch = strings.TrimSpace(ifNoneMatch)
+	if ifNoneMatch == "" {
+		return false
+	}
+	if ifNoneMatch == "*" {
+		return true
+	}
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == want {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponse holds the status and body until the ETag is known.
+// Headers go straight to the real writer.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if b.status == 0 {
+		b.status = status
+	}
+}
+
+func (b *bufferedResponse) Write(body []byte) (int, error) {
+	if b.status == 0 {
+		b.status = http.StatusOK
+	}
+	return b.body.Write(body)
+}
@@ -0,0 +1,60 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCacheable(t *testing.T) {
+	status := http.StatusOK
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeSuccess(r.Context(), w, status, map[string]int{"b": 2, "a": 1})
+	})
+	handler := Cacheable(cacheControlValue(time.Minute), next)
+
+	send := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/leagues/idn-liga-1-2025/players", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := send("")
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || first.Body.Len() == 0 {
+		t.Fatalf("expected tagged 200, got %d etag=%q", first.Code, etag)
+	}
+	if got := first.Header().Get("Cache-Control"); got != "public, max-age=60" {
+		t.Fatalf("unexpected Cache-Control %q", got)
+	}
+	if again := send(""); again.Header().Get("ETag") != etag {
+		t.Fatalf("expected identical data to keep its ETag")
+	}
+
+	notModified := send(`"other", ` + etag[2:])
+	if notModified.Code != http.StatusNotModified || notModified.Body.Len() != 0 || notModified.Header().Get("ETag") != etag {
+		t.Fatalf("expected 304 for matching If-None-Match, got %d", notModified.Code)
+	}
+	if rec := send(`W/"other"`); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 for stale If-None-Match, got %d", rec.Code)
+	}
+
+	status = http.StatusNotFound
+	if rec := send(etag); rec.Code != http.StatusNotFound || rec.Header().Get("ETag") != "" || rec.Header().Get("Cache-Control") != "" {
+		t.Fatalf("expected errors to pass through untagged, got %d", rec.Code)
+	}
+}
+
+func TestCacheControlValue(t *testing.T) {
+	if got := cacheControlValue(0); got != "public, no-cache" {
+		t.Fatalf("expected no-cache for zero max-age, got %q", got)
+	}
+	if got := cacheControlValue(time.Hour); got != "public, max-age=3600" {
+		t.Fatalf("unexpected value %q", got)
+	}
+}
@@ -0,0 +1,174 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotentRequestBytes = 1 << 20
+
+	// internalJobIdempotencyScope stands in for the user of internal job
+	// routes, which are authorized by a shared token rather than a principal.
+	internalJobIdempotencyScope = "internal-job"
+)
+
+// RequireIdempotency answers a POST or PUT retried with the same
+// Idempotency-Key from the stored response instead of running it again. Keys
+// are scoped to the principal, so it must run inside RequireAuth. Requests
+// without the header pass through unchanged.
+func RequireIdempotency(service *usecase.IdempotencyService, logger *logging.Logger, next http.Handler) http.Handler {
+	return requireIdempotency(service, logger, principalIdempotencyScope, next)
+}
+
+// RequireJobIdempotency is RequireIdempotency for internal job routes. Their
+// keys share one scope, so it must run inside RequireInternalJobToken.
+func RequireJobIdempotency(service *usecase.IdempotencyService, logger *logging.Logger, next http.Handler) http.Handler {
+	return requireIdempotency(service, logger, func(context.Context) (string, bool) {
+		return internalJobIdempotencyScope, true
+	}, next)
+}
+
+func principalIdempotencyScope(ctx context.Context) (string, bool) {
+	principal, ok := principalFromContext(ctx)
+	return principal.UserID, ok
+}
+
+func requireIdempotency(service *usecase.IdempotencyService, logger *logging.Logger, scope func(context.Context) (string, bool), next http.Handler) http.Handler {
+	if service == nil {
+		return next
+	}
+	if logger == nil {
+		logger = logging.Default()
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if strings.TrimSpace(key) == "" || (r.Method != http.MethodPost && r.Method != http.MethodPut) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, span := startSpan(r.Context(), "httpapi.RequireIdempotency")
+		defer span.End()
+
+		scopeID, ok := scope(ctx)
+		if !ok {
+			writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxIdempotentRequestBytes+1))
+		if err != nil {
+			writeError(ctx, w, fmt.Errorf("%w: read request body: %v", usecase.ErrInvalidInput, err))
+			return
+		}
+		if len(body) > maxIdempotentRequestBytes {
+			writeError(ctx, w, fmt.Errorf("%w: request body is too large for an idempotent request", usecase.ErrInvalidInput))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		record, replay, err := service.Begin(ctx, usecase.BeginIdempotentRequestInput{
+			UserID:      scopeID,
+			Key:         key,
+			Method:      r.Method,
+			Path:        r.URL.Path,
+			RequestHash: idempotentRequestHash(r, body),
+		})
+		if err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+		if replay {
+			if record.ContentType != "" {
+				w.Header().Set("Content-Type", record.ContentType)
+			}
+			w.Header().Set(idempotentReplayedHeader, "true")
+			w.WriteHeader(record.StatusCode)
+			_, _ = w.Write(record.Body)
+			return
+		}
+
+		release := func(reason string) {
+			if err := service.Release(context.WithoutCancel(ctx), record); err != nil {
+				logger.WarnContext(ctx, "release idempotency key failed", "user_id", scopeID, "reason", reason, "error", err)
+			}
+		}
+		// A panicking handler must not leave the key reserved until it
+		// expires; the panic is passed on once the key is free again.
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				release("panic")
+				panic(recovered)
+			}
+		}()
+
+		capture := &responseCapture{ResponseWriter: w}
+		next.ServeHTTP(capture, r.WithContext(ctx))
+
+		status := capture.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		// Server errors are not stored so the client can retry them.
+		if status >= http.StatusInternalServerError {
+			release("server error")
+			return
+		}
+		record.StatusCode = status
+		record.ContentType = capture.Header().Get("Content-Type")
+		record.Body = capture.body.Bytes()
+		if err := service.Complete(ctx, record); err != nil {
+			logger.WarnContext(ctx, "store idempotent response failed", "user_id", scopeID, "error", err)
+			release("store failed")
+		}
+	})
+}
+
+// idempotentRequestHash covers the method, path with query and body, so a
+// key reused for another route or payload is detected.
+func idempotentRequestHash(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseCapture keeps a copy of the status and body written by the
+// handler.
+type responseCapture struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (c *responseCapture) WriteHeader(status int) {
+	if c.status == 0 {
+		c.status = status
+	}
+	c.ResponseWriter.WriteHeader(status)
+}
+
+func (c *responseCapture) Write(body []byte) (int, error) {
+	if c.status == 0 {
+		c.status = http.StatusOK
+	}
+	c.body.Write(body)
+	return c.ResponseWriter.Write(body)
+}
+
+func (c *responseCapture) Unwrap() http.ResponseWriter {
+	return c.ResponseWriter
+}
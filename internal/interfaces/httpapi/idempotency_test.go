@@ -0,0 +1,167 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/idempotency"
+	"github.com/riskibarqy/fantasy-league/internal/domain/user"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+type memoryIdempotencyRepository struct {
+	records     map[string]idempotency.Record
+	completeErr error
+}
+
+func (r *memoryIdempotencyRepository) Reserve(_ context.Context, record idempotency.Record) (idempotency.Record, bool, error) {
+	if existing, ok := r.records[record.UserID+"|"+record.Key]; ok {
+		return existing, false, nil
+	}
+	r.records[record.UserID+"|"+record.Key] = record
+	return record, true, nil
+}
+
+func (r *memoryIdempotencyRepository) Complete(_ context.Context, record idempotency.Record) error {
+	if r.completeErr != nil {
+		return r.completeErr
+	}
+	r.records[record.UserID+"|"+record.Key] = record
+	return nil
+}
+
+func (r *memoryIdempotencyRepository) Release(_ context.Context, userID, key string) error {
+	delete(r.records, userID+"|"+key)
+	return nil
+}
+
+func (r *memoryIdempotencyRepository) DeleteExpired(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestRequireIdempotency(t *testing.T) {
+	repo := &memoryIdempotencyRepository{records: make(map[string]idempotency.Record)}
+	service := usecase.NewIdempotencyService(repo, time.Hour, nil)
+
+	calls := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"group-1"}`))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	handler := RequireIdempotency(service, nil, next)
+
+	send := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/custom-leagues", strings.NewReader(body))
+		req = req.WithContext(withPrincipal(req.Context(), user.Principal{UserID: "u-1"}))
+		if key != "" {
+			req.Header.Set(idempotencyKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := send("key-1", `{"name":"Kantor"}`); rec.Code != http.StatusCreated {
+		t.Fatalf("expected first request to run, got %d", rec.Code)
+	}
+	rec := send("key-1", `{"name":"Kantor"}`)
+	if rec.Code != http.StatusCreated || rec.Body.String() != `{"id":"group-1"}` || rec.Header().Get(idempotentReplayedHeader) != "true" {
+		t.Fatalf("expected stored response to be replayed, got %d %q", rec.Code, rec.Body.String())
+	}
+	if rec := send("key-1", `{"name":"Rumah"}`); rec.Code != http.StatusConflict {
+		t.Fatalf("expected reused key with different body to conflict, got %d", rec.Code)
+	}
+	if calls != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", calls)
+	}
+
+	// Server errors release the key so the client can retry.
+	if rec := send("key-2", `{}`); rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected server error, got %d", rec.Code)
+	}
+	if _, ok := repo.records["u-1|key-2"]; ok {
+		t.Fatalf("expected failed request to release its key")
+	}
+	if rec := send("", `{}`); rec.Code != http.StatusInternalServerError || calls != 3 {
+		t.Fatalf("expected request without key to pass through, got %d after %d calls", rec.Code, calls)
+	}
+}
+
+func TestRequireIdempotency_ReleasesKeyOnPanicAndFailedStore(t *testing.T) {
+	repo := &memoryIdempotencyRepository{records: make(map[string]idempotency.Record)}
+	service := usecase.NewIdempotencyService(repo, time.Hour, nil)
+	send := func(handler http.Handler, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/custom-leagues", strings.NewReader(`{}`))
+		req = req.WithContext(withPrincipal(req.Context(), user.Principal{UserID: "u-1"}))
+		req.Header.Set(idempotencyKeyHeader, key)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	panicking := RequireIdempotency(service, nil, http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}))
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != "boom" {
+				t.Fatalf("expected the panic to be passed on, got %v", recovered)
+			}
+		}()
+		send(panicking, "key-panic")
+	}()
+	if _, ok := repo.records["u-1|key-panic"]; ok {
+		t.Fatalf("expected panicking request to release its key")
+	}
+
+	repo.completeErr = errors.New("database is down")
+	created := RequireIdempotency(service, nil, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	if rec := send(created, "key-store"); rec.Code != http.StatusCreated {
+		t.Fatalf("expected request to run, got %d", rec.Code)
+	}
+	if _, ok := repo.records["u-1|key-store"]; ok {
+		t.Fatalf("expected key to be released when the response could not be stored")
+	}
+}
+
+func TestRequireJobIdempotency(t *testing.T) {
+	repo := &memoryIdempotencyRepository{records: make(map[string]idempotency.Record)}
+	service := usecase.NewIdempotencyService(repo, time.Hour, nil)
+
+	calls := 0
+	handler := RequireJobIdempotency(service, nil, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"count":3}`))
+	}))
+	send := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/internal/jobs/ownership", strings.NewReader(`{"league_id":"idn-liga-1-2025","gameweek":4}`))
+		req.Header.Set(idempotencyKeyHeader, "ownership-gw-4")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := send(); rec.Code != http.StatusOK {
+		t.Fatalf("expected job to run without a principal, got %d", rec.Code)
+	}
+	if rec := send(); rec.Header().Get(idempotentReplayedHeader) != "true" || calls != 1 {
+		t.Fatalf("expected retried job to be replayed, ran %d times", calls)
+	}
+	if _, ok := repo.records[internalJobIdempotencyScope+"|ownership-gw-4"]; !ok {
+		t.Fatalf("expected key stored under the internal job scope, got %v", repo.records)
+	}
+}
@@ -3,14 +3,19 @@ package httpapi
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/riskibarqy/fantasy-league/internal/domain/user"
+	"github.com/riskibarqy/fantasy-league/internal/platform/metrics"
 	"github.com/riskibarqy/fantasy-league/internal/usecase"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/attribute"
@@ -49,6 +54,33 @@ func RequireAuth(verifier TokenVerifier, next http.Handler) http.Handler {
 	})
 }
 
+// RequireAdmin allows only the configured admin user ids. It runs after
+// RequireAuth, which puts the principal in the context.
+func RequireAdmin(adminUserIDs []string, next http.Handler) http.Handler {
+	admins := make(map[string]struct{}, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			admins[id] = struct{}{}
+		}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := startSpan(r.Context(), "httpapi.RequireAdmin")
+		defer span.End()
+
+		principal, ok := principalFromContext(ctx)
+		if !ok {
+			writeError(ctx, w, fmt.Errorf("%w: principal is missing from request context", usecase.ErrUnauthorized))
+			return
+		}
+		if _, ok := admins[principal.UserID]; !ok {
+			writeError(ctx, w, fmt.Errorf("%w: user %s is not an admin", usecase.ErrForbidden, principal.UserID))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func RequireInternalJobToken(token string, next http.Handler) http.Handler {
 	expectedToken := strings.TrimSpace(token)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -70,6 +102,64 @@ func RequireInternalJobToken(token string, next http.Handler) http.Handler {
 	})
 }
 
+const (
+	maxWebhookBodyBytes = 1 << 20
+	// webhookMaxSkew bounds how far X-Anubis-Timestamp may be from now, so a
+	// captured request cannot be replayed later.
+	webhookMaxSkew = 5 * time.Minute
+)
+
+// RequireWebhookSignature accepts requests whose X-Anubis-Signature header is
+// the hex HMAC-SHA256 under secret of X-Anubis-Timestamp (unix seconds), a dot
+// and the raw body, optionally prefixed with "sha256=". Timestamps more than
+// webhookMaxSkew away from now are rejected. The body is handed on unchanged.
+func RequireWebhookSignature(secret string, next http.Handler) http.Handler {
+	key := []byte(strings.TrimSpace(secret))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := startSpan(r.Context(), "httpapi.RequireWebhookSignature")
+		defer span.End()
+
+		if len(key) == 0 {
+			writeError(ctx, w, fmt.Errorf("%w: webhook secret is not configured", usecase.ErrDependencyUnavailable))
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodyBytes+1))
+		if err != nil {
+			writeError(ctx, w, fmt.Errorf("%w: read webhook body: %v", usecase.ErrInvalidInput, err))
+			return
+		}
+		if len(body) > maxWebhookBodyBytes {
+			writeError(ctx, w, fmt.Errorf("%w: webhook body is too large", usecase.ErrInvalidInput))
+			return
+		}
+
+		timestamp := strings.TrimSpace(r.Header.Get("X-Anubis-Timestamp"))
+		sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			writeError(ctx, w, fmt.Errorf("%w: invalid webhook timestamp", usecase.ErrUnauthorized))
+			return
+		}
+		if skew := time.Since(time.Unix(sentAt, 0)); skew > webhookMaxSkew || skew < -webhookMaxSkew {
+			writeError(ctx, w, fmt.Errorf("%w: webhook timestamp is outside the accepted window", usecase.ErrUnauthorized))
+			return
+		}
+
+		provided := strings.TrimPrefix(strings.TrimSpace(r.Header.Get("X-Anubis-Signature")), "sha256=")
+		signature, err := hex.DecodeString(provided)
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(body)
+		if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+			writeError(ctx, w, fmt.Errorf("%w: invalid webhook signature", usecase.ErrUnauthorized))
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func RequestLogging(logger *logging.Logger, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx, span := startSpan(r.Context(), "httpapi.RequestLogging")
@@ -88,6 +178,63 @@ func RequestLogging(logger *logging.Logger, next http.Handler) http.Handler {
 	})
 }
 
+// RequestMetrics records request latency per ServeMux route pattern. It must
+// wrap the mux directly: the mux sets Pattern on the request it receives.
+func RequestMetrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w}
+		defer func() {
+			status := recorder.status
+			if status == 0 {
+				status = http.StatusInternalServerError
+			}
+			metrics.HTTPRequestDuration.
+				WithLabelValues(r.Method, routeLabel(r.Pattern), strconv.Itoa(status)).
+				Observe(time.Since(started).Seconds())
+		}()
+		next.ServeHTTP(recorder, r)
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
+		}
+	})
+}
+
+// routeLabel drops the method from a pattern such as "GET /v1/leagues/{id}".
+// Requests matching no route share one label value.
+func routeLabel(pattern string) string {
+	if pattern == "" {
+		return "unmatched"
+	}
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		return path
+	}
+	return pattern
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(body []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(body)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 func RequestTracing(next http.Handler) http.Handler {
 	return otelhttp.NewHandler(next, "fantasy-league-http",
 		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
@@ -237,7 +384,8 @@ func CORS(allowedOrigins []string, next http.Handler) http.Handler {
 				w.Header().Add("Vary", "Origin")
 			}
 			w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Authorization,Content-Type,Accept")
+			w.Header().Set("Access-Control-Allow-Headers", "Authorization,Content-Type,Accept,If-None-Match,"+idempotencyKeyHeader)
+			w.Header().Set("Access-Control-Expose-Headers", "Retry-After,ETag,"+nextCursorHeader+","+idempotentReplayedHeader)
 			w.Header().Set("Access-Control-Max-Age", "600")
 		}
 
@@ -0,0 +1,39 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/user"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := RequireAdmin([]string{"admin-1", " "}, next)
+
+	cases := []struct {
+		name   string
+		userID string
+		want   int
+	}{
+		{name: "admin", userID: "admin-1", want: http.StatusNoContent},
+		{name: "other user", userID: "user-2", want: http.StatusForbidden},
+		{name: "no principal", want: http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/v1/admin/leagues", nil)
+		if tc.userID != "" {
+			req = req.WithContext(withPrincipal(req.Context(), user.Principal{UserID: tc.userID}))
+		}
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != tc.want {
+			t.Fatalf("%s: expected status %d, got %d", tc.name, tc.want, rec.Code)
+		}
+	}
+}
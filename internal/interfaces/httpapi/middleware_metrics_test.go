@@ -0,0 +1,41 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/riskibarqy/fantasy-league/internal/platform/metrics"
+)
+
+func TestRequestMetrics_LabelsByRoutePattern(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/metrics-test/{leagueID}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	handler := RequestMetrics(mux)
+
+	for _, leagueID := range []string{"idn-liga-1-2025", "eng-premier-2025"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/metrics-test/"+leagueID, nil))
+	}
+
+	observer := metrics.HTTPRequestDuration.WithLabelValues(http.MethodGet, "/v1/metrics-test/{leagueID}", "202")
+	var out dto.Metric
+	if err := observer.(prometheus.Metric).Write(&out); err != nil {
+		t.Fatalf("write metric: %v", err)
+	}
+	if got := out.GetHistogram().GetSampleCount(); got != 2 {
+		t.Fatalf("expected 2 observations for the route pattern, got %d", got)
+	}
+}
+
+func TestRouteLabel(t *testing.T) {
+	if got := routeLabel(""); got != "unmatched" {
+		t.Fatalf("expected unmatched label, got %q", got)
+	}
+	if got := routeLabel("POST /v1/fantasy/squads"); got != "/v1/fantasy/squads" {
+		t.Fatalf("expected method to be dropped, got %q", got)
+	}
+}
@@ -0,0 +1,67 @@
+package httpapi
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequireWebhookSignature(t *testing.T) {
+	const body = `{"event":"account.deleted","user_id":"u-1"}`
+	sign := func(timestamp string) string {
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write([]byte(timestamp + "." + body))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+	signature := sign(now)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ := io.ReadAll(r.Body)
+		if string(got) != body {
+			t.Errorf("expected body to be passed through, got %q", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	cases := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		want      int
+	}{
+		{name: "valid", secret: "secret", timestamp: now, signature: signature, want: http.StatusNoContent},
+		{name: "prefixed", secret: "secret", timestamp: now, signature: "sha256=" + signature, want: http.StatusNoContent},
+		{name: "wrong signature", secret: "secret", timestamp: now, signature: strings.Repeat("0", len(signature)), want: http.StatusUnauthorized},
+		{name: "missing signature", secret: "secret", timestamp: now, want: http.StatusUnauthorized},
+		{name: "missing timestamp", secret: "secret", signature: signature, want: http.StatusUnauthorized},
+		{name: "timestamp not signed", secret: "secret", timestamp: strconv.FormatInt(time.Now().Unix()-1, 10), signature: signature, want: http.StatusUnauthorized},
+		{name: "stale", secret: "secret", timestamp: stale, signature: sign(stale), want: http.StatusUnauthorized},
+		{name: "unconfigured", timestamp: now, signature: signature, want: http.StatusServiceUnavailable},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/v1/webhooks/anubis", strings.NewReader(body))
+		if tc.timestamp != "" {
+			req.Header.Set("X-Anubis-Timestamp", tc.timestamp)
+		}
+		if tc.signature != "" {
+			req.Header.Set("X-Anubis-Signature", tc.signature)
+		}
+		rec := httptest.NewRecorder()
+
+		RequireWebhookSignature(tc.secret, next).ServeHTTP(rec, req)
+
+		if rec.Code != tc.want {
+			t.Fatalf("%s: expected status %d, got %d", tc.name, tc.want, rec.Code)
+		}
+	}
+}
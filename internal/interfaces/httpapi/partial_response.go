@@ -0,0 +1,172 @@
+package httpapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+const (
+	fieldsQueryParam  = "fields"
+	includeQueryParam = "include"
+)
+
+// includes is the set of relations a request asked to embed, in request
+// order and without duplicates.
+type includes []string
+
+func (i includes) has(name string) bool {
+	for _, item := range i {
+		if item == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIncludes reads the comma separated include parameter. A relation the
+// route cannot embed is rejected rather than silently dropped.
+func parseIncludes(r *http.Request, allowed ...string) (includes, error) {
+	raw := strings.TrimSpace(r.URL.Query().Get(includeQueryParam))
+	if raw == "" {
+		return nil, nil
+	}
+
+	out := make(includes, 0, len(allowed))
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == "" || out.has(item) {
+			continue
+		}
+		if !includes(allowed).has(item) {
+			return nil, fmt.Errorf("%w: include must be one of %s", usecase.ErrInvalidInput, strings.Join(allowed, ","))
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// parseFields reads the comma separated fields parameter together with the
+// keys of the included relations, which are always kept. It returns nil when
+// the request wants every field.
+func parseFields(r *http.Request) map[string]struct{} {
+	query := r.URL.Query()
+	raw := strings.TrimSpace(query.Get(fieldsQueryParam))
+	if raw == "" {
+		return nil
+	}
+
+	out := make(map[string]struct{})
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out[item] = struct{}{}
+		}
+	}
+	for _, item := range strings.Split(query.Get(includeQueryParam), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out[includeFieldName(item)] = struct{}{}
+		}
+	}
+	return out
+}
+
+// includeFieldName maps an include name such as season_stats to the key the
+// relation is embedded under, seasonStats.
+func includeFieldName(include string) string {
+	parts := strings.Split(strings.ToLower(include), "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// SparseFields trims the top-level keys of a successful response's data, or
+// of every item when data is a list, to the ones named in fields=. Wrap it
+// inside Cacheable so the ETag matches the trimmed body.
+func SparseFields(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields := parseFields(r)
+		if fields == nil || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, span := startSpan(r.Context(), "httpapi.SparseFields")
+		defer span.End()
+
+		buffer := &bufferedResponse{header: w.Header()}
+		next.ServeHTTP(buffer, r.WithContext(ctx))
+
+		status := buffer.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		body := buffer.body.Bytes()
+		if status == http.StatusOK {
+			if projected, ok := projectFields(body, fields); ok {
+				body = projected
+			}
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	})
+}
+
+// projectFields rewrites the response envelope with only the selected keys.
+// It reports false when data is neither an object nor a list of objects, and
+// the body is then sent as is.
+func projectFields(body []byte, fields map[string]struct{}) ([]byte, bool) {
+	var envelope struct {
+		APIVersion string          `json:"apiVersion"`
+		Data       json.RawMessage `json:"data"`
+	}
+	if err := responseJSON.Unmarshal(body, &envelope); err != nil {
+		return nil, false
+	}
+
+	var data any
+	switch raw := bytes.TrimSpace(envelope.Data); {
+	case len(raw) > 0 && raw[0] == '{':
+		var item map[string]json.RawMessage
+		if err := responseJSON.Unmarshal(raw, &item); err != nil {
+			return nil, false
+		}
+		data = selectFields(item, fields)
+	case len(raw) > 0 && raw[0] == '[':
+		var items []map[string]json.RawMessage
+		if err := responseJSON.Unmarshal(raw, &items); err != nil {
+			return nil, false
+		}
+		for i := range items {
+			items[i] = selectFields(items[i], fields)
+		}
+		data = items
+	default:
+		return nil, false
+	}
+
+	var out bytes.Buffer
+	if err := responseJSON.NewEncoder(&out).Encode(googleResponseEnvelope{
+		APIVersion: envelope.APIVersion,
+		Data:       data,
+	}); err != nil {
+		return nil, false
+	}
+	return out.Bytes(), true
+}
+
+func selectFields(item map[string]json.RawMessage, fields map[string]struct{}) map[string]json.RawMessage {
+	out := make(map[string]json.RawMessage, len(fields))
+	for key, value := range item {
+		if _, ok := fields[key]; ok {
+			out[key] = value
+		}
+	}
+	return out
+}
@@ -0,0 +1,81 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+)
+
+func TestParseIncludes(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/leagues/idn-liga-1-2025/players?include=team,%20Season_Stats,team", nil)
+	got, err := parseIncludes(req, usecase.IncludeTeam, usecase.IncludeSeasonStats, usecase.IncludeNextFixtures)
+	if err != nil {
+		t.Fatalf("parseIncludes error: %v", err)
+	}
+	if len(got) != 2 || !got.has(usecase.IncludeTeam) || !got.has(usecase.IncludeSeasonStats) || got.has(usecase.IncludeNextFixtures) {
+		t.Fatalf("unexpected includes: %v", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/leagues/idn-liga-1-2025/fixtures?include=season_stats", nil)
+	if _, err := parseIncludes(req, usecase.IncludeTeam); !errors.Is(err, usecase.ErrInvalidInput) {
+		t.Fatalf("expected invalid input for unsupported include, got %v", err)
+	}
+}
+
+func TestSparseFields(t *testing.T) {
+	var data any
+	status := http.StatusOK
+	handler := SparseFields(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeSuccess(r.Context(), w, status, data)
+	}))
+	send := func(query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/leagues/idn-liga-1-2025/players?"+query, nil))
+		return rec
+	}
+
+	data = []map[string]any{
+		{"id": "p-1", "name": "Simic", "price": 10.5, "seasonStats": map[string]int{"goals": 4}},
+		{"id": "p-2", "name": "Ciro", "price": 9},
+	}
+	rec := send("fields=id,%20price&include=season_stats")
+	want := `{"apiVersion":"2.0","data":[{"id":"p-1","price":10.5,"seasonStats":{"goals":4}},{"id":"p-2","price":9}]}` + "\n"
+	if rec.Code != http.StatusOK || rec.Body.String() != want {
+		t.Fatalf("unexpected projected list %d: %s", rec.Code, rec.Body.String())
+	}
+
+	data = map[string]any{"id": "f-1", "status": "FT", "venue": "GBK"}
+	if rec := send("fields=status"); rec.Body.String() != `{"apiVersion":"2.0","data":{"status":"FT"}}`+"\n" {
+		t.Fatalf("unexpected projected object: %s", rec.Body.String())
+	}
+
+	data = []string{"a", "b"}
+	if rec := send("fields=id"); rec.Body.String() != `{"apiVersion":"2.0","data":["a","b"]}`+"\n" {
+		t.Fatalf("expected data without objects to pass through, got %s", rec.Body.String())
+	}
+
+	data = map[string]any{"id": "f-1", "venue": "GBK"}
+	if rec := send(""); rec.Body.String() != `{"apiVersion":"2.0","data":{"id":"f-1","venue":"GBK"}}`+"\n" {
+		t.Fatalf("expected every field without fields=, got %s", rec.Body.String())
+	}
+
+	status = http.StatusBadRequest
+	if rec := send("fields=id"); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected errors to pass through, got %d", rec.Code)
+	}
+}
+
+func TestIncludeFieldName(t *testing.T) {
+	for include, want := range map[string]string{
+		"team":          "team",
+		"season_stats":  "seasonStats",
+		"next_fixtures": "nextFixtures",
+	} {
+		if got := includeFieldName(include); got != want {
+			t.Fatalf("includeFieldName(%q) = %q, want %q", include, got, want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package httpapi
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
+	"github.com/riskibarqy/fantasy-league/internal/usecase"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	rateLimitGroupPublic     = "public"
+	rateLimitGroupAuthorized = "authorized"
+	rateLimitGroupWrite      = "write"
+	rateLimitGroupInvite     = "invite"
+)
+
+var apiMeter = otel.Meter("fantasy-league/internal/interfaces/httpapi")
+
+// RateLimitRule is a token bucket: Burst requests at once, refilled at
+// RequestsPerSecond.
+type RateLimitRule struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// RateLimitConfig holds one rule per route group. Public routes are limited
+// per client IP, authorized routes per principal user ID.
+type RateLimitConfig struct {
+	Enabled bool
+	// Public covers unauthenticated league, team, player and fixture reads.
+	Public RateLimitRule
+	// Authorized covers authenticated reads.
+	Authorized RateLimitRule
+	// Write covers authenticated squad, lineup, onboarding and custom league writes.
+	Write RateLimitRule
+	// Invite covers joining a custom league by invite code.
+	Invite RateLimitRule
+}
+
+// RateLimiter throttles one route group.
+type RateLimiter struct {
+	group    string
+	buckets  *resilience.RateLimiter
+	rejected metric.Int64Counter
+}
+
+type routeRateLimiters struct {
+	public     *RateLimiter
+	authorized *RateLimiter
+	write      *RateLimiter
+	invite     *RateLimiter
+}
+
+func newRouteRateLimiters(cfg RateLimitConfig) routeRateLimiters {
+	if !cfg.Enabled {
+		return routeRateLimiters{}
+	}
+
+	rejected, err := apiMeter.Int64Counter(
+		"http.server.rate_limited",
+		metric.WithDescription("Requests rejected by the rate limiter"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return routeRateLimiters{
+		public:     NewRateLimiter(rateLimitGroupPublic, cfg.Public, rejected),
+		authorized: NewRateLimiter(rateLimitGroupAuthorized, cfg.Authorized, rejected),
+		write:      NewRateLimiter(rateLimitGroupWrite, cfg.Write, rejected),
+		invite:     NewRateLimiter(rateLimitGroupInvite, cfg.Invite, rejected),
+	}
+}
+
+// NewRateLimiter returns nil when the rule allows unlimited requests, which
+// makes the rate limit middleware a passthrough.
+func NewRateLimiter(group string, rule RateLimitRule, rejected metric.Int64Counter) *RateLimiter {
+	if rule.RequestsPerSecond <= 0 || rule.Burst <= 0 {
+		return nil
+	}
+
+	return &RateLimiter{
+		group:    group,
+		buckets:  resilience.NewRateLimiter(rule.RequestsPerSecond, rule.Burst),
+		rejected: rejected,
+	}
+}
+
+// RateLimitByIP limits requests per client IP. Use it for public routes.
+func RateLimitByIP(limiter *RateLimiter, next http.Handler) http.Handler {
+	if limiter == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		key := resolveClientIP(ctx, r)
+		if key == "" {
+			key = "unknown"
+		}
+		if !limiter.allow(w, r, "ip:"+key, "ip") {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RateLimitByPrincipal limits requests per authenticated user. It must run
+// after RequireAuth and falls back to the client IP without a principal.
+func RateLimitByPrincipal(limiter *RateLimiter, next http.Handler) http.Handler {
+	if limiter == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		keyType := "user"
+		key := ""
+		if principal, ok := principalFromContext(ctx); ok {
+			key = strings.TrimSpace(principal.UserID)
+		}
+		if key == "" {
+			keyType = "ip"
+			key = resolveClientIP(ctx, r)
+		}
+		if !limiter.allow(w, r, keyType+":"+key, keyType) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *RateLimiter) allow(w http.ResponseWriter, r *http.Request, key, keyType string) bool {
+	ok, retryAfter := l.buckets.Allow(key)
+	if ok {
+		return true
+	}
+
+	ctx := r.Context()
+	if l.rejected != nil {
+		l.rejected.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("rate_limit.group", l.group),
+			attribute.String("rate_limit.key_type", keyType),
+			attribute.String("http.request.method", r.Method),
+			attribute.String("http.route", r.Pattern),
+		))
+	}
+
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	writeError(ctx, w, fmt.Errorf("%w: group=%s key_type=%s retry_after=%ds", usecase.ErrRateLimited, l.group, keyType, seconds))
+	return false
+}
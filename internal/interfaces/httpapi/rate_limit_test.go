@@ -0,0 +1,87 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sonic "github.com/bytedance/sonic"
+	"github.com/riskibarqy/fantasy-league/internal/domain/user"
+)
+
+func TestRateLimitByIP_RejectsWithRetryAfter(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	limiter := NewRateLimiter(rateLimitGroupPublic, RateLimitRule{RequestsPerSecond: 0.5, Burst: 1}, nil)
+	handler := RateLimitByIP(limiter, next)
+
+	send := func(ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/leagues", nil)
+		req.Header.Set("X-Real-IP", ip)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := send("203.0.113.10"); rec.Code != http.StatusOK {
+		t.Fatalf("expected first request to pass, got %d", rec.Code)
+	}
+
+	rec := send("203.0.113.10")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Fatalf("expected Retry-After=2, got %q", got)
+	}
+
+	var body map[string]any
+	if err := sonic.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response body: %v", err)
+	}
+	errBody, _ := body["error"].(map[string]any)
+	if got, _ := errBody["status"].(string); got != "RESOURCE_EXHAUSTED" {
+		t.Fatalf("expected RESOURCE_EXHAUSTED error status, got %v", body["error"])
+	}
+
+	if rec := send("203.0.113.11"); rec.Code != http.StatusOK {
+		t.Fatalf("expected other client IP to pass, got %d", rec.Code)
+	}
+}
+
+func TestRateLimitByPrincipal_KeysByUser(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	limiter := NewRateLimiter(rateLimitGroupInvite, RateLimitRule{RequestsPerSecond: 0.1, Burst: 1}, nil)
+	handler := RateLimitByPrincipal(limiter, next)
+
+	send := func(userID string) int {
+		req := httptest.NewRequest(http.MethodPost, "/v1/custom-leagues/join", nil)
+		req.Header.Set("X-Real-IP", "203.0.113.10")
+		req = req.WithContext(withPrincipal(req.Context(), user.Principal{UserID: userID}))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := send("user-a"); code != http.StatusOK {
+		t.Fatalf("expected first request to pass, got %d", code)
+	}
+	if code := send("user-a"); code != http.StatusTooManyRequests {
+		t.Fatalf("expected second request of same user to be limited, got %d", code)
+	}
+	if code := send("user-b"); code != http.StatusOK {
+		t.Fatalf("expected other user behind the same IP to pass, got %d", code)
+	}
+}
+
+func TestNewRateLimiter_ZeroRuleDisablesLimiting(t *testing.T) {
+	if limiter := NewRateLimiter(rateLimitGroupWrite, RateLimitRule{}, nil); limiter != nil {
+		t.Fatalf("expected nil limiter for zero rule")
+	}
+	if limits := newRouteRateLimiters(RateLimitConfig{Public: RateLimitRule{RequestsPerSecond: 1, Burst: 1}}); limits.public != nil {
+		t.Fatalf("expected disabled config to build no limiters")
+	}
+}
@@ -18,6 +18,10 @@ const (
 	errorDomain      = "fantasy-league"
 )
 
+// responseJSON sorts map keys so the same data always encodes to the same
+// bytes, which keeps content ETags stable.
+var responseJSON = sonic.Config{SortMapKeys: true}.Froze()
+
 type googleResponseEnvelope struct {
 	APIVersion string           `json:"apiVersion"`
 	Data       any              `json:"data,omitempty"`
@@ -50,7 +54,7 @@ func writeJSON(ctx context.Context, w http.ResponseWriter, status int, payload a
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
-	_ = sonic.ConfigDefault.NewEncoder(w).Encode(payload)
+	_ = responseJSON.NewEncoder(w).Encode(payload)
 }
 
 func writeSuccess(ctx context.Context, w http.ResponseWriter, status int, data any) {
@@ -158,6 +162,27 @@ func mapError(ctx context.Context, err error) mappedError {
 			Status:        "UNAUTHENTICATED",
 			PublicMessage: "unauthorized",
 		}
+	case errors.Is(err, usecase.ErrForbidden):
+		return mappedError{
+			HTTPStatus:    http.StatusForbidden,
+			Reason:        "forbidden",
+			Status:        "PERMISSION_DENIED",
+			PublicMessage: "forbidden",
+		}
+	case errors.Is(err, usecase.ErrRateLimited):
+		return mappedError{
+			HTTPStatus:    http.StatusTooManyRequests,
+			Reason:        "rateLimitExceeded",
+			Status:        "RESOURCE_EXHAUSTED",
+			PublicMessage: "too many requests",
+		}
+	case errors.Is(err, usecase.ErrConflict):
+		return mappedError{
+			HTTPStatus:    http.StatusConflict,
+			Reason:        "conflict",
+			Status:        "ABORTED",
+			PublicMessage: "request conflicts with an earlier request",
+		}
 	case errors.Is(err, usecase.ErrDependencyUnavailable):
 		return mappedError{
 			HTTPStatus:    http.StatusServiceUnavailable,
@@ -15,20 +15,27 @@ func NewRouter(
 	swaggerEnabled bool,
 	corsAllowedOrigins []string,
 	internalJobToken string,
+	adminUserIDs []string,
+	anubisWebhookSecret string,
 	traceRequestBody bool,
 	traceRequestBodyMaxBytes int,
+	rateLimits RateLimitConfig,
+	cacheConfig HTTPCacheConfig,
 ) http.Handler {
 	if logger == nil {
 		logger = logging.Default()
 	}
 
+	limits := newRouteRateLimiters(rateLimits)
 	mux := http.NewServeMux()
 	registerSystemRoutes(mux, handler, swaggerEnabled)
-	registerPublicDomainRoutes(mux, handler)
-	registerAuthorizedRoutes(mux, handler, verifier)
+	registerPublicDomainRoutes(mux, handler, limits, newRouteCachePolicies(cacheConfig))
+	registerAuthorizedRoutes(mux, handler, verifier, limits)
+	registerAdminRoutes(mux, handler, verifier, adminUserIDs, limits)
 	registerInternalJobRoutes(mux, handler, internalJobToken)
+	registerWebhookRoutes(mux, handler, anubisWebhookSecret)
 
-	stack := RequestLogging(logger, CORS(corsAllowedOrigins, recoverPanic(logger, mux)))
+	stack := RequestLogging(logger, CORS(corsAllowedOrigins, recoverPanic(logger, RequestMetrics(mux))))
 	stack = RequestBodyTracing(traceRequestBody, traceRequestBodyMaxBytes, stack)
 	return RequestTracing(stack)
 }
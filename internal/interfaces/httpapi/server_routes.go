@@ -4,6 +4,7 @@ import "net/http"
 
 func registerSystemRoutes(mux *http.ServeMux, handler *Handler, swaggerEnabled bool) {
 	mux.HandleFunc("GET /healthz", handler.Healthz)
+	mux.HandleFunc("GET /readyz", handler.Readyz)
 	if !swaggerEnabled {
 		return
 	}
@@ -13,84 +14,142 @@ func registerSystemRoutes(mux *http.ServeMux, handler *Handler, swaggerEnabled b
 	mux.HandleFunc("GET /docs/", handler.SwaggerUI)
 }
 
-func registerPublicDomainRoutes(mux *http.ServeMux, handler *Handler) {
-	mux.HandleFunc("GET /v1/leagues", handler.ListLeagues)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/teams", handler.ListTeamsByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/teams/{teamID}", handler.GetTeamDetailsByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/teams/{teamID}/history", handler.GetTeamHistoryByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/teams/{teamID}/stats", handler.GetTeamStatsByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/players", handler.ListPlayersByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/topscorers/season/{season}", handler.ListTopScorerByLeagueAndSeason)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/players/{playerID}", handler.GetPlayerDetailsByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/players/{playerID}/history", handler.GetPlayerHistoryByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/fixtures", handler.ListFixturesByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/standings", handler.ListLeagueStandings)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/standings/live", handler.ListLiveLeagueStandings)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/fixtures/{fixtureID}", handler.GetFixtureDetailsByLeague)
-	mux.HandleFunc("GET /v1/leagues/{leagueID}/fixtures/{fixtureID}/events", handler.ListFixtureEventsByLeague)
+func registerPublicDomainRoutes(mux *http.ServeMux, handler *Handler, limits routeRateLimiters, caching routeCachePolicies) {
+	public := func(cacheControl string, next http.HandlerFunc) http.Handler {
+		return RateLimitByIP(limits.public, Cacheable(cacheControl, SparseFields(next)))
+	}
+	mux.Handle("GET /v1/leagues", public(caching.catalog, handler.ListLeagues))
+	mux.Handle("GET /v1/leagues/{leagueID}/teams", public(caching.catalog, handler.ListTeamsByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/teams/{teamID}", public(caching.standard, handler.GetTeamDetailsByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/teams/{teamID}/history", public(caching.standard, handler.GetTeamHistoryByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/teams/{teamID}/stats", public(caching.standard, handler.GetTeamStatsByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/teams/{teamID}/stat-values", public(caching.standard, handler.ListTeamStatValues))
+	mux.Handle("GET /v1/leagues/{leagueID}/players", public(caching.standard, handler.ListPlayersByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/topscorers/season/{season}", public(caching.standard, handler.ListTopScorerByLeagueAndSeason))
+	mux.Handle("GET /v1/leagues/{leagueID}/players/compare", public(caching.standard, handler.ComparePlayers))
+	mux.Handle("GET /v1/leagues/{leagueID}/players/{playerID}", public(caching.standard, handler.GetPlayerDetailsByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/players/{playerID}/history", public(caching.standard, handler.GetPlayerHistoryByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/players/{playerID}/stat-values", public(caching.standard, handler.ListPlayerStatValues))
+	mux.Handle("GET /v1/leagues/{leagueID}/stat-leaders/players", public(caching.standard, handler.ListPlayerStatLeaders))
+	mux.Handle("GET /v1/leagues/{leagueID}/stat-leaders/teams", public(caching.standard, handler.ListTeamStatLeaders))
+	mux.Handle("GET /v1/leagues/{leagueID}/leaderboards", public(caching.standard, handler.ListLeaderboards))
+	mux.Handle("GET /v1/stat-types", public(caching.catalog, handler.ListStatTypes))
+	mux.Handle("GET /v1/leagues/{leagueID}/ownership", public(caching.standard, handler.GetOwnershipLeaders))
+	mux.Handle("GET /v1/leagues/{leagueID}/gameweek-summaries", public(caching.standard, handler.ListGameweekSummaries))
+	mux.Handle("GET /v1/leagues/{leagueID}/dream-team", public(caching.standard, handler.GetGameweekDreamTeam))
+	mux.Handle("GET /v1/leagues/{leagueID}/dream-team/season", public(caching.standard, handler.GetSeasonDreamTeam))
+	mux.Handle("GET /v1/leagues/{leagueID}/fixtures", public(caching.live, handler.ListFixturesByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/standings", public(caching.standard, handler.ListLeagueStandings))
+	mux.Handle("GET /v1/leagues/{leagueID}/standings/live", public(caching.live, handler.ListLiveLeagueStandings))
+	mux.Handle("GET /v1/leagues/{leagueID}/fixtures/ticker", public(caching.standard, handler.GetFixtureTicker))
+	mux.Handle("GET /v1/leagues/{leagueID}/fixtures/{fixtureID}", public(caching.live, handler.GetFixtureDetailsByLeague))
+	mux.Handle("GET /v1/leagues/{leagueID}/fixtures/{fixtureID}/events", public(caching.live, handler.ListFixtureEventsByLeague))
 }
 
-func registerAuthorizedRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier) {
-	registerAuthorizedDashboardRoutes(mux, handler, verifier)
-	registerAuthorizedFantasyRoutes(mux, handler, verifier)
-	registerAuthorizedOnboardingRoutes(mux, handler, verifier)
-	registerAuthorizedCustomLeagueRoutes(mux, handler, verifier)
+func registerAuthorizedRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier, limits routeRateLimiters) {
+	registerAuthorizedDashboardRoutes(mux, handler, verifier, limits)
+	registerAuthorizedFantasyRoutes(mux, handler, verifier, limits)
+	registerAuthorizedOnboardingRoutes(mux, handler, verifier, limits)
+	registerAuthorizedCustomLeagueRoutes(mux, handler, verifier, limits)
 	registerAuthorizedIngestionRoutes(mux, handler, verifier)
+	registerAuthorizedAccountRoutes(mux, handler, verifier, limits)
+}
+
+func registerAdminRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier, adminUserIDs []string, limits routeRateLimiters) {
+	admin := func(next http.HandlerFunc) http.Handler {
+		return RequireAuth(verifier, RequireAdmin(adminUserIDs, RateLimitByPrincipal(limits.write, idempotent(handler, next))))
+	}
+	mux.Handle("POST /v1/admin/leagues", admin(handler.AdminCreateLeague))
+	mux.Handle("PATCH /v1/admin/leagues/{leagueID}", admin(handler.AdminUpdateLeague))
+	mux.Handle("GET /v1/admin/leagues/{leagueID}/overrides", admin(handler.AdminListOverrides))
+	mux.Handle("PUT /v1/admin/leagues/{leagueID}/teams/{teamID}/override", admin(handler.AdminSetTeamOverride))
+	mux.Handle("PUT /v1/admin/leagues/{leagueID}/players/{playerID}/override", admin(handler.AdminSetPlayerOverride))
+	mux.Handle("GET /v1/admin/leagues/{leagueID}/fixtures/{fixtureID}/stat-corrections", admin(handler.AdminListStatCorrections))
+	mux.Handle("POST /v1/admin/leagues/{leagueID}/fixtures/{fixtureID}/stat-corrections", admin(handler.AdminApplyStatCorrection))
+	mux.Handle("POST /v1/admin/leagues/{leagueID}/stat-corrections/rescore", admin(handler.AdminRescoreStatCorrections))
 }
 
 func registerInternalJobRoutes(mux *http.ServeMux, handler *Handler, internalJobToken string) {
-	mux.Handle("POST /v1/internal/jobs/bootstrap", RequireInternalJobToken(internalJobToken, http.HandlerFunc(handler.RunBootstrapJob)))
-	mux.Handle("POST /v1/internal/jobs/sync-schedule", RequireInternalJobToken(internalJobToken, http.HandlerFunc(handler.RunSyncScheduleJob)))
-	mux.Handle("POST /v1/internal/jobs/sync-live", RequireInternalJobToken(internalJobToken, http.HandlerFunc(handler.RunSyncLiveJob)))
+	job := func(next http.HandlerFunc) http.Handler {
+		return RequireInternalJobToken(internalJobToken, RequireJobIdempotency(handler.idempotencyService, handler.logger, next))
+	}
+	mux.Handle("POST /v1/internal/jobs/bootstrap", job(handler.RunBootstrapJob))
+	mux.Handle("POST /v1/internal/jobs/sync-schedule", job(handler.RunSyncScheduleJob))
+	mux.Handle("POST /v1/internal/jobs/sync-live", job(handler.RunSyncLiveJob))
+	mux.Handle("POST /v1/internal/jobs/season-rollover", job(handler.RunSeasonRollover))
+	mux.Handle("POST /v1/internal/jobs/season-summary", job(handler.RunSeasonSummaryJob))
+	mux.Handle("POST /v1/internal/jobs/ownership", job(handler.RunOwnershipJob))
+	mux.Handle("GET /v1/internal/status", RequireInternalJobToken(internalJobToken, http.HandlerFunc(handler.GetSystemStatus)))
+	mux.Handle("GET /v1/internal/audit-logs", RequireInternalJobToken(internalJobToken, http.HandlerFunc(handler.ListAuditLogs)))
 }
 
-func registerAuthorizedDashboardRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier) {
-	mux.Handle("GET /v1/dashboard", RequireAuth(verifier, http.HandlerFunc(handler.GetDashboard)))
+// idempotent adds Idempotency-Key handling to an authenticated POST or PUT
+// route.
+func idempotent(handler *Handler, next http.HandlerFunc) http.Handler {
+	return RequireIdempotency(handler.idempotencyService, handler.logger, next)
 }
 
-func registerAuthorizedFantasyRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier) {
-	mux.Handle("GET /v1/leagues/{leagueID}/lineup", RequireAuth(verifier, http.HandlerFunc(handler.GetLineupByLeague)))
-	mux.Handle("PUT /v1/leagues/{leagueID}/lineup", RequireAuth(verifier, http.HandlerFunc(handler.SaveLineupByLeague)))
-	mux.Handle("POST /v1/fantasy/squads", RequireAuth(verifier, http.HandlerFunc(handler.UpsertSquad)))
-	mux.Handle("POST /v1/fantasy/squads/picks", RequireAuth(verifier, http.HandlerFunc(handler.PickSquad)))
-	mux.Handle("GET /v1/fantasy/squads/me/players", RequireAuth(verifier, http.HandlerFunc(handler.ListMySquadPlayers)))
-	mux.Handle("POST /v1/fantasy/squads/me/players", RequireAuth(verifier, http.HandlerFunc(handler.AddPlayerToMySquad)))
-	mux.Handle("GET /v1/fantasy/squads/me", RequireAuth(verifier, http.HandlerFunc(handler.GetMySquad)))
-	mux.Handle("GET /v1/fantasy/points/summary", RequireAuth(verifier, http.HandlerFunc(handler.GetMySeasonPointsSummary)))
-	mux.Handle("GET /v1/fantasy/points/players", RequireAuth(verifier, http.HandlerFunc(handler.ListMyPlayerPointsByGameweek)))
+func registerWebhookRoutes(mux *http.ServeMux, handler *Handler, anubisWebhookSecret string) {
+	mux.Handle("POST /v1/webhooks/anubis", RequireWebhookSignature(anubisWebhookSecret, http.HandlerFunc(handler.HandleAnubisWebhook)))
 }
 
-func registerAuthorizedOnboardingRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier) {
-	mux.Handle("PUT /v1/onboarding/favorite-club", RequireAuth(verifier, http.HandlerFunc(handler.SaveOnboardingFavoriteClub)))
-	mux.Handle("POST /v1/onboarding/pick-squad", RequireAuth(verifier, http.HandlerFunc(handler.CompleteOnboardingPickSquad)))
+func registerAuthorizedDashboardRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier, limits routeRateLimiters) {
+	mux.Handle("GET /v1/dashboard", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetDashboard))))
 }
 
-func registerAuthorizedCustomLeagueRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier) {
-	mux.Handle("POST /v1/custom-leagues", RequireAuth(verifier, http.HandlerFunc(handler.CreateCustomLeague)))
-	mux.Handle("GET /v1/custom-leagues", RequireAuth(verifier, http.HandlerFunc(handler.ListMyCustomLeagues)))
-	mux.Handle("GET /v1/custom-leagues/me", RequireAuth(verifier, http.HandlerFunc(handler.ListMyCustomLeagues)))
-	mux.Handle("GET /v1/custom-leagues/{groupID}", RequireAuth(verifier, http.HandlerFunc(handler.GetCustomLeague)))
-	mux.Handle("PUT /v1/custom-leagues/{groupID}", RequireAuth(verifier, http.HandlerFunc(handler.UpdateCustomLeague)))
-	mux.Handle("DELETE /v1/custom-leagues/{groupID}", RequireAuth(verifier, http.HandlerFunc(handler.DeleteCustomLeague)))
-	mux.Handle("POST /v1/custom-leagues/join", RequireAuth(verifier, http.HandlerFunc(handler.JoinCustomLeagueByInvite)))
-	mux.Handle("GET /v1/custom-leagues/{groupID}/standings", RequireAuth(verifier, http.HandlerFunc(handler.ListCustomLeagueStandings)))
+func registerAuthorizedFantasyRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier, limits routeRateLimiters) {
+	mux.Handle("GET /v1/leagues/{leagueID}/lineup", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetLineupByLeague))))
+	mux.Handle("PUT /v1/leagues/{leagueID}/lineup", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.SaveLineupByLeague))))
+	mux.Handle("POST /v1/fantasy/squads", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.UpsertSquad))))
+	mux.Handle("POST /v1/fantasy/squads/picks", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.PickSquad))))
+	mux.Handle("GET /v1/fantasy/squads/me/players", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.ListMySquadPlayers))))
+	mux.Handle("POST /v1/fantasy/squads/me/players", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.AddPlayerToMySquad))))
+	mux.Handle("GET /v1/fantasy/squads/me", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetMySquad))))
+	mux.Handle("GET /v1/fantasy/points/summary", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetMySeasonPointsSummary))))
+	mux.Handle("GET /v1/fantasy/points/players", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.ListMyPlayerPointsByGameweek))))
+	mux.Handle("GET /v1/fantasy/history", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetMySeasonHistory))))
+}
+
+func registerAuthorizedOnboardingRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier, limits routeRateLimiters) {
+	mux.Handle("PUT /v1/onboarding/favorite-club", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.SaveOnboardingFavoriteClub))))
+	mux.Handle("POST /v1/onboarding/pick-squad", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.CompleteOnboardingPickSquad))))
+	mux.Handle("GET /v1/notifications/preferences", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetNotificationPreferences))))
+	mux.Handle("PUT /v1/notifications/preferences", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.UpdateNotificationPreferences))))
+}
+
+func registerAuthorizedCustomLeagueRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier, limits routeRateLimiters) {
+	mux.Handle("POST /v1/custom-leagues", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.CreateCustomLeague))))
+	mux.Handle("GET /v1/custom-leagues", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.ListMyCustomLeagues))))
+	mux.Handle("GET /v1/custom-leagues/me", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.ListMyCustomLeagues))))
+	mux.Handle("GET /v1/custom-leagues/{groupID}", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetCustomLeague))))
+	mux.Handle("PUT /v1/custom-leagues/{groupID}", RequireAuth(verifier, RateLimitByPrincipal(limits.write, idempotent(handler, handler.UpdateCustomLeague))))
+	mux.Handle("DELETE /v1/custom-leagues/{groupID}", RequireAuth(verifier, RateLimitByPrincipal(limits.write, http.HandlerFunc(handler.DeleteCustomLeague))))
+	mux.Handle("POST /v1/custom-leagues/join", RequireAuth(verifier, RateLimitByPrincipal(limits.invite, idempotent(handler, handler.JoinCustomLeagueByInvite))))
+	mux.Handle("GET /v1/custom-leagues/{groupID}/standings", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.ListCustomLeagueStandings))))
+	mux.Handle("GET /v1/custom-leagues/{groupID}/managers/{userID}/points", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetManagerPlayerPoints))))
+	mux.Handle("GET /v1/custom-leagues/{groupID}/managers/{userID}/history", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.GetManagerSeasonHistory))))
 }
 
 func registerAuthorizedIngestionRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier) {
-	mux.Handle("POST /v1/internal/ingestion/fixtures", RequireAuth(verifier, http.HandlerFunc(handler.IngestFixtures)))
-	mux.Handle("POST /v1/internal/ingestion/player-stats", RequireAuth(verifier, http.HandlerFunc(handler.IngestPlayerFixtureStats)))
-	mux.Handle("POST /v1/internal/ingestion/team-stats", RequireAuth(verifier, http.HandlerFunc(handler.IngestTeamFixtureStats)))
-	mux.Handle("POST /v1/internal/ingestion/fixture-events", RequireAuth(verifier, http.HandlerFunc(handler.IngestFixtureEvents)))
-	mux.Handle("POST /v1/internal/ingestion/raw-payloads", RequireAuth(verifier, http.HandlerFunc(handler.IngestRawPayloads)))
-	mux.Handle("POST /v1/internal/ingestion/standings", RequireAuth(verifier, http.HandlerFunc(handler.IngestLeagueStandings)))
-	mux.Handle("POST /v1/internal/sync/schedule", RequireAuth(verifier, http.HandlerFunc(handler.RunSyncScheduleDirect)))
-	mux.Handle("POST /v1/internal/sync/resync", RequireAuth(verifier, http.HandlerFunc(handler.RunResync)))
+	mux.Handle("POST /v1/internal/ingestion/fixtures", RequireAuth(verifier, idempotent(handler, handler.IngestFixtures)))
+	mux.Handle("POST /v1/internal/ingestion/player-stats", RequireAuth(verifier, idempotent(handler, handler.IngestPlayerFixtureStats)))
+	mux.Handle("POST /v1/internal/ingestion/team-stats", RequireAuth(verifier, idempotent(handler, handler.IngestTeamFixtureStats)))
+	mux.Handle("POST /v1/internal/ingestion/fixture-events", RequireAuth(verifier, idempotent(handler, handler.IngestFixtureEvents)))
+	mux.Handle("POST /v1/internal/ingestion/raw-payloads", RequireAuth(verifier, idempotent(handler, handler.IngestRawPayloads)))
+	mux.Handle("POST /v1/internal/ingestion/standings", RequireAuth(verifier, idempotent(handler, handler.IngestLeagueStandings)))
+	mux.Handle("POST /v1/internal/sync/schedule", RequireAuth(verifier, idempotent(handler, handler.RunSyncScheduleDirect)))
+	mux.Handle("POST /v1/internal/sync/resync", RequireAuth(verifier, idempotent(handler, handler.RunResync)))
 	// Master data sync for season initialization (teams + players + stat types catalogs).
-	mux.Handle("POST /v1/internal/sync/master-data", RequireAuth(verifier, http.HandlerFunc(handler.RunSyncMasterData)))
+	mux.Handle("POST /v1/internal/sync/master-data", RequireAuth(verifier, idempotent(handler, handler.RunSyncMasterData)))
 	// Team schedule sync focused on fixtures/timeline refresh.
-	mux.Handle("POST /v1/internal/sync/team-schedule", RequireAuth(verifier, http.HandlerFunc(handler.RunSyncTeamSchedule)))
+	mux.Handle("POST /v1/internal/sync/team-schedule", RequireAuth(verifier, idempotent(handler, handler.RunSyncTeamSchedule)))
 	// Reconcile sync for repairing data mismatches across fixtures/stats/standings.
-	mux.Handle("POST /v1/internal/sync/reconcile", RequireAuth(verifier, http.HandlerFunc(handler.RunSyncReconcile)))
+	mux.Handle("POST /v1/internal/sync/reconcile", RequireAuth(verifier, idempotent(handler, handler.RunSyncReconcile)))
 	// Get a previously executed sync result by run id.
 	mux.Handle("GET /v1/internal/sync/runs/{runID}", RequireAuth(verifier, http.HandlerFunc(handler.GetSyncRun)))
 }
+
+func registerAuthorizedAccountRoutes(mux *http.ServeMux, handler *Handler, verifier TokenVerifier, limits routeRateLimiters) {
+	mux.Handle("GET /v1/me/export", RequireAuth(verifier, RateLimitByPrincipal(limits.authorized, http.HandlerFunc(handler.ExportMyData))))
+	mux.Handle("DELETE /v1/me", RequireAuth(verifier, RateLimitByPrincipal(limits.write, http.HandlerFunc(handler.DeleteMyAccount))))
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/riskibarqy/fantasy-league/internal/config"
+	"github.com/riskibarqy/fantasy-league/internal/platform/metrics"
 )
 
 func StartPprofServer(cfg config.Config, logger *logging.Logger) (*http.Server, error) {
@@ -27,6 +28,7 @@ func StartPprofServer(cfg config.Config, logger *logging.Logger) (*http.Server,
 	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
 	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/metrics", metrics.Handler())
 
 	srv := &http.Server{
 		Addr:              cfg.PprofAddr,
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/riskibarqy/fantasy-league/internal/platform/metrics"
 	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
 )
 
@@ -40,6 +41,12 @@ func NewStoreWithLimit(ttl time.Duration, maxEntries int) *Store {
 }
 
 func (s *Store) Get(_ context.Context, key string) (any, bool) {
+	value, ok := s.lookup(key)
+	recordLookup(key, ok)
+	return value, ok
+}
+
+func (s *Store) lookup(key string) (any, bool) {
 	if key == "" {
 		return nil, false
 	}
@@ -109,6 +116,24 @@ func (s *Store) DeletePrefix(_ context.Context, prefix string) {
 	s.mu.Unlock()
 }
 
+// Len returns the number of entries that have not expired yet.
+func (s *Store) Len() int {
+	now := time.Now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.ttl <= 0 {
+		return len(s.entries)
+	}
+	count := 0
+	for _, e := range s.entries {
+		if e.expiresAt.After(now) {
+			count++
+		}
+	}
+	return count
+}
+
 func (s *Store) GetOrLoad(ctx context.Context, key string, loader func(context.Context) (any, error)) (any, error) {
 	if loader == nil {
 		return nil, fmt.Errorf("loader is required")
@@ -117,12 +142,14 @@ func (s *Store) GetOrLoad(ctx context.Context, key string, loader func(context.C
 		return loader(ctx)
 	}
 
-	if value, ok := s.Get(ctx, key); ok {
+	value, ok := s.lookup(key)
+	recordLookup(key, ok)
+	if ok {
 		return value, nil
 	}
 
 	value, err, _ := s.flight.Do(key, func() (any, error) {
-		if cached, ok := s.Get(ctx, key); ok {
+		if cached, ok := s.lookup(key); ok {
 			return cached, nil
 		}
 
@@ -140,6 +167,14 @@ func (s *Store) GetOrLoad(ctx context.Context, key string, loader func(context.C
 	return value, nil
 }
 
+func recordLookup(key string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	metrics.CacheRequests.WithLabelValues(metrics.CacheNamespace(key), result).Inc()
+}
+
 func (s *Store) pruneExpiredLocked(now time.Time) {
 	if s.ttl <= 0 {
 		return
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
+)
+
+// CircuitReporter is implemented by external clients guarded by a
+// resilience.CircuitBreaker.
+type CircuitReporter interface {
+	CircuitState() resilience.CircuitState
+}
+
+var circuitStates = []resilience.CircuitState{
+	resilience.CircuitStateClosed,
+	resilience.CircuitStateOpen,
+	resilience.CircuitStateHalfOpen,
+	resilience.CircuitStateDisabled,
+}
+
+var circuits = &circuitCollector{
+	desc: prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "circuit_breaker", "state"),
+		"Circuit breaker state per dependency, 1 for the current state.",
+		[]string{"dependency", "state"},
+		nil,
+	),
+	reporters: make(map[string]CircuitReporter),
+}
+
+func init() {
+	Registry.MustRegister(circuits)
+}
+
+// RegisterCircuit exposes the breaker state of a client under dependency.
+// Registering the same dependency again replaces the reporter.
+func RegisterCircuit(dependency string, reporter CircuitReporter) {
+	if reporter == nil {
+		return
+	}
+	circuits.mu.Lock()
+	circuits.reporters[dependency] = reporter
+	circuits.mu.Unlock()
+}
+
+type circuitCollector struct {
+	desc      *prometheus.Desc
+	mu        sync.RWMutex
+	reporters map[string]CircuitReporter
+}
+
+func (c *circuitCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *circuitCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for dependency, reporter := range c.reporters {
+		current := reporter.CircuitState()
+		for _, state := range circuitStates {
+			value := 0.0
+			if state == current {
+				value = 1
+			}
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, value, dependency, string(state))
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Package metrics holds the Prometheus registry served on the admin listener
+// and the collectors shared across layers. Collectors are package level so
+// adapters can record without threading a registry through constructors.
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "fantasy"
+
+// Registry holds every collector exposed on /metrics.
+var Registry = prometheus.NewRegistry()
+
+var (
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by ServeMux route pattern.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"method", "route", "status"})
+
+	CacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Repository cache lookups by key namespace and result.",
+	}, []string{"namespace", "result"})
+
+	ProviderRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "provider",
+		Name:      "requests_total",
+		Help:      "Sport data provider requests by endpoint and outcome.",
+	}, []string{"provider", "endpoint", "outcome"})
+
+	JobDispatches = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "job",
+		Name:      "dispatches_total",
+		Help:      "Recorded job dispatch events by job and status.",
+	}, []string{"job", "status"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequestDuration,
+		CacheRequests,
+		ProviderRequests,
+		JobDispatches,
+	)
+}
+
+// Handler serves the registry in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Register adds a collector, ignoring one that is already registered so the
+// app can be wired more than once in a process.
+func Register(c prometheus.Collector) error {
+	err := Registry.Register(c)
+	var already prometheus.AlreadyRegisteredError
+	if errors.As(err, &already) {
+		return nil
+	}
+	return err
+}
+
+// Provider request outcomes.
+const (
+	OutcomeOK          = "ok"
+	OutcomeError       = "error"
+	OutcomeCircuitOpen = "circuit_open"
+)
+
+// ObserveProviderRequest counts one provider request to path.
+func ObserveProviderRequest(provider, path string, err error) {
+	outcome := OutcomeOK
+	if err != nil {
+		outcome = OutcomeError
+	}
+	ProviderRequests.WithLabelValues(provider, EndpointLabel(path), outcome).Inc()
+}
+
+// CacheNamespace returns the part of a cache key before the first colon,
+// which keeps the namespace label to one value per repository.
+func CacheNamespace(key string) string {
+	if idx := strings.IndexByte(key, ':'); idx > 0 {
+		return key[:idx]
+	}
+	return "other"
+}
+
+// EndpointLabel replaces path segments holding digits, such as ids and
+// dates, with a placeholder so each endpoint is one label value.
+func EndpointLabel(path string) string {
+	if idx := strings.IndexByte(path, '?'); idx >= 0 {
+		path = path[:idx]
+	}
+	parts := strings.Split(path, "/")
+	for idx, part := range parts {
+		if strings.ContainsAny(part, "0123456789") {
+			parts[idx] = "{id}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
+)
+
+func TestEndpointLabel(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"/football/fixtures/between/2025-01-01/2025-02-01": "/football/fixtures/between/{id}/{id}",
+		"/football/seasons/23614?include=stages":           "/football/seasons/{id}",
+		"/football/livescores/inplay":                      "/football/livescores/inplay",
+	}
+	for path, want := range cases {
+		if got := EndpointLabel(path); got != want {
+			t.Fatalf("EndpointLabel(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestCacheNamespace(t *testing.T) {
+	t.Parallel()
+
+	if got := CacheNamespace("squad:user:u1:league:l1"); got != "squad" {
+		t.Fatalf("expected squad namespace, got %q", got)
+	}
+	if got := CacheNamespace("plain"); got != "other" {
+		t.Fatalf("expected other namespace, got %q", got)
+	}
+}
+
+type fixedCircuit resilience.CircuitState
+
+func (c fixedCircuit) CircuitState() resilience.CircuitState {
+	return resilience.CircuitState(c)
+}
+
+func TestRegisterCircuit(t *testing.T) {
+	t.Parallel()
+
+	RegisterCircuit("test-dependency", fixedCircuit(resilience.CircuitStateOpen))
+
+	expected := `
+# HELP fantasy_circuit_breaker_state Circuit breaker state per dependency, 1 for the current state.
+# TYPE fantasy_circuit_breaker_state gauge
+fantasy_circuit_breaker_state{dependency="test-dependency",state="closed"} 0
+fantasy_circuit_breaker_state{dependency="test-dependency",state="disabled"} 0
+fantasy_circuit_breaker_state{dependency="test-dependency",state="half_open"} 0
+fantasy_circuit_breaker_state{dependency="test-dependency",state="open"} 1
+`
+	if err := testutil.CollectAndCompare(circuits, strings.NewReader(expected)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -14,6 +14,8 @@ const (
 	CircuitStateClosed   CircuitState = "closed"
 	CircuitStateOpen     CircuitState = "open"
 	CircuitStateHalfOpen CircuitState = "half_open"
+	// CircuitStateDisabled is reported by clients whose breaker is turned off.
+	CircuitStateDisabled CircuitState = "disabled"
 )
 
 // CircuitBreaker is a small stateful breaker for dependency protection.
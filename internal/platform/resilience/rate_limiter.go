@@ -0,0 +1,88 @@
+package resilience
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const defaultRateLimiterSweepInterval = time.Minute
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// RateLimiter is a keyed token bucket limiter. Every key gets its own bucket
+// holding up to burst tokens, refilled at rate tokens per second.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	rate  float64
+	burst float64
+
+	buckets       map[string]*tokenBucket
+	lastSweep     time.Time
+	sweepInterval time.Duration
+	now           func() time.Time
+}
+
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if rate <= 0 {
+		rate = 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:          rate,
+		burst:         float64(burst),
+		buckets:       make(map[string]*tokenBucket),
+		sweepInterval: defaultRateLimiterSweepInterval,
+		now:           time.Now,
+	}
+}
+
+// Allow takes one token from the key bucket. When the bucket is empty it
+// returns false and how long until the next token is available.
+func (l *RateLimiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = bucket
+	} else if elapsed := now.Sub(bucket.last); elapsed > 0 {
+		bucket.tokens = math.Min(l.burst, bucket.tokens+elapsed.Seconds()*l.rate)
+		bucket.last = now
+	}
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+
+	wait := time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+	return false, wait
+}
+
+// sweep drops buckets that have refilled completely, since a new bucket
+// starts full anyway. Caller must hold l.mu.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	refill := time.Duration(l.burst / l.rate * float64(time.Second))
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.last) >= refill {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_BurstThenRefill(t *testing.T) {
+	l := NewRateLimiter(2, 3)
+
+	now := time.Date(2026, 2, 11, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.Allow("user-a"); !ok {
+			t.Fatalf("expected request %d within burst to pass", i+1)
+		}
+	}
+
+	ok, retryAfter := l.Allow("user-a")
+	if ok {
+		t.Fatalf("expected request beyond burst to be rejected")
+	}
+	if retryAfter != 500*time.Millisecond {
+		t.Fatalf("expected retry after 500ms, got %s", retryAfter)
+	}
+
+	if ok, _ := l.Allow("user-b"); !ok {
+		t.Fatalf("expected other key to have its own bucket")
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if ok, _ := l.Allow("user-a"); !ok {
+		t.Fatalf("expected one token after refill")
+	}
+	if ok, _ := l.Allow("user-a"); ok {
+		t.Fatalf("expected bucket to be empty again")
+	}
+}
+
+func TestRateLimiter_SweepsRefilledBuckets(t *testing.T) {
+	l := NewRateLimiter(1, 2)
+
+	now := time.Date(2026, 2, 11, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	l.Allow("idle")
+	now = now.Add(2 * time.Minute)
+	l.Allow("active")
+
+	if _, exists := l.buckets["idle"]; exists {
+		t.Fatalf("expected idle bucket to be swept")
+	}
+	if _, exists := l.buckets["active"]; !exists {
+		t.Fatalf("expected active bucket to be kept")
+	}
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/account"
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+)
+
+// AccountService exports and deletes everything stored for one user.
+type AccountService struct {
+	groupRepo customleague.Repository
+	repo      account.Repository
+	audit     *AuditRecorder
+	now       func() time.Time
+}
+
+func NewAccountService(groupRepo customleague.Repository, repo account.Repository) *AccountService {
+	return &AccountService{
+		groupRepo: groupRepo,
+		repo:      repo,
+		now:       time.Now,
+	}
+}
+
+func (s *AccountService) SetAuditRecorder(audit *AuditRecorder) {
+	s.audit = audit
+}
+
+func (s *AccountService) Export(ctx context.Context, userID string) (account.Archive, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.AccountService.Export")
+	defer span.End()
+
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return account.Archive{}, fmt.Errorf("%w: user id is required", ErrInvalidInput)
+	}
+
+	tables, err := s.repo.ExportUserData(ctx, userID)
+	if err != nil {
+		return account.Archive{}, fmt.Errorf("export user data: %w", err)
+	}
+	return account.Archive{
+		UserID:      userID,
+		GeneratedAt: s.now().UTC(),
+		Tables:      tables,
+	}, nil
+}
+
+// Delete hands owned custom leagues to their longest-standing other member,
+// removes the user's rows and re-ranks the standings they were part of, all in
+// the repository's transaction.
+// Deleting an unknown or already deleted user succeeds with nothing removed.
+func (s *AccountService) Delete(ctx context.Context, userID string) (account.DeletionResult, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.AccountService.Delete")
+	defer span.End()
+
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return account.DeletionResult{}, fmt.Errorf("%w: user id is required", ErrInvalidInput)
+	}
+	if userID == account.DeletedUserID || userID == auditlog.ActorSystem {
+		return account.DeletionResult{}, fmt.Errorf("%w: user id %s is reserved", ErrInvalidInput, userID)
+	}
+
+	groups, err := s.groupRepo.ListGroupsByUser(ctx, userID)
+	if err != nil {
+		return account.DeletionResult{}, fmt.Errorf("list custom leagues of user: %w", err)
+	}
+
+	plan := account.DeletionPlan{UserID: userID, NewOwners: make(map[string]string)}
+	leagueSet := make(map[string]struct{})
+	for _, group := range groups {
+		leagueSet[group.LeagueID] = struct{}{}
+		if !group.IsDefault && group.OwnerUserID == userID {
+			members, err := s.groupRepo.ListMembershipsByGroup(ctx, group.ID)
+			if err != nil {
+				return account.DeletionResult{}, fmt.Errorf("list members of custom league=%s: %w", group.ID, err)
+			}
+			next, ok := nextGroupOwner(members, userID)
+			if !ok {
+				plan.ClosedGroupIDs = append(plan.ClosedGroupIDs, group.ID)
+				continue
+			}
+			plan.NewOwners[group.ID] = next
+		}
+		plan.RerankGroupIDs = append(plan.RerankGroupIDs, group.ID)
+	}
+
+	rows, err := s.repo.DeleteUserData(ctx, plan)
+	if err != nil {
+		return account.DeletionResult{}, fmt.Errorf("delete user data: %w", err)
+	}
+
+	leagueIDs := make([]string, 0, len(leagueSet))
+	for leagueID := range leagueSet {
+		leagueIDs = append(leagueIDs, leagueID)
+	}
+	sort.Strings(leagueIDs)
+
+	result := account.DeletionResult{
+		UserID:       userID,
+		RowsByTable:  rows,
+		NewOwners:    plan.NewOwners,
+		ClosedGroups: plan.ClosedGroupIDs,
+		LeagueIDs:    leagueIDs,
+	}
+	s.audit.RecordAnonymized(ctx, userID, "account.delete", auditlog.EntityUser, userID, "", nil, map[string]any{
+		"new_owners":    plan.NewOwners,
+		"closed_groups": plan.ClosedGroupIDs,
+		"rows":          rows,
+	})
+	return result, nil
+}
+
+// nextGroupOwner picks the member who joined first, excluding the leaving
+// owner.
+func nextGroupOwner(members []customleague.Membership, leavingUserID string) (string, bool) {
+	var next customleague.Membership
+	found := false
+	for _, member := range members {
+		if member.UserID == leavingUserID {
+			continue
+		}
+		if !found || member.JoinedAt.Before(next.JoinedAt) ||
+			(member.JoinedAt.Equal(next.JoinedAt) && member.UserID < next.UserID) {
+			next = member
+			found = true
+		}
+	}
+	return next.UserID, found
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/account"
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/user"
+)
+
+type stubAccountGroupRepository struct {
+	customleague.Repository
+	groups  []customleague.Group
+	members map[string][]customleague.Membership
+}
+
+func (s *stubAccountGroupRepository) ListGroupsByUser(_ context.Context, _ string) ([]customleague.Group, error) {
+	return s.groups, nil
+}
+
+func (s *stubAccountGroupRepository) ListMembershipsByGroup(_ context.Context, groupID string) ([]customleague.Membership, error) {
+	return s.members[groupID], nil
+}
+
+type recordingAccountRepository struct {
+	account.Repository
+	plan  account.DeletionPlan
+	audit *recordingAuditLogRepository
+}
+
+// DeleteUserData anonymizes the audit entries the way the postgres
+// repository does inside its transaction.
+func (r *recordingAccountRepository) DeleteUserData(_ context.Context, plan account.DeletionPlan) (map[string]int64, error) {
+	r.plan = plan
+	if r.audit != nil {
+		for i, entry := range r.audit.entries {
+			if entry.ActorUserID == plan.UserID {
+				entry.ActorUserID = account.DeletedUserID
+			}
+			if entry.EntityID == plan.UserID {
+				entry.EntityID = account.DeletedUserID
+			}
+			for key, value := range entry.After {
+				if value == plan.UserID {
+					entry.After[key] = account.DeletedUserID
+				}
+			}
+			r.audit.entries[i] = entry
+		}
+	}
+	return map[string]int64{"fantasy_squads": 1}, nil
+}
+
+func TestAccountService_Delete(t *testing.T) {
+	t.Parallel()
+
+	joined := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	groupRepo := &stubAccountGroupRepository{
+		groups: []customleague.Group{
+			{ID: "default", LeagueID: "lg-b", OwnerUserID: "u-1", IsDefault: true},
+			{ID: "kantor", LeagueID: "lg-a", OwnerUserID: "u-1"},
+			{ID: "solo", LeagueID: "lg-a", OwnerUserID: "u-1"},
+			{ID: "friends", LeagueID: "lg-a", OwnerUserID: "u-9"},
+		},
+		members: map[string][]customleague.Membership{
+			"kantor": {
+				{GroupID: "kantor", UserID: "u-1", JoinedAt: joined},
+				{GroupID: "kantor", UserID: "u-3", JoinedAt: joined.Add(time.Hour)},
+				{GroupID: "kantor", UserID: "u-2", JoinedAt: joined.Add(time.Minute)},
+			},
+			"solo": {{GroupID: "solo", UserID: "u-1", JoinedAt: joined}},
+		},
+	}
+	repo := &recordingAccountRepository{}
+	svc := NewAccountService(groupRepo, repo)
+
+	result, err := svc.Delete(context.Background(), " u-1 ")
+	if err != nil {
+		t.Fatalf("delete account: %v", err)
+	}
+
+	if repo.plan.UserID != "u-1" || !reflect.DeepEqual(repo.plan.NewOwners, map[string]string{"kantor": "u-2"}) {
+		t.Fatalf("expected kantor to go to the earliest other member, got %+v", repo.plan)
+	}
+	if !reflect.DeepEqual(repo.plan.ClosedGroupIDs, []string{"solo"}) {
+		t.Fatalf("expected memberless group to be closed, got %v", repo.plan.ClosedGroupIDs)
+	}
+	if !reflect.DeepEqual(repo.plan.RerankGroupIDs, []string{"default", "kantor", "friends"}) {
+		t.Fatalf("expected every group with remaining members to be re-ranked, got %v", repo.plan.RerankGroupIDs)
+	}
+	if result.RowsByTable["fantasy_squads"] != 1 || !reflect.DeepEqual(result.LeagueIDs, []string{"lg-a", "lg-b"}) {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	if _, err := svc.Delete(context.Background(), account.DeletedUserID); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected reserved user id to be rejected, got %v", err)
+	}
+}
+
+func TestAccountService_DeleteLeavesNoAuditReference(t *testing.T) {
+	t.Parallel()
+
+	ctx := user.WithPrincipal(context.Background(), user.Principal{UserID: "u-1"})
+	auditRepo := &recordingAuditLogRepository{}
+	recorder := NewAuditRecorder(auditRepo, nil)
+	recorder.Record(ctx, "lineup.save", auditlog.EntityLineup, "u-1", "lg-a", nil, map[string]any{"captain_id": "p-1"})
+	recorder.Record(ctx, "custom_league.join", auditlog.EntityCustomLeague, "kantor", "lg-a", nil, map[string]any{"user_id": "u-1"})
+
+	svc := NewAccountService(&stubAccountGroupRepository{}, &recordingAccountRepository{audit: auditRepo})
+	svc.SetAuditRecorder(recorder)
+	if _, err := svc.Delete(ctx, "u-1"); err != nil {
+		t.Fatalf("delete account: %v", err)
+	}
+
+	if len(auditRepo.entries) != 3 {
+		t.Fatalf("expected the deletion to be recorded, got %d entries", len(auditRepo.entries))
+	}
+	for _, entry := range auditRepo.entries {
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			t.Fatalf("encode audit entry: %v", err)
+		}
+		if strings.Contains(string(raw), `"u-1"`) {
+			t.Fatalf("expected no audit entry to reference the deleted user, got %s", raw)
+		}
+	}
+	last := auditRepo.entries[2]
+	if last.Action != "account.delete" || last.ActorUserID != account.DeletedUserID || last.EntityID != account.DeletedUserID {
+		t.Fatalf("unexpected deletion entry %+v", last)
+	}
+}
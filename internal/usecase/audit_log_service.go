@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/account"
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/user"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+)
+
+const (
+	defaultAuditLogLimit = 100
+	maxAuditLogLimit     = 500
+)
+
+// AuditRecorder builds and appends the audit entries of every usecase that
+// mutates data. The actor is the principal in ctx. Record writes the entry
+// after the change succeeded, so a failed append is logged and never fails
+// the change. Changes that must be audited in their own transaction take the
+// entry from Entry instead.
+type AuditRecorder struct {
+	repo   auditlog.Repository
+	logger *logging.Logger
+	now    func() time.Time
+}
+
+func NewAuditRecorder(repo auditlog.Repository, logger *logging.Logger) *AuditRecorder {
+	if logger == nil {
+		logger = logging.Default()
+	}
+	return &AuditRecorder{repo: repo, logger: logger, now: time.Now}
+}
+
+// Record stores the fields that differ between before and after. A nil
+// recorder records nothing, which keeps services usable without an audit log.
+func (r *AuditRecorder) Record(ctx context.Context, action, entityType, entityID, leagueID string, before, after map[string]any) {
+	if r == nil || r.repo == nil {
+		return
+	}
+	r.append(ctx, r.entry(ctx, action, entityType, entityID, leagueID, before, after))
+}
+
+// RecordAnonymized records like Record, but stores account.DeletedUserID
+// wherever userID would be the actor or the entity. Account deletion uses it
+// so its own entry does not bring back the id it just removed.
+func (r *AuditRecorder) RecordAnonymized(ctx context.Context, userID, action, entityType, entityID, leagueID string, before, after map[string]any) {
+	if r == nil || r.repo == nil {
+		return
+	}
+	entry := r.entry(ctx, action, entityType, entityID, leagueID, before, after)
+	if entry.ActorUserID == userID {
+		entry.ActorUserID = account.DeletedUserID
+	}
+	if entry.EntityID == userID {
+		entry.EntityID = account.DeletedUserID
+	}
+	r.append(ctx, entry)
+}
+
+// Entry builds the entry Record would append, for a repository that writes
+// it in the same transaction as the change. A nil recorder still builds it.
+func (r *AuditRecorder) Entry(ctx context.Context, action, entityType, entityID, leagueID string, before, after map[string]any) auditlog.Entry {
+	return r.entry(ctx, action, entityType, entityID, leagueID, before, after)
+}
+
+func (r *AuditRecorder) entry(ctx context.Context, action, entityType, entityID, leagueID string, before, after map[string]any) auditlog.Entry {
+	now := time.Now
+	if r != nil && r.now != nil {
+		now = r.now
+	}
+	actorUserID := auditlog.ActorSystem
+	if principal, ok := user.PrincipalFromContext(ctx); ok && strings.TrimSpace(principal.UserID) != "" {
+		actorUserID = principal.UserID
+	}
+	traceID, _ := traceMetaFromContext(ctx)
+	before, after = diffAuditData(before, after)
+
+	return auditlog.Entry{
+		ActorUserID: actorUserID,
+		Action:      action,
+		EntityType:  entityType,
+		EntityID:    entityID,
+		LeagueID:    leagueID,
+		TraceID:     traceID,
+		Before:      before,
+		After:       after,
+		CreatedAt:   now().UTC(),
+	}
+}
+
+func (r *AuditRecorder) append(ctx context.Context, entry auditlog.Entry) {
+	if err := r.repo.Append(ctx, entry); err != nil {
+		r.logger.WarnContext(ctx, "append audit log failed",
+			"action", entry.Action,
+			"entity_type", entry.EntityType,
+			"entity_id", entry.EntityID,
+			"error", err,
+		)
+	}
+}
+
+// diffAuditData drops the fields both sides agree on. Deletions keep their
+// full before data and creations their full after data.
+func diffAuditData(before, after map[string]any) (map[string]any, map[string]any) {
+	if before == nil || after == nil {
+		return before, after
+	}
+
+	changedBefore := make(map[string]any)
+	changedAfter := make(map[string]any)
+	for key, value := range before {
+		next, ok := after[key]
+		if !ok || !reflect.DeepEqual(value, next) {
+			changedBefore[key] = value
+		}
+	}
+	for key, value := range after {
+		prev, ok := before[key]
+		if !ok || !reflect.DeepEqual(prev, value) {
+			changedAfter[key] = value
+		}
+	}
+	return changedBefore, changedAfter
+}
+
+type ListAuditLogInput struct {
+	UserID     string
+	EntityType string
+	EntityID   string
+	From       time.Time
+	To         time.Time
+	Limit      int
+}
+
+// AuditLogService answers support queries over the audit log.
+type AuditLogService struct {
+	repo auditlog.Repository
+}
+
+func NewAuditLogService(repo auditlog.Repository) *AuditLogService {
+	return &AuditLogService{repo: repo}
+}
+
+func (s *AuditLogService) List(ctx context.Context, input ListAuditLogInput) ([]auditlog.Entry, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.AuditLogService.List")
+	defer span.End()
+
+	if !input.From.IsZero() && !input.To.IsZero() && !input.From.Before(input.To) {
+		return nil, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	if input.Limit < 0 || input.Limit > maxAuditLogLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidInput, maxAuditLogLimit)
+	}
+	if input.Limit == 0 {
+		input.Limit = defaultAuditLogLimit
+	}
+
+	items, err := s.repo.List(ctx, auditlog.Query{
+		ActorUserID: strings.TrimSpace(input.UserID),
+		EntityType:  strings.TrimSpace(input.EntityType),
+		EntityID:    strings.TrimSpace(input.EntityID),
+		From:        input.From,
+		To:          input.To,
+		Limit:       input.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list audit logs: %w", err)
+	}
+	return items, nil
+}
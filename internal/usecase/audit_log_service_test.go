@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/user"
+)
+
+type recordingAuditLogRepository struct {
+	entries []auditlog.Entry
+	query   auditlog.Query
+	err     error
+}
+
+func (r *recordingAuditLogRepository) Append(_ context.Context, entry auditlog.Entry) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *recordingAuditLogRepository) List(_ context.Context, query auditlog.Query) ([]auditlog.Entry, error) {
+	r.query = query
+	return append([]auditlog.Entry(nil), r.entries...), nil
+}
+
+func TestAuditRecorder_Record(t *testing.T) {
+	t.Parallel()
+
+	repo := &recordingAuditLogRepository{}
+	recorder := NewAuditRecorder(repo, nil)
+
+	ctx := user.WithPrincipal(context.Background(), user.Principal{UserID: "u-1"})
+	recorder.Record(ctx, "lineup.save", auditlog.EntityLineup, "u-1", "lg",
+		map[string]any{"captain_id": "p-1", "vice_captain_id": "p-2", "forward_ids": []string{"p-9"}},
+		map[string]any{"captain_id": "p-3", "vice_captain_id": "p-2", "forward_ids": []string{"p-9"}},
+	)
+	recorder.Record(context.Background(), "ingestion.fixture_events", auditlog.EntityFixture, "fx-1", "", nil, map[string]any{"count": 3})
+
+	if len(repo.entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(repo.entries))
+	}
+	entry := repo.entries[0]
+	if entry.ActorUserID != "u-1" || len(entry.Before) != 1 || entry.Before["captain_id"] != "p-1" || len(entry.After) != 1 || entry.After["captain_id"] != "p-3" {
+		t.Fatalf("expected only the captain change, got %+v", entry)
+	}
+	if repo.entries[1].ActorUserID != auditlog.ActorSystem || repo.entries[1].Before != nil {
+		t.Fatalf("expected system actor for unauthenticated change, got %+v", repo.entries[1])
+	}
+
+	// Append failures and a nil recorder must not panic or surface.
+	NewAuditRecorder(&recordingAuditLogRepository{err: errors.New("db down")}, nil).Record(ctx, "x", "y", "z", "", nil, nil)
+	var nilRecorder *AuditRecorder
+	nilRecorder.Record(ctx, "x", "y", "z", "", nil, nil)
+}
+
+func TestAuditLogService_List(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := &recordingAuditLogRepository{}
+	svc := NewAuditLogService(repo)
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := svc.List(ctx, ListAuditLogInput{From: from, To: from.Add(-time.Hour)}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected reversed range to be rejected, got %v", err)
+	}
+	if _, err := svc.List(ctx, ListAuditLogInput{Limit: maxAuditLogLimit + 1}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected oversized limit to be rejected, got %v", err)
+	}
+
+	if _, err := svc.List(ctx, ListAuditLogInput{UserID: " u-1 ", EntityType: "lineup", From: from}); err != nil {
+		t.Fatalf("list audit logs: %v", err)
+	}
+	if repo.query.ActorUserID != "u-1" || repo.query.EntityType != "lineup" || repo.query.Limit != defaultAuditLogLimit || !repo.query.From.Equal(from) {
+		t.Fatalf("unexpected query %+v", repo.query)
+	}
+}
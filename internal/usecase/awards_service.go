@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/awards"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+)
+
+type AwardsService struct {
+	leagueRepo      league.Repository
+	playerRepo      player.Repository
+	playerStatsRepo playerstats.Repository
+	scoringRepo     scoring.Repository
+	awardsRepo      awards.Repository
+	scorer          leagueScoringUpdater
+	now             func() time.Time
+}
+
+func NewAwardsService(
+	leagueRepo league.Repository,
+	playerRepo player.Repository,
+	playerStatsRepo playerstats.Repository,
+	scoringRepo scoring.Repository,
+	awardsRepo awards.Repository,
+) *AwardsService {
+	return &AwardsService{
+		leagueRepo:      leagueRepo,
+		playerRepo:      playerRepo,
+		playerStatsRepo: playerStatsRepo,
+		scoringRepo:     scoringRepo,
+		awardsRepo:      awardsRepo,
+		now:             time.Now,
+	}
+}
+
+// SetScoringUpdater lets reads finalize pending gameweeks, which computes
+// their awards, before loading them.
+func (s *AwardsService) SetScoringUpdater(scorer leagueScoringUpdater) {
+	s.scorer = scorer
+}
+
+// ComputeGameweek builds the dream teams, top player and top manager of a
+// finalized gameweek from its fantasy points and replaces the stored awards.
+func (s *AwardsService) ComputeGameweek(ctx context.Context, leagueID string, gameweek int) (awards.GameweekAwards, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.AwardsService.ComputeGameweek")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return awards.GameweekAwards{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	if gameweek <= 0 {
+		return awards.GameweekAwards{}, fmt.Errorf("%w: gameweek must be greater than zero", ErrInvalidInput)
+	}
+
+	players, err := s.playerRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return awards.GameweekAwards{}, fmt.Errorf("list players for awards: %w", err)
+	}
+	positionByPlayer := make(map[string]player.Position, len(players))
+	for _, item := range players {
+		positionByPlayer[item.ID] = item.Position
+	}
+
+	gameweekPoints := make(map[string]int)
+	seasonPoints := make(map[string]int)
+	for gw := 1; gw <= gameweek; gw++ {
+		points, err := s.playerStatsRepo.GetFantasyPointsByLeagueAndGameweek(ctx, leagueID, gw)
+		if err != nil {
+			return awards.GameweekAwards{}, fmt.Errorf("get fantasy points for awards gameweek=%d: %w", gw, err)
+		}
+		for playerID, value := range points {
+			seasonPoints[playerID] += value
+			if gw == gameweek {
+				gameweekPoints[playerID] = value
+			}
+		}
+	}
+
+	userPoints, err := s.scoringRepo.ListUserGameweekPointsByLeague(ctx, leagueID)
+	if err != nil {
+		return awards.GameweekAwards{}, fmt.Errorf("list user gameweek points for awards: %w", err)
+	}
+
+	item := awards.GameweekAwards{
+		LeagueID:        leagueID,
+		Gameweek:        gameweek,
+		DreamTeam:       buildDreamTeam(gameweekPoints, positionByPlayer),
+		SeasonDreamTeam: buildDreamTeam(seasonPoints, positionByPlayer),
+		ComputedAt:      s.now().UTC(),
+	}
+	for playerID, points := range gameweekPoints {
+		if _, ok := positionByPlayer[playerID]; !ok {
+			continue
+		}
+		if item.TopPlayerID == "" || points > item.TopPlayerPoints ||
+			(points == item.TopPlayerPoints && playerID < item.TopPlayerID) {
+			item.TopPlayerID = playerID
+			item.TopPlayerPoints = points
+		}
+	}
+	for _, row := range userPoints {
+		if row.Gameweek != gameweek {
+			continue
+		}
+		if item.TopManagerUserID == "" || row.Points > item.TopManagerPoints ||
+			(row.Points == item.TopManagerPoints && row.UserID < item.TopManagerUserID) {
+			item.TopManagerUserID = row.UserID
+			item.TopManagerPoints = row.Points
+		}
+	}
+
+	if err := s.awardsRepo.Replace(ctx, item); err != nil {
+		return awards.GameweekAwards{}, fmt.Errorf("replace gameweek awards: %w", err)
+	}
+	return item, nil
+}
+
+// ListAwardedGameweeks returns the gameweeks that already have awards.
+func (s *AwardsService) ListAwardedGameweeks(ctx context.Context, leagueID string) ([]int, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.AwardsService.ListAwardedGameweeks")
+	defer span.End()
+
+	items, err := s.awardsRepo.ListGameweeksByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("list awarded gameweeks: %w", err)
+	}
+	return items, nil
+}
+
+// GetGameweekAwards returns the stored awards of a gameweek. A zero gameweek
+// means the latest finalized one.
+func (s *AwardsService) GetGameweekAwards(ctx context.Context, leagueID string, gameweek int) (awards.GameweekAwards, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.AwardsService.GetGameweekAwards")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return awards.GameweekAwards{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	if gameweek < 0 {
+		return awards.GameweekAwards{}, fmt.Errorf("%w: gameweek must be greater than zero", ErrInvalidInput)
+	}
+
+	lg, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return awards.GameweekAwards{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return awards.GameweekAwards{}, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+	if s.scorer != nil && !lg.IsArchived() {
+		if err := s.scorer.EnsureLeagueUpToDate(ctx, leagueID); err != nil {
+			return awards.GameweekAwards{}, err
+		}
+	}
+
+	if gameweek == 0 {
+		gameweeks, err := s.awardsRepo.ListGameweeksByLeague(ctx, leagueID)
+		if err != nil {
+			return awards.GameweekAwards{}, fmt.Errorf("list awarded gameweeks: %w", err)
+		}
+		if len(gameweeks) == 0 {
+			return awards.GameweekAwards{}, fmt.Errorf("%w: no finalized gameweek for league=%s", ErrNotFound, leagueID)
+		}
+		gameweek = gameweeks[len(gameweeks)-1]
+	}
+
+	item, exists, err := s.awardsRepo.GetByLeagueGameweek(ctx, leagueID, gameweek)
+	if err != nil {
+		return awards.GameweekAwards{}, fmt.Errorf("get gameweek awards: %w", err)
+	}
+	if !exists {
+		return awards.GameweekAwards{}, fmt.Errorf("%w: awards for league=%s gameweek=%d", ErrNotFound, leagueID, gameweek)
+	}
+	return item, nil
+}
+
+// buildDreamTeam picks the highest scoring XI that satisfies the lineup
+// formation rules: one goalkeeper, then the position minimums, then the best
+// remaining outfield players up to each position maximum. Ties go to the
+// lower player id. It returns nil when the players cannot fill a valid XI.
+func buildDreamTeam(points map[string]int, positionByPlayer map[string]player.Position) []awards.DreamTeamPlayer {
+	byPosition := make(map[player.Position][]awards.DreamTeamPlayer, len(player.AllPositions))
+	for playerID, position := range positionByPlayer {
+		byPosition[position] = append(byPosition[position], awards.DreamTeamPlayer{
+			PlayerID: playerID,
+			Position: position,
+			Points:   points[playerID],
+		})
+	}
+	for _, candidates := range byPosition {
+		sortDreamTeamPlayers(candidates)
+	}
+
+	limits := []struct {
+		position player.Position
+		min      int
+		max      int
+	}{
+		{position: player.PositionGoalkeeper, min: 1, max: 1},
+		{position: player.PositionDefender, min: lineupDefenderMin, max: lineupDefenderMax},
+		{position: player.PositionMidfielder, min: lineupMidfielderMin, max: lineupMidfielderMax},
+		{position: player.PositionForward, min: lineupForwardMin, max: lineupForwardMax},
+	}
+
+	taken := make(map[player.Position]int, len(limits))
+	for _, limit := range limits {
+		if len(byPosition[limit.position]) < limit.min {
+			return nil
+		}
+		taken[limit.position] = limit.min
+	}
+
+	remaining := lineupStarterSize - 1 - lineupDefenderMin - lineupMidfielderMin - lineupForwardMin
+	for ; remaining > 0; remaining-- {
+		var best *awards.DreamTeamPlayer
+		for _, limit := range limits {
+			next := taken[limit.position]
+			if next >= limit.max || next >= len(byPosition[limit.position]) {
+				continue
+			}
+			candidate := byPosition[limit.position][next]
+			if best == nil || candidate.Points > best.Points ||
+				(candidate.Points == best.Points && candidate.PlayerID < best.PlayerID) {
+				best = &candidate
+			}
+		}
+		if best == nil {
+			return nil
+		}
+		taken[best.Position]++
+	}
+
+	out := make([]awards.DreamTeamPlayer, 0, lineupStarterSize)
+	for _, limit := range limits {
+		out = append(out, byPosition[limit.position][:taken[limit.position]]...)
+	}
+	return out
+}
+
+func sortDreamTeamPlayers(items []awards.DreamTeamPlayer) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Points != items[j].Points {
+			return items[i].Points > items[j].Points
+		}
+		return items[i].PlayerID < items[j].PlayerID
+	})
+}
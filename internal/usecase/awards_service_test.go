@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/awards"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+	"github.com/riskibarqy/fantasy-league/internal/infrastructure/repository/memory"
+)
+
+type recordingAwardsRepository struct {
+	items map[int]awards.GameweekAwards
+}
+
+func (r *recordingAwardsRepository) Replace(_ context.Context, item awards.GameweekAwards) error {
+	if r.items == nil {
+		r.items = make(map[int]awards.GameweekAwards)
+	}
+	r.items[item.Gameweek] = item
+	return nil
+}
+
+func (r *recordingAwardsRepository) GetByLeagueGameweek(_ context.Context, _ string, gameweek int) (awards.GameweekAwards, bool, error) {
+	item, ok := r.items[gameweek]
+	return item, ok, nil
+}
+
+func (r *recordingAwardsRepository) ListGameweeksByLeague(_ context.Context, _ string) ([]int, error) {
+	out := make([]int, 0, len(r.items))
+	for gameweek := 1; len(out) < len(r.items); gameweek++ {
+		if _, ok := r.items[gameweek]; ok {
+			out = append(out, gameweek)
+		}
+	}
+	return out, nil
+}
+
+func awardsTestPlayers(leagueID string, position player.Position, prefix string, count int) []player.Player {
+	out := make([]player.Player, 0, count)
+	for i := 1; i <= count; i++ {
+		out = append(out, player.Player{
+			ID:       fmt.Sprintf("%s-%d", prefix, i),
+			LeagueID: leagueID,
+			TeamID:   "t-1",
+			Name:     fmt.Sprintf("%s %d", prefix, i),
+			Position: position,
+		})
+	}
+	return out
+}
+
+func TestBuildDreamTeam_RespectsFormationLimits(t *testing.T) {
+	t.Parallel()
+
+	positionByPlayer := map[string]player.Position{}
+	points := map[string]int{}
+	add := func(prefix string, position player.Position, values ...int) {
+		for idx, value := range values {
+			playerID := fmt.Sprintf("%s-%d", prefix, idx+1)
+			positionByPlayer[playerID] = position
+			points[playerID] = value
+		}
+	}
+	add("gk", player.PositionGoalkeeper, 9, 2)
+	add("def", player.PositionDefender, 1, 1, 1, 1, 1)
+	add("mid", player.PositionMidfielder, 3, 3, 3, 3, 3)
+	add("fwd", player.PositionForward, 10, 10, 10)
+
+	team := buildDreamTeam(points, positionByPlayer)
+	if len(team) != lineupStarterSize {
+		t.Fatalf("expected %d starters, got %d", lineupStarterSize, len(team))
+	}
+
+	count := map[player.Position]int{}
+	total := 0
+	for _, item := range team {
+		count[item.Position]++
+		total += item.Points
+	}
+	// Forwards are capped at three and defenders need three, so the two
+	// remaining slots go to midfielders rather than the second goalkeeper.
+	if count[player.PositionGoalkeeper] != 1 || count[player.PositionDefender] != 3 ||
+		count[player.PositionMidfielder] != 4 || count[player.PositionForward] != 3 {
+		t.Fatalf("unexpected formation: %v", count)
+	}
+	if team[0].PlayerID != "gk-1" {
+		t.Fatalf("expected best goalkeeper first, got %+v", team[0])
+	}
+	if total != 9+3+12+30 {
+		t.Fatalf("expected 54 points, got %d", total)
+	}
+
+	delete(positionByPlayer, "gk-1")
+	delete(positionByPlayer, "gk-2")
+	if team := buildDreamTeam(points, positionByPlayer); team != nil {
+		t.Fatalf("expected no dream team without a goalkeeper, got %+v", team)
+	}
+}
+
+func TestAwardsService_ComputeGameweek(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	players := awardsTestPlayers(leagueID, player.PositionGoalkeeper, "gk", 2)
+	players = append(players, awardsTestPlayers(leagueID, player.PositionDefender, "def", 5)...)
+	players = append(players, awardsTestPlayers(leagueID, player.PositionMidfielder, "mid", 5)...)
+	players = append(players, awardsTestPlayers(leagueID, player.PositionForward, "fwd", 3)...)
+
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		leagueID: {ID: leagueID, Name: "Liga 1 Indonesia"},
+	}}
+	statsRepo := &stubPointsPlayerStatsRepository{pointsByGameweek: map[int]map[string]int{
+		1: {"gk-2": 12, "fwd-1": 8},
+		2: {"gk-1": 6, "fwd-2": 13, "mid-4": 13},
+	}}
+	scoringRepo := &stubPointsScoringRepository{userRows: []scoring.UserGameweekPoints{
+		{LeagueID: leagueID, Gameweek: 1, UserID: "user-a", Points: 80},
+		{LeagueID: leagueID, Gameweek: 2, UserID: "user-a", Points: 41},
+		{LeagueID: leagueID, Gameweek: 2, UserID: "user-c", Points: 57},
+		{LeagueID: leagueID, Gameweek: 2, UserID: "user-b", Points: 57},
+	}}
+	awardsRepo := &recordingAwardsRepository{}
+
+	svc := NewAwardsService(leagues, memory.NewPlayerRepository(players), statsRepo, scoringRepo, awardsRepo)
+	computedAt := time.Date(2026, 2, 21, 20, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return computedAt }
+
+	item, err := svc.ComputeGameweek(ctx, leagueID, 2)
+	if err != nil {
+		t.Fatalf("compute gameweek: %v", err)
+	}
+
+	if item.TopPlayerID != "fwd-2" || item.TopPlayerPoints != 13 {
+		t.Fatalf("expected fwd-2 to win the tie on player id, got %s=%d", item.TopPlayerID, item.TopPlayerPoints)
+	}
+	if item.TopManagerUserID != "user-b" || item.TopManagerPoints != 57 {
+		t.Fatalf("expected user-b as top manager, got %s=%d", item.TopManagerUserID, item.TopManagerPoints)
+	}
+	if len(item.DreamTeam) != lineupStarterSize || item.DreamTeam[0].PlayerID != "gk-1" {
+		t.Fatalf("unexpected gameweek dream team: %+v", item.DreamTeam)
+	}
+	if len(item.SeasonDreamTeam) != lineupStarterSize || item.SeasonDreamTeam[0].PlayerID != "gk-2" {
+		t.Fatalf("expected season dream team goalkeeper gk-2, got %+v", item.SeasonDreamTeam)
+	}
+
+	got, err := svc.GetGameweekAwards(ctx, leagueID, 0)
+	if err != nil {
+		t.Fatalf("get latest awards: %v", err)
+	}
+	if got.Gameweek != 2 || !got.ComputedAt.Equal(computedAt) {
+		t.Fatalf("expected stored awards of gameweek 2, got %+v", got)
+	}
+	if _, err := svc.GetGameweekAwards(ctx, leagueID, 1); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for gameweek without awards, got %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
 	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
 	"github.com/riskibarqy/fantasy-league/internal/domain/league"
@@ -39,6 +40,7 @@ type CustomLeagueService struct {
 	groupRepo  customleague.Repository
 	scorer     leagueScoringUpdater
 	idGen      idgen.Generator
+	audit      *AuditRecorder
 	now        func() time.Time
 }
 
@@ -63,6 +65,10 @@ func NewCustomLeagueService(
 	}
 }
 
+func (s *CustomLeagueService) SetAuditRecorder(audit *AuditRecorder) {
+	s.audit = audit
+}
+
 func (s *CustomLeagueService) CreateGroup(ctx context.Context, input CreateCustomLeagueInput) (customleague.Group, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.CustomLeagueService.CreateGroup")
 	defer span.End()
@@ -123,6 +129,7 @@ func (s *CustomLeagueService) CreateGroup(ctx context.Context, input CreateCusto
 	if err := s.upsertMembershipAndStanding(ctx, group.ID, input.UserID, squad.ID, now); err != nil {
 		return customleague.Group{}, err
 	}
+	s.audit.Record(ctx, "custom_league.create", auditlog.EntityCustomLeague, group.ID, group.LeagueID, nil, customLeagueAuditData(group))
 
 	return group, nil
 }
@@ -235,6 +242,38 @@ func (s *CustomLeagueService) GetGroup(ctx context.Context, userID, groupID stri
 	return group, nil
 }
 
+// AuthorizeManagerView allows a user to see another manager's locked lineups,
+// points and history only when both belong to the custom league. Default
+// groups do not count: every squad owner is auto-joined to them, so they
+// would open every manager to every user.
+func (s *CustomLeagueService) AuthorizeManagerView(ctx context.Context, viewerUserID, groupID, managerUserID string) (customleague.Group, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.CustomLeagueService.AuthorizeManagerView")
+	defer span.End()
+
+	managerUserID = strings.TrimSpace(managerUserID)
+	if managerUserID == "" {
+		return customleague.Group{}, fmt.Errorf("%w: manager user id is required", ErrInvalidInput)
+	}
+
+	group, err := s.GetGroup(ctx, viewerUserID, groupID)
+	if err != nil {
+		return customleague.Group{}, err
+	}
+	if group.IsDefault {
+		return customleague.Group{}, fmt.Errorf("%w: managers can only be viewed through a private custom league", ErrForbidden)
+	}
+
+	isMember, err := s.groupRepo.IsGroupMember(ctx, group.ID, managerUserID)
+	if err != nil {
+		return customleague.Group{}, fmt.Errorf("check custom league manager member: %w", err)
+	}
+	if !isMember {
+		return customleague.Group{}, fmt.Errorf("%w: manager is not a member of this custom league", ErrNotFound)
+	}
+
+	return group, nil
+}
+
 func (s *CustomLeagueService) UpdateGroupName(ctx context.Context, input UpdateCustomLeagueInput) error {
 	ctx, span := startUsecaseSpan(ctx, "usecase.CustomLeagueService.UpdateGroupName")
 	defer span.End()
@@ -252,6 +291,11 @@ func (s *CustomLeagueService) UpdateGroupName(ctx context.Context, input UpdateC
 		return fmt.Errorf("%w: group name is required", ErrInvalidInput)
 	}
 
+	current, err := s.groupForAudit(ctx, input.GroupID)
+	if err != nil {
+		return err
+	}
+
 	if err := s.groupRepo.UpdateGroupName(ctx, input.GroupID, input.UserID, input.Name); err != nil {
 		if isNotFoundText(err) {
 			return fmt.Errorf("%w: custom league not found", ErrNotFound)
@@ -261,6 +305,10 @@ func (s *CustomLeagueService) UpdateGroupName(ctx context.Context, input UpdateC
 		}
 		return fmt.Errorf("update custom league name: %w", err)
 	}
+	s.audit.Record(ctx, "custom_league.rename", auditlog.EntityCustomLeague, input.GroupID, current.LeagueID,
+		map[string]any{"name": current.Name},
+		map[string]any{"name": input.Name},
+	)
 
 	return nil
 }
@@ -278,12 +326,18 @@ func (s *CustomLeagueService) DeleteGroup(ctx context.Context, userID, groupID s
 		return fmt.Errorf("%w: group id is required", ErrInvalidInput)
 	}
 
+	current, err := s.groupForAudit(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
 	if err := s.groupRepo.SoftDeleteGroup(ctx, groupID, userID); err != nil {
 		if isNotFoundText(err) {
 			return fmt.Errorf("%w: custom league not found", ErrNotFound)
 		}
 		return fmt.Errorf("delete custom league: %w", err)
 	}
+	s.audit.Record(ctx, "custom_league.delete", auditlog.EntityCustomLeague, groupID, current.LeagueID, customLeagueAuditData(current), nil)
 
 	return nil
 }
@@ -309,6 +363,10 @@ func (s *CustomLeagueService) JoinByInviteCode(ctx context.Context, input JoinCu
 		return customleague.Group{}, fmt.Errorf("%w: invite code not found", ErrNotFound)
 	}
 
+	if err := s.validateLeague(ctx, group.LeagueID); err != nil {
+		return customleague.Group{}, err
+	}
+
 	squad, exists, err := s.squadRepo.GetByUserAndLeague(ctx, input.UserID, group.LeagueID)
 	if err != nil {
 		return customleague.Group{}, fmt.Errorf("get user squad for join: %w", err)
@@ -320,10 +378,37 @@ func (s *CustomLeagueService) JoinByInviteCode(ctx context.Context, input JoinCu
 	if err := s.upsertMembershipAndStanding(ctx, group.ID, input.UserID, squad.ID, s.now().UTC()); err != nil {
 		return customleague.Group{}, err
 	}
+	// The invite code grants access to the group, so only ids are recorded.
+	s.audit.Record(ctx, "custom_league.join", auditlog.EntityCustomLeague, group.ID, group.LeagueID, nil, map[string]any{
+		"user_id":  input.UserID,
+		"squad_id": squad.ID,
+		"group_id": group.ID,
+	})
+
+	return group, nil
+}
 
+// groupForAudit loads the group a change is about to rewrite. It only reads
+// when an audit recorder is set, so ownership checks stay in the repository.
+func (s *CustomLeagueService) groupForAudit(ctx context.Context, groupID string) (customleague.Group, error) {
+	if s.audit == nil {
+		return customleague.Group{}, nil
+	}
+	group, _, err := s.groupRepo.GetGroupByID(ctx, groupID)
+	if err != nil {
+		return customleague.Group{}, fmt.Errorf("get custom league for audit: %w", err)
+	}
 	return group, nil
 }
 
+func customLeagueAuditData(group customleague.Group) map[string]any {
+	return map[string]any{
+		"name":          group.Name,
+		"owner_user_id": group.OwnerUserID,
+		"is_default":    group.IsDefault,
+	}
+}
+
 func (s *CustomLeagueService) GetStandings(ctx context.Context, userID, groupID string) ([]customleague.Standing, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.CustomLeagueService.GetStandings")
 	defer span.End()
@@ -400,6 +485,13 @@ func (s *CustomLeagueService) EnsureDefaultMemberships(ctx context.Context, user
 		}
 		defaultGroups = append(defaultGroups, countryGroups...)
 	}
+	// Custom leagues carried over from the previous season are rejoined
+	// automatically with fresh standings.
+	carriedGroups, err := s.groupRepo.ListCarriedOverGroupsByUser(ctx, leagueID, userID)
+	if err != nil {
+		return fmt.Errorf("list carried over custom leagues: %w", err)
+	}
+	defaultGroups = append(defaultGroups, carriedGroups...)
 
 	joined := make(map[string]struct{}, len(defaultGroups))
 	now := s.now().UTC()
@@ -417,13 +509,16 @@ func (s *CustomLeagueService) EnsureDefaultMemberships(ctx context.Context, user
 }
 
 func (s *CustomLeagueService) validateLeague(ctx context.Context, leagueID string) error {
-	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	item, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
 	if err != nil {
 		return fmt.Errorf("get league by id: %w", err)
 	}
 	if !exists {
 		return fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
 	}
+	if item.IsArchived() {
+		return fmt.Errorf("%w: league=%s season %s is archived", ErrInvalidInput, leagueID, item.Season)
+	}
 	return nil
 }
 
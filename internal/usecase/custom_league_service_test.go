@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+)
+
+type stubMembershipGroupRepository struct {
+	customleague.Repository
+	groups  map[string]customleague.Group
+	members map[string]map[string]struct{}
+}
+
+func (s *stubMembershipGroupRepository) GetGroupByID(_ context.Context, groupID string) (customleague.Group, bool, error) {
+	group, ok := s.groups[groupID]
+	return group, ok, nil
+}
+
+func (s *stubMembershipGroupRepository) GetGroupByInviteCode(_ context.Context, inviteCode string) (customleague.Group, bool, error) {
+	for _, group := range s.groups {
+		if group.InviteCode == inviteCode {
+			return group, true, nil
+		}
+	}
+	return customleague.Group{}, false, nil
+}
+
+func (s *stubMembershipGroupRepository) UpsertMembershipAndStanding(_ context.Context, membership customleague.Membership, _ customleague.Standing) error {
+	if s.members[membership.GroupID] == nil {
+		s.members[membership.GroupID] = make(map[string]struct{})
+	}
+	s.members[membership.GroupID][membership.UserID] = struct{}{}
+	return nil
+}
+
+type stubJoinSquadRepository struct {
+	fantasy.Repository
+	squad fantasy.Squad
+}
+
+func (s *stubJoinSquadRepository) GetByUserAndLeague(_ context.Context, userID, leagueID string) (fantasy.Squad, bool, error) {
+	return s.squad, s.squad.UserID == userID && s.squad.LeagueID == leagueID, nil
+}
+
+func (s *stubMembershipGroupRepository) IsGroupMember(_ context.Context, groupID, userID string) (bool, error) {
+	_, ok := s.members[groupID][userID]
+	return ok, nil
+}
+
+func TestCustomLeagueService_AuthorizeManagerView(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	groupRepo := &stubMembershipGroupRepository{
+		groups: map[string]customleague.Group{
+			"group-1": {ID: "group-1", LeagueID: "idn-liga-1-2025", Name: "Kantor"},
+			"default": {ID: "default", LeagueID: "idn-liga-1-2025", Name: "Liga 1", IsDefault: true},
+		},
+		members: map[string]map[string]struct{}{
+			"group-1": {"user-a": {}, "user-b": {}},
+			"default": {"user-a": {}, "user-b": {}, "user-c": {}},
+		},
+	}
+	svc := NewCustomLeagueService(nil, nil, groupRepo, nil, nil)
+
+	group, err := svc.AuthorizeManagerView(ctx, "user-a", "group-1", "user-b")
+	if err != nil {
+		t.Fatalf("expected rival in the same custom league to be visible: %v", err)
+	}
+	if group.LeagueID != "idn-liga-1-2025" {
+		t.Fatalf("expected group league id, got %q", group.LeagueID)
+	}
+
+	if _, err := svc.AuthorizeManagerView(ctx, "user-c", "group-1", "user-b"); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected unauthorized for viewer outside the custom league, got %v", err)
+	}
+	if _, err := svc.AuthorizeManagerView(ctx, "user-a", "group-1", "user-c"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for manager outside the custom league, got %v", err)
+	}
+	if _, err := svc.AuthorizeManagerView(ctx, "user-a", "group-2", "user-b"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for unknown custom league, got %v", err)
+	}
+	if _, err := svc.AuthorizeManagerView(ctx, "user-c", "default", "user-b"); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected default group to be rejected, got %v", err)
+	}
+}
+
+func TestCustomLeagueService_JoinByInviteCodeKeepsInviteCodeOutOfAuditLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	groupRepo := &stubMembershipGroupRepository{
+		groups: map[string]customleague.Group{
+			"group-1": {ID: "group-1", LeagueID: leagueID, Name: "Kantor", InviteCode: "KANTOR42"},
+		},
+		members: map[string]map[string]struct{}{},
+	}
+	squads := &stubJoinSquadRepository{squad: fantasy.Squad{ID: "squad-1", UserID: "user-a", LeagueID: leagueID}}
+	leagues := &stubLeagueRepository{byID: map[string]league.League{leagueID: {ID: leagueID}}}
+	auditRepo := &recordingAuditLogRepository{}
+	svc := NewCustomLeagueService(leagues, squads, groupRepo, nil, nil)
+	svc.SetAuditRecorder(NewAuditRecorder(auditRepo, nil))
+
+	if _, err := svc.JoinByInviteCode(ctx, JoinCustomLeagueByInviteInput{UserID: "user-a", InviteCode: " kantor42 "}); err != nil {
+		t.Fatalf("join by invite code: %v", err)
+	}
+	if len(auditRepo.entries) != 1 {
+		t.Fatalf("expected one audit entry, got %d", len(auditRepo.entries))
+	}
+	entry := auditRepo.entries[0]
+	if _, leaked := entry.After["invite_code"]; leaked || entry.After["group_id"] != "group-1" || entry.EntityID != "group-1" {
+		t.Fatalf("expected only ids in the join audit data, got %+v", entry)
+	}
+	if _, leaked := customLeagueAuditData(groupRepo.groups["group-1"])["invite_code"]; leaked {
+		t.Fatalf("expected invite code to stay out of custom league audit data")
+	}
+}
@@ -62,7 +62,7 @@ func (s *DashboardService) Get(ctx context.Context, userID string) (Dashboard, e
 
 	selected := leagues[0]
 	for _, l := range leagues {
-		if l.IsDefault {
+		if l.IsDefault && !l.IsArchived() {
 			selected = l
 			break
 		}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/team"
+)
+
+// Relations a list response can embed with include=.
+const (
+	IncludeTeam         = "team"
+	IncludeSeasonStats  = "season_stats"
+	IncludeNextFixtures = "next_fixtures"
+)
+
+// nextFixturesPerTeam caps the upcoming fixtures embedded per team.
+const nextFixturesPerTeam = 3
+
+// Enrichment holds the related data of one league, looked up per list item.
+// Only the requested relations are loaded; the others stay nil.
+type Enrichment struct {
+	Teams              []team.Team
+	TeamsByID          map[string]team.Team
+	SeasonStatsByID    map[string]playerstats.SeasonStats
+	NextFixturesByTeam map[string][]fixture.Fixture
+}
+
+// EnrichmentService loads related data for list responses with one
+// repository call per relation, whatever the number of items, so embedding
+// never turns into a query per row.
+type EnrichmentService struct {
+	leagueRepo  league.Repository
+	teamRepo    team.Repository
+	fixtureRepo fixture.Repository
+	statsRepo   playerstats.Repository
+}
+
+func NewEnrichmentService(leagueRepo league.Repository, teamRepo team.Repository, fixtureRepo fixture.Repository, statsRepo playerstats.Repository) *EnrichmentService {
+	return &EnrichmentService{
+		leagueRepo:  leagueRepo,
+		teamRepo:    teamRepo,
+		fixtureRepo: fixtureRepo,
+		statsRepo:   statsRepo,
+	}
+}
+
+func (s *EnrichmentService) Load(ctx context.Context, leagueID string, includes ...string) (Enrichment, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.EnrichmentService.Load")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return Enrichment{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return Enrichment{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return Enrichment{}, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+
+	var out Enrichment
+	for _, include := range includes {
+		switch include {
+		case IncludeTeam:
+			if out.TeamsByID != nil {
+				continue
+			}
+			teams, err := s.teamRepo.ListByLeague(ctx, leagueID)
+			if err != nil {
+				return Enrichment{}, fmt.Errorf("list teams by league: %w", err)
+			}
+			out.Teams = visibleTeams(teams)
+			out.TeamsByID = make(map[string]team.Team, len(out.Teams))
+			for _, item := range out.Teams {
+				out.TeamsByID[item.ID] = item
+			}
+		case IncludeSeasonStats:
+			if out.SeasonStatsByID != nil {
+				continue
+			}
+			totals, err := s.statsRepo.ListPlayerTotalsByLeague(ctx, leagueID, 0, 0)
+			if err != nil {
+				return Enrichment{}, fmt.Errorf("list player season totals: %w", err)
+			}
+			out.SeasonStatsByID = make(map[string]playerstats.SeasonStats, len(totals))
+			for _, item := range totals {
+				out.SeasonStatsByID[item.PlayerID] = item.SeasonStats
+			}
+		case IncludeNextFixtures:
+			if out.NextFixturesByTeam != nil {
+				continue
+			}
+			fixtures, err := s.fixtureRepo.ListByLeague(ctx, leagueID)
+			if err != nil {
+				return Enrichment{}, fmt.Errorf("list fixtures by league: %w", err)
+			}
+			out.NextFixturesByTeam = nextFixturesByTeam(fixtures, nextFixturesPerTeam)
+		default:
+			return Enrichment{}, fmt.Errorf("%w: unknown include %q", ErrInvalidInput, include)
+		}
+	}
+	return out, nil
+}
+
+// nextFixturesByTeam keeps the earliest unsettled fixtures of every team,
+// including a match that is being played.
+func nextFixturesByTeam(fixtures []fixture.Fixture, perTeam int) map[string][]fixture.Fixture {
+	upcoming := make([]fixture.Fixture, 0, len(fixtures))
+	for _, item := range fixtures {
+		if !isSettledFixture(item) {
+			upcoming = append(upcoming, item)
+		}
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		if !upcoming[i].KickoffAt.Equal(upcoming[j].KickoffAt) {
+			return upcoming[i].KickoffAt.Before(upcoming[j].KickoffAt)
+		}
+		return upcoming[i].ID < upcoming[j].ID
+	})
+
+	out := make(map[string][]fixture.Fixture)
+	for _, item := range upcoming {
+		for _, teamID := range []string{item.HomeTeamID, item.AwayTeamID} {
+			if teamID == "" || len(out[teamID]) >= perTeam {
+				continue
+			}
+			out[teamID] = append(out[teamID], item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/team"
+	teammock "github.com/riskibarqy/fantasy-league/internal/mocks/domain/team"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEnrichmentService_Load(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		leagueID: {ID: leagueID, Name: "Liga 1 Indonesia"},
+	}}
+	teams := teammock.NewRepository(t)
+	teams.
+		On("ListByLeague", mock.Anything, leagueID).
+		Return([]team.Team{
+			{ID: "t-1", LeagueID: leagueID, Name: "Persija Jakarta"},
+			{ID: "t-2", LeagueID: leagueID, Name: "Persib Bandung"},
+			{ID: "t-3", LeagueID: leagueID, Name: "Hidden FC", Hidden: true},
+		}, nil).
+		Once()
+
+	kickoff := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	score := func(v int) *int { return &v }
+	fixtures := &stubFixtureRepository{byLeague: map[string][]fixture.Fixture{
+		leagueID: {
+			{ID: "f-1", HomeTeamID: "t-1", AwayTeamID: "t-2", KickoffAt: kickoff, HomeScore: score(1), AwayScore: score(0), Status: fixture.StatusFinished},
+			{ID: "f-4", HomeTeamID: "t-2", AwayTeamID: "t-1", KickoffAt: kickoff.AddDate(0, 0, 21), Status: fixture.StatusScheduled},
+			{ID: "f-2", HomeTeamID: "t-1", AwayTeamID: "t-2", KickoffAt: kickoff.AddDate(0, 0, 7), Status: fixture.StatusScheduled},
+			{ID: "f-3", HomeTeamID: "t-2", AwayTeamID: "t-1", KickoffAt: kickoff.AddDate(0, 0, 14), Status: fixture.StatusScheduled},
+			{ID: "f-5", HomeTeamID: "t-1", AwayTeamID: "t-2", KickoffAt: kickoff.AddDate(0, 0, 28), Status: fixture.StatusScheduled},
+		},
+	}}
+	stats := &stubLeaderboardPlayerStatsRepository{totals: []playerstats.PlayerTotals{
+		{PlayerID: "p-1", TeamID: "t-1", SeasonStats: playerstats.SeasonStats{Goals: 4, TotalPoints: 40}},
+	}}
+	svc := NewEnrichmentService(leagues, teams, fixtures, stats)
+
+	got, err := svc.Load(ctx, leagueID, IncludeTeam, IncludeSeasonStats, IncludeNextFixtures, IncludeTeam)
+	if err != nil {
+		t.Fatalf("load enrichment: %v", err)
+	}
+	if len(got.Teams) != 2 || got.TeamsByID["t-1"].Name != "Persija Jakarta" {
+		t.Fatalf("expected hidden teams to be left out, got %+v", got.Teams)
+	}
+	if got.SeasonStatsByID["p-1"].Goals != 4 {
+		t.Fatalf("unexpected season stats: %+v", got.SeasonStatsByID)
+	}
+	if stats.fromGameweek != 0 || stats.toGameweek != 0 {
+		t.Fatalf("expected whole-season totals, got gameweeks %d-%d", stats.fromGameweek, stats.toGameweek)
+	}
+	next := got.NextFixturesByTeam["t-1"]
+	if len(next) != nextFixturesPerTeam || next[0].ID != "f-2" || next[1].ID != "f-3" || next[2].ID != "f-4" {
+		t.Fatalf("expected the three earliest unsettled fixtures, got %+v", next)
+	}
+
+	only, err := svc.Load(ctx, leagueID, IncludeSeasonStats)
+	if err != nil {
+		t.Fatalf("load season stats only: %v", err)
+	}
+	if only.TeamsByID != nil || only.NextFixturesByTeam != nil {
+		t.Fatalf("expected relations that were not asked for to stay nil, got %+v", only)
+	}
+}
+
+func TestEnrichmentService_LoadRejectsBadInput(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	leagues := &stubLeagueRepository{byID: map[string]league.League{leagueID: {ID: leagueID}}}
+	svc := NewEnrichmentService(leagues, teammock.NewRepository(t), &stubFixtureRepository{}, &stubLeaderboardPlayerStatsRepository{})
+
+	if _, err := svc.Load(ctx, leagueID, "owners"); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected invalid input for unknown include, got %v", err)
+	}
+	if _, err := svc.Load(ctx, "missing-league", IncludeTeam); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for missing league, got %v", err)
+	}
+}
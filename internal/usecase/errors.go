@@ -6,5 +6,8 @@ var (
 	ErrInvalidInput          = crerr.New("invalid input")
 	ErrNotFound              = crerr.New("resource not found")
 	ErrUnauthorized          = crerr.New("unauthorized")
+	ErrForbidden             = crerr.New("forbidden")
 	ErrDependencyUnavailable = crerr.New("dependency unavailable")
+	ErrRateLimited           = crerr.New("rate limited")
+	ErrConflict              = crerr.New("conflict")
 )
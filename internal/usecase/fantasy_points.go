@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+)
+
+// EstimateFantasyPoints scores one player's fixture stat line with the
+// standard FPL-style rules. Provider adapters use it for rows without their
+// own fantasy points and stat corrections use it to rescore a changed line,
+// so both always agree.
+func EstimateFantasyPoints(stat ExternalPlayerFixtureStat) int {
+	position := player.Position(strings.ToUpper(strings.TrimSpace(stat.Position)))
+	goalkeeper := position == player.PositionGoalkeeper
+	defensive := goalkeeper || position == player.PositionDefender
+
+	points := 0
+	switch {
+	case defensive:
+		points += stat.Goals * 6
+	case position == player.PositionMidfielder:
+		points += stat.Goals * 5
+	default:
+		points += stat.Goals * 4
+	}
+	points += stat.Assists * 3
+
+	if stat.CleanSheet && stat.MinutesPlayed >= 60 {
+		switch {
+		case defensive:
+			points += 4
+		case position == player.PositionMidfielder:
+			points++
+		}
+	}
+	if defensive {
+		points -= stat.GoalsConceded / 2
+	}
+	if goalkeeper {
+		points += stat.Saves / 3
+		points += stat.PenaltiesSaved * 5
+	}
+	points -= stat.PenaltiesMissed * 2
+	points -= stat.OwnGoals * 2
+	points -= stat.YellowCards
+	points -= stat.RedCards * 3
+
+	if stat.MinutesPlayed >= 60 {
+		points += 2
+	} else if stat.MinutesPlayed > 0 {
+		points++
+	}
+
+	return points + stat.BonusPoints
+}
+
+// fixtureStatScoringLine is the part of a stored fixture stat the scoring
+// rules read. Stats the row does not keep, such as goals conceded, are zero.
+func fixtureStatScoringLine(stat playerstats.FixtureStat, position player.Position) ExternalPlayerFixtureStat {
+	return ExternalPlayerFixtureStat{
+		Position:      string(position),
+		MinutesPlayed: stat.MinutesPlayed,
+		Goals:         stat.Goals,
+		Assists:       stat.Assists,
+		CleanSheet:    stat.CleanSheet,
+		YellowCards:   stat.YellowCards,
+		RedCards:      stat.RedCards,
+		Saves:         stat.Saves,
+		BonusPoints:   stat.BonusPoints,
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+)
+
+func TestEstimateFantasyPoints_FPLStyleGoalAndCleanSheetByPosition(t *testing.T) {
+	t.Parallel()
+
+	gk := ExternalPlayerFixtureStat{
+		Position:       "GK",
+		MinutesPlayed:  90,
+		Goals:          1,
+		CleanSheet:     true,
+		Saves:          6,
+		BonusPoints:    3,
+		PenaltiesSaved: 1,
+	}
+	// GK: goal 6 + clean sheet 4 + saves 2 + minutes 2 + pen saved 5 + bonus 3 = 22
+	if got := EstimateFantasyPoints(gk); got != 22 {
+		t.Fatalf("gk fantasy points mismatch: got=%d want=22", got)
+	}
+
+	mid := ExternalPlayerFixtureStat{
+		Position:      " mid ",
+		MinutesPlayed: 90,
+		Goals:         1,
+		Assists:       1,
+		CleanSheet:    true,
+		YellowCards:   1,
+		BonusPoints:   2,
+	}
+	// MID: goal 5 + assist 3 + clean sheet 1 + minutes 2 - yellow 1 + bonus 2 = 12
+	if got := EstimateFantasyPoints(mid); got != 12 {
+		t.Fatalf("mid fantasy points mismatch: got=%d want=12", got)
+	}
+
+	def := ExternalPlayerFixtureStat{
+		Position:        "DEF",
+		MinutesPlayed:   75,
+		GoalsConceded:   3,
+		OwnGoals:        1,
+		PenaltiesMissed: 1,
+		RedCards:        1,
+	}
+	// DEF: minutes 2 - conceded 1 - own goal 2 - pen missed 2 - red 3 = -6
+	if got := EstimateFantasyPoints(def); got != -6 {
+		t.Fatalf("def fantasy points mismatch: got=%d want=-6", got)
+	}
+}
+
+func TestFixtureStatScoringLine_MatchesProviderScoring(t *testing.T) {
+	t.Parallel()
+
+	stat := playerstats.FixtureStat{MinutesPlayed: 90, Goals: 1, Assists: 1, CleanSheet: true, BonusPoints: 2}
+	line := fixtureStatScoringLine(stat, player.PositionDefender)
+	// DEF: goal 6 + assist 3 + clean sheet 4 + minutes 2 + bonus 2 = 17
+	if got := EstimateFantasyPoints(line); got != 17 {
+		t.Fatalf("stored stat scoring mismatch: got=%d want=17", got)
+	}
+}
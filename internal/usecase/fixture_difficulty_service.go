@@ -0,0 +1,409 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixturedifficulty"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/leaguestanding"
+	"github.com/riskibarqy/fantasy-league/internal/domain/teamstats"
+)
+
+const (
+	defaultFixtureTickerGameweeks = 5
+	maxFixtureTickerGameweeks     = 10
+
+	// Weights of the overall team strength: table position, points per game
+	// and shots on target per match, each normalized to 0..1.
+	difficultyPositionWeight      = 0.5
+	difficultyPointsPerGameWeight = 0.3
+	difficultyShotsWeight         = 0.2
+	// difficultyHomeAdvantage is added to hosts and taken from visitors.
+	difficultyHomeAdvantage = 0.08
+	// difficultyVenueFormWeight scales how much better a team did at home
+	// than away, in points per game over three.
+	difficultyVenueFormWeight = 0.15
+)
+
+type FixtureDifficultyService struct {
+	leagueRepo     league.Repository
+	fixtureRepo    fixture.Repository
+	standingRepo   leaguestanding.Repository
+	teamStatsRepo  teamstats.Repository
+	difficultyRepo fixturedifficulty.Repository
+	scorer         leagueScoringUpdater
+	now            func() time.Time
+}
+
+// FixtureTicker lists the fixtures of every team over a range of gameweeks.
+type FixtureTicker struct {
+	LeagueID     string
+	FromGameweek int
+	ToGameweek   int
+	Teams        []TeamFixtureTicker
+}
+
+type TeamFixtureTicker struct {
+	TeamID   string
+	Fixtures []TickerFixture
+}
+
+// TickerFixture is one fixture from the point of view of a team. A team can
+// have none or several fixtures in one gameweek.
+type TickerFixture struct {
+	FixtureID      string
+	Gameweek       int
+	KickoffAt      time.Time
+	OpponentTeamID string
+	IsHome         bool
+	Difficulty     int
+}
+
+func NewFixtureDifficultyService(
+	leagueRepo league.Repository,
+	fixtureRepo fixture.Repository,
+	standingRepo leaguestanding.Repository,
+	teamStatsRepo teamstats.Repository,
+	difficultyRepo fixturedifficulty.Repository,
+) *FixtureDifficultyService {
+	return &FixtureDifficultyService{
+		leagueRepo:     leagueRepo,
+		fixtureRepo:    fixtureRepo,
+		standingRepo:   standingRepo,
+		teamStatsRepo:  teamStatsRepo,
+		difficultyRepo: difficultyRepo,
+		now:            time.Now,
+	}
+}
+
+// SetScoringUpdater lets ticker reads refresh ratings of a league whose
+// latest gameweek was finalized since the last refresh.
+func (s *FixtureDifficultyService) SetScoringUpdater(scorer leagueScoringUpdater) {
+	s.scorer = scorer
+}
+
+// EnsureFresh recomputes team strengths when the stored ones predate the
+// latest finalized gameweek, or do not exist yet.
+func (s *FixtureDifficultyService) EnsureFresh(ctx context.Context, leagueID string, finalizedGameweek int) error {
+	ctx, span := startUsecaseSpan(ctx, "usecase.FixtureDifficultyService.EnsureFresh")
+	defer span.End()
+
+	items, err := s.difficultyRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return fmt.Errorf("list team fixture difficulty: %w", err)
+	}
+	if len(items) > 0 && items[0].BasedOnGameweek >= finalizedGameweek {
+		return nil
+	}
+
+	_, err = s.Refresh(ctx, leagueID, finalizedGameweek)
+	return err
+}
+
+// Refresh computes the home and away strength of every team from the
+// standings, team season stats and finished results, and replaces the
+// stored values.
+func (s *FixtureDifficultyService) Refresh(ctx context.Context, leagueID string, basedOnGameweek int) ([]fixturedifficulty.TeamStrength, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.FixtureDifficultyService.Refresh")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return nil, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+
+	fixtures, err := s.fixtureRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("list fixtures for difficulty: %w", err)
+	}
+	standings, err := s.standingRepo.ListByLeague(ctx, leagueID, false)
+	if err != nil {
+		return nil, fmt.Errorf("list standings for difficulty: %w", err)
+	}
+
+	teamIDs := make(map[string]struct{})
+	for _, item := range standings {
+		teamIDs[item.TeamID] = struct{}{}
+	}
+	for _, item := range fixtures {
+		if item.HomeTeamID != "" {
+			teamIDs[item.HomeTeamID] = struct{}{}
+		}
+		if item.AwayTeamID != "" {
+			teamIDs[item.AwayTeamID] = struct{}{}
+		}
+	}
+
+	shotsOnTargetPerMatch := make(map[string]float64, len(teamIDs))
+	for teamID := range teamIDs {
+		stats, err := s.teamStatsRepo.GetSeasonStatsByLeagueAndTeam(ctx, leagueID, teamID)
+		if err != nil {
+			return nil, fmt.Errorf("get team season stats for difficulty team=%s: %w", teamID, err)
+		}
+		if stats.Appearances > 0 {
+			shotsOnTargetPerMatch[teamID] = float64(stats.TotalShotsOnTarget) / float64(stats.Appearances)
+		}
+	}
+
+	items := computeTeamStrengths(leagueID, teamIDs, standings, shotsOnTargetPerMatch, fixtures)
+	computedAt := s.now().UTC()
+	for idx := range items {
+		items[idx].BasedOnGameweek = basedOnGameweek
+		items[idx].ComputedAt = computedAt
+	}
+
+	if err := s.difficultyRepo.ReplaceByLeague(ctx, leagueID, items); err != nil {
+		return nil, fmt.Errorf("replace team fixture difficulty: %w", err)
+	}
+	return items, nil
+}
+
+// GetTicker returns the fixtures of every team for gameweeks starting at the
+// first one with an unfinished fixture.
+func (s *FixtureDifficultyService) GetTicker(ctx context.Context, leagueID string, gameweeks int) (FixtureTicker, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.FixtureDifficultyService.GetTicker")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return FixtureTicker{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	if gameweeks <= 0 {
+		gameweeks = defaultFixtureTickerGameweeks
+	}
+	if gameweeks > maxFixtureTickerGameweeks {
+		gameweeks = maxFixtureTickerGameweeks
+	}
+
+	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return FixtureTicker{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return FixtureTicker{}, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+	if s.scorer != nil {
+		if err := s.scorer.EnsureLeagueUpToDate(ctx, leagueID); err != nil {
+			return FixtureTicker{}, err
+		}
+	}
+
+	fixtures, err := s.fixtureRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return FixtureTicker{}, fmt.Errorf("list fixtures for ticker: %w", err)
+	}
+	strengths, err := s.difficultyRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return FixtureTicker{}, fmt.Errorf("list team fixture difficulty: %w", err)
+	}
+
+	return buildFixtureTicker(leagueID, fixtures, strengths, gameweeks), nil
+}
+
+func buildFixtureTicker(leagueID string, fixtures []fixture.Fixture, strengths []fixturedifficulty.TeamStrength, gameweeks int) FixtureTicker {
+	strengthByTeam := make(map[string]fixturedifficulty.TeamStrength, len(strengths))
+	for _, item := range strengths {
+		strengthByTeam[item.TeamID] = item
+	}
+	difficultyAgainst := func(opponentID string, opponentHosts bool) int {
+		item, ok := strengthByTeam[opponentID]
+		if !ok {
+			return fixturedifficulty.NeutralDifficulty
+		}
+		return item.DifficultyAgainst(opponentHosts)
+	}
+
+	fromGameweek := 0
+	teamIDs := make(map[string]struct{})
+	for _, item := range fixtures {
+		if item.HomeTeamID != "" {
+			teamIDs[item.HomeTeamID] = struct{}{}
+		}
+		if item.AwayTeamID != "" {
+			teamIDs[item.AwayTeamID] = struct{}{}
+		}
+		if item.Gameweek <= 0 || isSettledFixture(item) {
+			continue
+		}
+		if fromGameweek == 0 || item.Gameweek < fromGameweek {
+			fromGameweek = item.Gameweek
+		}
+	}
+
+	out := FixtureTicker{
+		LeagueID: leagueID,
+		Teams:    make([]TeamFixtureTicker, 0, len(teamIDs)),
+	}
+	if fromGameweek > 0 {
+		out.FromGameweek = fromGameweek
+		out.ToGameweek = fromGameweek + gameweeks - 1
+	}
+
+	byTeam := make(map[string][]TickerFixture, len(teamIDs))
+	for _, item := range fixtures {
+		if out.FromGameweek == 0 || item.Gameweek < out.FromGameweek || item.Gameweek > out.ToGameweek {
+			continue
+		}
+		if isSettledFixture(item) || item.HomeTeamID == "" || item.AwayTeamID == "" {
+			continue
+		}
+		byTeam[item.HomeTeamID] = append(byTeam[item.HomeTeamID], TickerFixture{
+			FixtureID:      item.ID,
+			Gameweek:       item.Gameweek,
+			KickoffAt:      item.KickoffAt,
+			OpponentTeamID: item.AwayTeamID,
+			IsHome:         true,
+			Difficulty:     difficultyAgainst(item.AwayTeamID, false),
+		})
+		byTeam[item.AwayTeamID] = append(byTeam[item.AwayTeamID], TickerFixture{
+			FixtureID:      item.ID,
+			Gameweek:       item.Gameweek,
+			KickoffAt:      item.KickoffAt,
+			OpponentTeamID: item.HomeTeamID,
+			IsHome:         false,
+			Difficulty:     difficultyAgainst(item.HomeTeamID, true),
+		})
+	}
+
+	for teamID := range teamIDs {
+		items := byTeam[teamID]
+		if items == nil {
+			items = []TickerFixture{}
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].Gameweek != items[j].Gameweek {
+				return items[i].Gameweek < items[j].Gameweek
+			}
+			return items[i].KickoffAt.Before(items[j].KickoffAt)
+		})
+		out.Teams = append(out.Teams, TeamFixtureTicker{TeamID: teamID, Fixtures: items})
+	}
+	sort.Slice(out.Teams, func(i, j int) bool {
+		return out.Teams[i].TeamID < out.Teams[j].TeamID
+	})
+	return out
+}
+
+func computeTeamStrengths(
+	leagueID string,
+	teamIDs map[string]struct{},
+	standings []leaguestanding.Standing,
+	shotsOnTargetPerMatch map[string]float64,
+	fixtures []fixture.Fixture,
+) []fixturedifficulty.TeamStrength {
+	standingByTeam := make(map[string]leaguestanding.Standing, len(standings))
+	maxPosition := 0
+	for _, item := range standings {
+		standingByTeam[item.TeamID] = item
+		if item.Position > maxPosition {
+			maxPosition = item.Position
+		}
+	}
+	maxShots := 0.0
+	for _, value := range shotsOnTargetPerMatch {
+		maxShots = math.Max(maxShots, value)
+	}
+
+	type venueRecord struct {
+		homePoints, homePlayed int
+		awayPoints, awayPlayed int
+	}
+	venues := make(map[string]*venueRecord, len(teamIDs))
+	record := func(teamID string) *venueRecord {
+		item, ok := venues[teamID]
+		if !ok {
+			item = &venueRecord{}
+			venues[teamID] = item
+		}
+		return item
+	}
+	for _, item := range fixtures {
+		if !fixture.IsFinishedStatus(item.Status) || item.HomeScore == nil || item.AwayScore == nil {
+			continue
+		}
+		if item.HomeTeamID == "" || item.AwayTeamID == "" {
+			continue
+		}
+		homePoints, awayPoints := 1, 1
+		switch {
+		case *item.HomeScore > *item.AwayScore:
+			homePoints, awayPoints = 3, 0
+		case *item.HomeScore < *item.AwayScore:
+			homePoints, awayPoints = 0, 3
+		}
+		home := record(item.HomeTeamID)
+		home.homePoints += homePoints
+		home.homePlayed++
+		away := record(item.AwayTeamID)
+		away.awayPoints += awayPoints
+		away.awayPlayed++
+	}
+
+	out := make([]fixturedifficulty.TeamStrength, 0, len(teamIDs))
+	for teamID := range teamIDs {
+		positionScore := 0.5
+		pointsScore := 0.5
+		if standing, ok := standingByTeam[teamID]; ok {
+			if standing.Position > 0 && maxPosition > 1 {
+				positionScore = 1 - float64(standing.Position-1)/float64(maxPosition-1)
+			}
+			if standing.Played > 0 {
+				pointsScore = float64(standing.Points) / float64(standing.Played) / 3
+			} else {
+				pointsScore = positionScore
+			}
+		}
+		shotsScore := 0.5
+		if maxShots > 0 {
+			shotsScore = shotsOnTargetPerMatch[teamID] / maxShots
+		}
+		base := difficultyPositionWeight*positionScore +
+			difficultyPointsPerGameWeight*pointsScore +
+			difficultyShotsWeight*shotsScore
+
+		venueGap := 0.0
+		if venue, ok := venues[teamID]; ok && venue.homePlayed > 0 && venue.awayPlayed > 0 {
+			homePPG := float64(venue.homePoints) / float64(venue.homePlayed)
+			awayPPG := float64(venue.awayPoints) / float64(venue.awayPlayed)
+			venueGap = (homePPG - awayPPG) / 3
+		}
+
+		homeStrength := clampUnit(base + difficultyHomeAdvantage + difficultyVenueFormWeight*venueGap/2)
+		awayStrength := clampUnit(base - difficultyHomeAdvantage - difficultyVenueFormWeight*venueGap/2)
+		out = append(out, fixturedifficulty.TeamStrength{
+			LeagueID:       leagueID,
+			TeamID:         teamID,
+			HomeStrength:   math.Round(homeStrength*10000) / 10000,
+			AwayStrength:   math.Round(awayStrength*10000) / 10000,
+			HomeDifficulty: strengthToDifficulty(homeStrength),
+			AwayDifficulty: strengthToDifficulty(awayStrength),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].TeamID < out[j].TeamID
+	})
+	return out
+}
+
+func strengthToDifficulty(strength float64) int {
+	value := fixturedifficulty.MinDifficulty + int(math.Round(clampUnit(strength)*4))
+	if value > fixturedifficulty.MaxDifficulty {
+		return fixturedifficulty.MaxDifficulty
+	}
+	return value
+}
+
+func clampUnit(value float64) float64 {
+	return math.Min(1, math.Max(0, value))
+}
+
+func isSettledFixture(item fixture.Fixture) bool {
+	status := fixture.NormalizeStatus(item.Status)
+	return fixture.IsFinishedStatus(status) || fixture.IsCancelledLikeStatus(status)
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixturedifficulty"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/leaguestanding"
+	"github.com/riskibarqy/fantasy-league/internal/domain/teamstats"
+)
+
+type stubDifficultyTeamStatsRepository struct {
+	teamstats.Repository
+	byTeam map[string]teamstats.SeasonStats
+}
+
+func (s *stubDifficultyTeamStatsRepository) GetSeasonStatsByLeagueAndTeam(_ context.Context, _, teamID string) (teamstats.SeasonStats, error) {
+	return s.byTeam[teamID], nil
+}
+
+type recordingFixtureDifficultyRepository struct {
+	items []fixturedifficulty.TeamStrength
+}
+
+func (r *recordingFixtureDifficultyRepository) ReplaceByLeague(_ context.Context, _ string, items []fixturedifficulty.TeamStrength) error {
+	r.items = append([]fixturedifficulty.TeamStrength(nil), items...)
+	return nil
+}
+
+func (r *recordingFixtureDifficultyRepository) ListByLeague(_ context.Context, _ string) ([]fixturedifficulty.TeamStrength, error) {
+	return append([]fixturedifficulty.TeamStrength(nil), r.items...), nil
+}
+
+func difficultyTestFixture(id string, gameweek int, home, away string, homeScore, awayScore *int) fixture.Fixture {
+	status := fixture.StatusScheduled
+	if homeScore != nil && awayScore != nil {
+		status = fixture.StatusFinished
+	}
+	return fixture.Fixture{
+		ID:         id,
+		Gameweek:   gameweek,
+		HomeTeamID: home,
+		AwayTeamID: away,
+		KickoffAt:  time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, 7*gameweek),
+		HomeScore:  homeScore,
+		AwayScore:  awayScore,
+		Status:     status,
+	}
+}
+
+func newDifficultyTestService(leagueID string) (*FixtureDifficultyService, *recordingFixtureDifficultyRepository) {
+	score := func(v int) *int { return &v }
+	fixtures := &stubFixtureRepository{byLeague: map[string][]fixture.Fixture{
+		leagueID: {
+			difficultyTestFixture("f-1", 1, "t-1", "t-4", score(3), score(0)),
+			difficultyTestFixture("f-2", 1, "t-2", "t-3", score(1), score(1)),
+			difficultyTestFixture("f-3", 2, "t-4", "t-1", score(0), score(2)),
+			difficultyTestFixture("f-4", 2, "t-3", "t-2", nil, nil),
+			difficultyTestFixture("f-5", 3, "t-1", "t-2", nil, nil),
+			difficultyTestFixture("f-6", 3, "t-3", "t-4", nil, nil),
+			difficultyTestFixture("f-7", 4, "t-2", "t-1", nil, nil),
+		},
+	}}
+	standings := &stubLeagueStandingRepository{rows: map[string][]leaguestanding.Standing{
+		standingsKey(leagueID, false): {
+			{TeamID: "t-1", Position: 1, Played: 2, Points: 6},
+			{TeamID: "t-2", Position: 2, Played: 1, Points: 1},
+			{TeamID: "t-3", Position: 3, Played: 1, Points: 1},
+			{TeamID: "t-4", Position: 4, Played: 2, Points: 0},
+		},
+	}}
+	teamStats := &stubDifficultyTeamStatsRepository{byTeam: map[string]teamstats.SeasonStats{
+		"t-1": {Appearances: 2, TotalShotsOnTarget: 12},
+		"t-2": {Appearances: 1, TotalShotsOnTarget: 4},
+		"t-3": {Appearances: 1, TotalShotsOnTarget: 3},
+		"t-4": {Appearances: 2, TotalShotsOnTarget: 2},
+	}}
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		leagueID: {ID: leagueID, Name: "Liga 1 Indonesia"},
+	}}
+	difficultyRepo := &recordingFixtureDifficultyRepository{}
+	return NewFixtureDifficultyService(leagues, fixtures, standings, teamStats, difficultyRepo), difficultyRepo
+}
+
+func TestFixtureDifficultyService_Refresh(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	svc, difficultyRepo := newDifficultyTestService(leagueID)
+
+	items, err := svc.Refresh(ctx, leagueID, 2)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if len(items) != 4 || len(difficultyRepo.items) != 4 {
+		t.Fatalf("expected four stored team strengths, got %d/%d", len(items), len(difficultyRepo.items))
+	}
+
+	byTeam := make(map[string]fixturedifficulty.TeamStrength, len(items))
+	for _, item := range items {
+		byTeam[item.TeamID] = item
+		if item.BasedOnGameweek != 2 {
+			t.Fatalf("expected strengths based on gameweek 2, got %+v", item)
+		}
+		if item.HomeStrength < item.AwayStrength {
+			t.Fatalf("expected home strength not below away strength, got %+v", item)
+		}
+		if item.HomeDifficulty < fixturedifficulty.MinDifficulty || item.HomeDifficulty > fixturedifficulty.MaxDifficulty {
+			t.Fatalf("home difficulty out of range: %+v", item)
+		}
+	}
+	if byTeam["t-1"].HomeDifficulty != fixturedifficulty.MaxDifficulty {
+		t.Fatalf("expected league leader at home to be hardest, got %+v", byTeam["t-1"])
+	}
+	if byTeam["t-4"].AwayDifficulty != fixturedifficulty.MinDifficulty {
+		t.Fatalf("expected bottom side away to be easiest, got %+v", byTeam["t-4"])
+	}
+	if byTeam["t-2"].HomeStrength <= byTeam["t-3"].HomeStrength {
+		t.Fatalf("expected second placed side stronger than third, got %+v vs %+v", byTeam["t-2"], byTeam["t-3"])
+	}
+
+	if err := svc.EnsureFresh(ctx, leagueID, 2); err != nil {
+		t.Fatalf("ensure fresh: %v", err)
+	}
+	difficultyRepo.items[0].HomeDifficulty = 0
+	if err := svc.EnsureFresh(ctx, leagueID, 2); err != nil {
+		t.Fatalf("ensure fresh: %v", err)
+	}
+	if difficultyRepo.items[0].HomeDifficulty != 0 {
+		t.Fatal("expected no refresh while strengths are current")
+	}
+	if err := svc.EnsureFresh(ctx, leagueID, 3); err != nil {
+		t.Fatalf("ensure fresh: %v", err)
+	}
+	if difficultyRepo.items[0].HomeDifficulty == 0 || difficultyRepo.items[0].BasedOnGameweek != 3 {
+		t.Fatalf("expected refresh after a newer finalized gameweek, got %+v", difficultyRepo.items[0])
+	}
+}
+
+func TestFixtureDifficultyService_GetTicker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	svc, _ := newDifficultyTestService(leagueID)
+
+	ticker, err := svc.GetTicker(ctx, leagueID, 2)
+	if err != nil {
+		t.Fatalf("get ticker without strengths: %v", err)
+	}
+	if ticker.FromGameweek != 2 || ticker.ToGameweek != 3 {
+		t.Fatalf("expected gameweeks 2-3, got %d-%d", ticker.FromGameweek, ticker.ToGameweek)
+	}
+	for _, team := range ticker.Teams {
+		for _, item := range team.Fixtures {
+			if item.Difficulty != fixturedifficulty.NeutralDifficulty {
+				t.Fatalf("expected neutral difficulty before any refresh, got %+v", item)
+			}
+		}
+	}
+
+	strengths, err := svc.Refresh(ctx, leagueID, 1)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	ticker, err = svc.GetTicker(ctx, leagueID, 2)
+	if err != nil {
+		t.Fatalf("get ticker: %v", err)
+	}
+	if len(ticker.Teams) != 4 {
+		t.Fatalf("expected every team in the ticker, got %d", len(ticker.Teams))
+	}
+
+	t1 := ticker.Teams[0]
+	if t1.TeamID != "t-1" || len(t1.Fixtures) != 1 {
+		t.Fatalf("expected t-1 to have only its unfinished gameweek 3 fixture, got %+v", t1)
+	}
+	var t2Strength fixturedifficulty.TeamStrength
+	for _, item := range strengths {
+		if item.TeamID == "t-2" {
+			t2Strength = item
+		}
+	}
+	got := t1.Fixtures[0]
+	if got.FixtureID != "f-5" || !got.IsHome || got.OpponentTeamID != "t-2" || got.Difficulty != t2Strength.AwayDifficulty {
+		t.Fatalf("unexpected t-1 fixture: %+v", got)
+	}
+
+	t2 := ticker.Teams[1]
+	if len(t2.Fixtures) != 2 || t2.Fixtures[0].Gameweek != 2 || t2.Fixtures[1].Gameweek != 3 {
+		t.Fatalf("expected t-2 fixtures in gameweeks 2 and 3, got %+v", t2.Fixtures)
+	}
+	if t2.Fixtures[1].IsHome || t2.Fixtures[1].OpponentTeamID != "t-1" {
+		t.Fatalf("expected t-2 away at t-1 in gameweek 3, got %+v", t2.Fixtures[1])
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/idempotency"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+)
+
+const (
+	maxIdempotencyKeyLength  = 255
+	idempotencyPurgeInterval = 10 * time.Minute
+)
+
+type BeginIdempotentRequestInput struct {
+	UserID      string
+	Key         string
+	Method      string
+	Path        string
+	RequestHash string
+}
+
+// IdempotencyService stores the response of a mutating request under the
+// client's Idempotency-Key so a retry gets the same answer instead of
+// running the change twice.
+type IdempotencyService struct {
+	repo   idempotency.Repository
+	ttl    time.Duration
+	logger *logging.Logger
+	now    func() time.Time
+
+	purgeMu   sync.Mutex
+	lastPurge time.Time
+}
+
+func NewIdempotencyService(repo idempotency.Repository, ttl time.Duration, logger *logging.Logger) *IdempotencyService {
+	if logger == nil {
+		logger = logging.Default()
+	}
+	return &IdempotencyService{repo: repo, ttl: ttl, logger: logger, now: time.Now}
+}
+
+// Begin reserves the key for a new request, or returns the stored record
+// with replay set when the same request was already answered. Reusing a key
+// for a different request, or while its first request is still running, is
+// a conflict.
+func (s *IdempotencyService) Begin(ctx context.Context, input BeginIdempotentRequestInput) (idempotency.Record, bool, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.IdempotencyService.Begin")
+	defer span.End()
+
+	input.Key = strings.TrimSpace(input.Key)
+	if input.Key == "" || len(input.Key) > maxIdempotencyKeyLength {
+		return idempotency.Record{}, false, fmt.Errorf("%w: idempotency key must be 1-%d characters", ErrInvalidInput, maxIdempotencyKeyLength)
+	}
+	if strings.TrimSpace(input.UserID) == "" {
+		return idempotency.Record{}, false, fmt.Errorf("%w: user id is required", ErrInvalidInput)
+	}
+
+	now := s.now().UTC()
+	s.purgeExpired(ctx, now)
+
+	record := idempotency.Record{
+		UserID:      input.UserID,
+		Key:         input.Key,
+		Method:      input.Method,
+		Path:        input.Path,
+		RequestHash: input.RequestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.ttl),
+	}
+	stored, reserved, err := s.repo.Reserve(ctx, record)
+	if err != nil {
+		return idempotency.Record{}, false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	if reserved {
+		return stored, false, nil
+	}
+	if stored.RequestHash != input.RequestHash {
+		return idempotency.Record{}, false, fmt.Errorf("%w: idempotency key %s was used for a different request", ErrConflict, input.Key)
+	}
+	if !stored.Completed() {
+		return idempotency.Record{}, false, fmt.Errorf("%w: request with idempotency key %s is still in progress", ErrConflict, input.Key)
+	}
+	return stored, true, nil
+}
+
+// Complete stores the response of a reserved request for later replays.
+func (s *IdempotencyService) Complete(ctx context.Context, record idempotency.Record) error {
+	ctx, span := startUsecaseSpan(ctx, "usecase.IdempotencyService.Complete")
+	defer span.End()
+
+	if err := s.repo.Complete(ctx, record); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release frees a reserved key whose request failed, so the client can retry
+// it.
+func (s *IdempotencyService) Release(ctx context.Context, record idempotency.Record) error {
+	ctx, span := startUsecaseSpan(ctx, "usecase.IdempotencyService.Release")
+	defer span.End()
+
+	if err := s.repo.Release(ctx, record.UserID, record.Key); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// purgeExpired drops expired keys at most once per interval. Expired keys
+// are already ignored by Reserve, so a failed purge is only logged.
+func (s *IdempotencyService) purgeExpired(ctx context.Context, now time.Time) {
+	s.purgeMu.Lock()
+	if now.Sub(s.lastPurge) < idempotencyPurgeInterval {
+		s.purgeMu.Unlock()
+		return
+	}
+	s.lastPurge = now
+	s.purgeMu.Unlock()
+
+	if _, err := s.repo.DeleteExpired(ctx, now); err != nil {
+		s.logger.WarnContext(ctx, "purge expired idempotency keys failed", "error", err)
+	}
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/idempotency"
+)
+
+type memoryIdempotencyRepository struct {
+	records map[string]idempotency.Record
+	purged  int
+}
+
+func newMemoryIdempotencyRepository() *memoryIdempotencyRepository {
+	return &memoryIdempotencyRepository{records: make(map[string]idempotency.Record)}
+}
+
+func (r *memoryIdempotencyRepository) Reserve(_ context.Context, record idempotency.Record) (idempotency.Record, bool, error) {
+	id := record.UserID + "|" + record.Key
+	if existing, ok := r.records[id]; ok && existing.ExpiresAt.After(record.CreatedAt) {
+		return existing, false, nil
+	}
+	r.records[id] = record
+	return record, true, nil
+}
+
+func (r *memoryIdempotencyRepository) Complete(_ context.Context, record idempotency.Record) error {
+	r.records[record.UserID+"|"+record.Key] = record
+	return nil
+}
+
+func (r *memoryIdempotencyRepository) Release(_ context.Context, userID, key string) error {
+	delete(r.records, userID+"|"+key)
+	return nil
+}
+
+func (r *memoryIdempotencyRepository) DeleteExpired(_ context.Context, _ time.Time) (int64, error) {
+	r.purged++
+	return 0, nil
+}
+
+func TestIdempotencyService_Begin(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := newMemoryIdempotencyRepository()
+	svc := NewIdempotencyService(repo, time.Hour, nil)
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	input := BeginIdempotentRequestInput{UserID: "u-1", Key: " key-1 ", Method: "POST", Path: "/v1/custom-leagues", RequestHash: "h1"}
+	record, replay, err := svc.Begin(ctx, input)
+	if err != nil || replay || record.Key != "key-1" {
+		t.Fatalf("expected fresh reservation, got record=%+v replay=%t err=%v", record, replay, err)
+	}
+
+	if _, _, err := svc.Begin(ctx, input); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected in-flight retry to conflict, got %v", err)
+	}
+
+	record.StatusCode = 201
+	record.Body = []byte(`{"id":"group-1"}`)
+	if err := svc.Complete(ctx, record); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	stored, replay, err := svc.Begin(ctx, input)
+	if err != nil || !replay || stored.StatusCode != 201 || string(stored.Body) != `{"id":"group-1"}` {
+		t.Fatalf("expected stored response to be replayed, got record=%+v replay=%t err=%v", stored, replay, err)
+	}
+
+	other := input
+	other.RequestHash = "h2"
+	if _, _, err := svc.Begin(ctx, other); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected reused key with different body to conflict, got %v", err)
+	}
+	other.UserID = "u-2"
+	if _, replay, err := svc.Begin(ctx, other); err != nil || replay {
+		t.Fatalf("expected keys to be scoped per user, got replay=%t err=%v", replay, err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, replay, err := svc.Begin(ctx, input); err != nil || replay {
+		t.Fatalf("expected expired key to be reserved again, got replay=%t err=%v", replay, err)
+	}
+	if repo.purged != 2 {
+		t.Fatalf("expected expired keys to be purged once per interval, got %d purges", repo.purged)
+	}
+
+	if _, _, err := svc.Begin(ctx, BeginIdempotentRequestInput{UserID: "u-1", Key: " "}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected blank key to be rejected, got %v", err)
+	}
+}
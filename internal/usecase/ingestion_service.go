@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
 	"github.com/riskibarqy/fantasy-league/internal/domain/leaguestanding"
 	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
@@ -20,6 +21,7 @@ type IngestionService struct {
 	playerStatsRepo playerstats.Repository
 	teamStatsRepo   teamstats.Repository
 	rawDataRepo     rawdata.Repository
+	audit           *AuditRecorder
 }
 
 type fixtureIngestionWriter interface {
@@ -42,6 +44,10 @@ func NewIngestionService(
 	}
 }
 
+func (s *IngestionService) SetAuditRecorder(audit *AuditRecorder) {
+	s.audit = audit
+}
+
 func (s *IngestionService) UpsertFixtures(ctx context.Context, fixtures []fixture.Fixture) error {
 	ctx, span := startUsecaseSpan(ctx, "usecase.IngestionService.UpsertFixtures")
 	defer span.End()
@@ -70,6 +76,18 @@ func (s *IngestionService) UpsertFixtures(ctx context.Context, fixtures []fixtur
 	if err := s.fixtureWriter.UpsertFixtures(ctx, fixtures); err != nil {
 		return fmt.Errorf("upsert fixtures: %w", err)
 	}
+
+	if s.audit != nil {
+		fixtureIDsByLeague := make(map[string][]string)
+		for _, item := range fixtures {
+			fixtureIDsByLeague[item.LeagueID] = append(fixtureIDsByLeague[item.LeagueID], item.ID)
+		}
+		for leagueID, fixtureIDs := range fixtureIDsByLeague {
+			s.audit.Record(ctx, "ingestion.fixtures", auditlog.EntityLeague, leagueID, leagueID, nil, map[string]any{
+				"fixture_ids": fixtureIDs,
+			})
+		}
+	}
 	return nil
 }
 
@@ -99,6 +117,9 @@ func (s *IngestionService) UpsertPlayerFixtureStats(ctx context.Context, fixture
 	if err := s.playerStatsRepo.UpsertFixtureStats(ctx, fixtureID, cleaned); err != nil {
 		return fmt.Errorf("upsert player fixture stats: %w", err)
 	}
+	s.audit.Record(ctx, "ingestion.player_fixture_stats", auditlog.EntityFixture, fixtureID, "", nil, map[string]any{
+		"count": len(cleaned),
+	})
 	return nil
 }
 
@@ -124,6 +145,9 @@ func (s *IngestionService) UpsertTeamFixtureStats(ctx context.Context, fixtureID
 	if err := s.teamStatsRepo.UpsertFixtureStats(ctx, fixtureID, cleaned); err != nil {
 		return fmt.Errorf("upsert team fixture stats: %w", err)
 	}
+	s.audit.Record(ctx, "ingestion.team_fixture_stats", auditlog.EntityFixture, fixtureID, "", nil, map[string]any{
+		"count": len(cleaned),
+	})
 	return nil
 }
 
@@ -153,6 +177,9 @@ func (s *IngestionService) ReplaceFixtureEvents(ctx context.Context, fixtureID s
 	if err := s.playerStatsRepo.ReplaceFixtureEvents(ctx, fixtureID, cleaned); err != nil {
 		return fmt.Errorf("replace fixture events: %w", err)
 	}
+	s.audit.Record(ctx, "ingestion.fixture_events", auditlog.EntityFixture, fixtureID, "", nil, map[string]any{
+		"count": len(cleaned),
+	})
 	return nil
 }
 
@@ -240,5 +267,10 @@ func (s *IngestionService) ReplaceLeagueStandings(ctx context.Context, leagueID
 	if err := s.standingRepo.ReplaceByLeague(ctx, leagueID, live, gameweek, cleaned); err != nil {
 		return fmt.Errorf("replace league standings: %w", err)
 	}
+	s.audit.Record(ctx, "ingestion.league_standings", auditlog.EntityLeague, leagueID, leagueID, nil, map[string]any{
+		"live":     live,
+		"gameweek": gameweek,
+		"count":    len(cleaned),
+	})
 	return nil
 }
@@ -52,6 +52,11 @@ type LeagueDataSyncer interface {
 	SyncLive(ctx context.Context, league league.League) error
 }
 
+// LeagueNotifier sends the due manager notifications of a league.
+type LeagueNotifier interface {
+	NotifyLeague(ctx context.Context, leagueID string) error
+}
+
 type JobOrchestratorService struct {
 	leagueRepo   league.Repository
 	fixtureRepo  fixture.Repository
@@ -59,6 +64,7 @@ type JobOrchestratorService struct {
 	leagueSyncer LeagueDataSyncer
 	queue        JobQueue
 	dispatchRepo jobscheduler.Repository
+	notifier     LeagueNotifier
 	cfg          JobOrchestratorConfig
 	logger       *logging.Logger
 	now          func() time.Time
@@ -105,6 +111,12 @@ func NewJobOrchestratorService(
 	}
 }
 
+// SetLeagueNotifier sends manager notifications after every league sync and
+// wakes the schedule job up for deadline reminders.
+func (s *JobOrchestratorService) SetLeagueNotifier(notifier LeagueNotifier) {
+	s.notifier = notifier
+}
+
 func (s *JobOrchestratorService) RunScheduleSync(ctx context.Context, input JobSyncInput) (JobSyncResult, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.JobOrchestratorService.RunScheduleSync")
 	defer span.End()
@@ -188,6 +200,11 @@ func (s *JobOrchestratorService) run(ctx context.Context, mode string, input Job
 				s.logger.WarnContext(ctx, "ensure scoring up to date failed", "league_id", item.ID, "error", err)
 			}
 		}
+		if s.notifier != nil {
+			if err := s.notifier.NotifyLeague(ctx, item.ID); err != nil {
+				s.logger.WarnContext(ctx, "notify league managers failed", "league_id", item.ID, "error", err)
+			}
+		}
 		if !enqueueNext {
 			continue
 		}
@@ -220,7 +237,7 @@ func (s *JobOrchestratorService) run(ctx context.Context, mode string, input Job
 			result.QueuedOperations = append(result.QueuedOperations, "sync-live:"+item.ID)
 		}
 
-		scheduleDelay := s.nextScheduleDelay(now, hasLive, nearestUpcoming)
+		scheduleDelay := s.reminderDelay(now, nearestUpcoming, s.nextScheduleDelay(now, hasLive, nearestUpcoming))
 		if err := s.enqueueSchedule(ctx, item.ID, scheduleDelay, now); err != nil {
 			return JobSyncResult{}, err
 		}
@@ -238,7 +255,13 @@ func (s *JobOrchestratorService) pickLeagues(ctx context.Context, leagueID strin
 		if err != nil {
 			return nil, fmt.Errorf("list leagues for jobs: %w", err)
 		}
-		return items, nil
+		active := make([]league.League, 0, len(items))
+		for _, item := range items {
+			if !item.IsArchived() {
+				active = append(active, item)
+			}
+		}
+		return active, nil
 	}
 
 	item, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
@@ -248,6 +271,11 @@ func (s *JobOrchestratorService) pickLeagues(ctx context.Context, leagueID strin
 	if !exists {
 		return nil, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
 	}
+	// Jobs queued before a season rollover end quietly once the league is
+	// archived.
+	if item.IsArchived() {
+		return []league.League{}, nil
+	}
 
 	return []league.League{item}, nil
 }
@@ -405,6 +433,21 @@ func (s *JobOrchestratorService) nextScheduleDelay(now time.Time, hasLive bool,
 	return maxDuration(s.cfg.ScheduleInterval, 6*time.Hour)
 }
 
+// reminderDelay shortens delay so the schedule job runs when a deadline
+// reminder of the nearest kickoff becomes due.
+func (s *JobOrchestratorService) reminderDelay(now time.Time, nearestUpcoming *time.Time, delay time.Duration) time.Duration {
+	if s.notifier == nil || nearestUpcoming == nil {
+		return delay
+	}
+	for _, lead := range NotificationDeadlineLeads {
+		until := nearestUpcoming.Add(-lead).Sub(now)
+		if until > 0 && until < delay {
+			delay = maxDuration(until, time.Minute)
+		}
+	}
+	return delay
+}
+
 func maxDuration(left, right time.Duration) time.Duration {
 	if left > right {
 		return left
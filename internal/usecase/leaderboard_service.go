@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+)
+
+const (
+	defaultLeaderboardLimit = 20
+	maxLeaderboardLimit     = 100
+)
+
+// Leaderboard categories, ranked from the first-party player_fixture_stats.
+const (
+	LeaderboardGoals       = "goals"
+	LeaderboardAssists     = "assists"
+	LeaderboardCleanSheets = "clean_sheets"
+	LeaderboardSaves       = "saves"
+	LeaderboardYellowCards = "yellow_cards"
+	LeaderboardRedCards    = "red_cards"
+	LeaderboardBonus       = "bonus"
+	LeaderboardPoints      = "points"
+)
+
+// LeaderboardCategories lists every category in response order.
+var LeaderboardCategories = []string{
+	LeaderboardGoals,
+	LeaderboardAssists,
+	LeaderboardCleanSheets,
+	LeaderboardSaves,
+	LeaderboardYellowCards,
+	LeaderboardRedCards,
+	LeaderboardBonus,
+	LeaderboardPoints,
+}
+
+// LeaderboardService ranks league players by the fixture stats we score, so
+// the numbers always match the fantasy points of the same fixtures.
+type LeaderboardService struct {
+	leagueRepo      league.Repository
+	playerStatsRepo playerstats.Repository
+}
+
+// LeaderboardQuery selects the categories and gameweek range of a request.
+// An empty Category returns every category and zero gameweeks leave that
+// side of the range open.
+type LeaderboardQuery struct {
+	LeagueID     string
+	Category     string
+	FromGameweek int
+	ToGameweek   int
+	Limit        int
+}
+
+type Leaderboard struct {
+	Category string
+	Entries  []LeaderboardEntry
+}
+
+// LeaderboardEntry is one ranked player. Players level on Value share a
+// rank, and the next distinct value skips the shared places.
+type LeaderboardEntry struct {
+	Rank     int
+	PlayerID string
+	TeamID   string
+	Value    int
+	Stats    playerstats.SeasonStats
+}
+
+func NewLeaderboardService(leagueRepo league.Repository, playerStatsRepo playerstats.Repository) *LeaderboardService {
+	return &LeaderboardService{
+		leagueRepo:      leagueRepo,
+		playerStatsRepo: playerStatsRepo,
+	}
+}
+
+// List returns one leaderboard per requested category. Players without any
+// of the ranked stat are left out.
+func (s *LeaderboardService) List(ctx context.Context, query LeaderboardQuery) ([]Leaderboard, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.LeaderboardService.List")
+	defer span.End()
+
+	leagueID := strings.TrimSpace(query.LeagueID)
+	if leagueID == "" {
+		return nil, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	categories := LeaderboardCategories
+	if category := strings.ToLower(strings.TrimSpace(query.Category)); category != "" {
+		if leaderboardValue(category, playerstats.SeasonStats{}) < 0 {
+			return nil, fmt.Errorf("%w: unknown leaderboard category %q", ErrInvalidInput, category)
+		}
+		categories = []string{category}
+	}
+	if query.FromGameweek < 0 || query.ToGameweek < 0 {
+		return nil, fmt.Errorf("%w: gameweek must be positive", ErrInvalidInput)
+	}
+	if query.ToGameweek > 0 && query.FromGameweek > query.ToGameweek {
+		return nil, fmt.Errorf("%w: from gameweek must not be after to gameweek", ErrInvalidInput)
+	}
+	if query.Limit < 0 {
+		return nil, fmt.Errorf("%w: limit must be positive", ErrInvalidInput)
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+
+	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+
+	totals, err := s.playerStatsRepo.ListPlayerTotalsByLeague(ctx, leagueID, query.FromGameweek, query.ToGameweek)
+	if err != nil {
+		return nil, fmt.Errorf("list player totals: %w", err)
+	}
+
+	out := make([]Leaderboard, 0, len(categories))
+	for _, category := range categories {
+		out = append(out, Leaderboard{
+			Category: category,
+			Entries:  rankLeaderboard(category, totals, limit),
+		})
+	}
+	return out, nil
+}
+
+func rankLeaderboard(category string, totals []playerstats.PlayerTotals, limit int) []LeaderboardEntry {
+	entries := make([]LeaderboardEntry, 0, len(totals))
+	for _, item := range totals {
+		value := leaderboardValue(category, item.SeasonStats)
+		if value <= 0 {
+			continue
+		}
+		entries = append(entries, LeaderboardEntry{
+			PlayerID: item.PlayerID,
+			TeamID:   item.TeamID,
+			Value:    value,
+			Stats:    item.SeasonStats,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Value != entries[j].Value {
+			return entries[i].Value > entries[j].Value
+		}
+		if entries[i].Stats.TotalPoints != entries[j].Stats.TotalPoints {
+			return entries[i].Stats.TotalPoints > entries[j].Stats.TotalPoints
+		}
+		return entries[i].PlayerID < entries[j].PlayerID
+	})
+
+	for idx := range entries {
+		if idx > 0 && entries[idx].Value == entries[idx-1].Value {
+			entries[idx].Rank = entries[idx-1].Rank
+			continue
+		}
+		entries[idx].Rank = idx + 1
+	}
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// leaderboardValue returns the stat ranked by category, or -1 when the
+// category is unknown.
+func leaderboardValue(category string, stats playerstats.SeasonStats) int {
+	switch category {
+	case LeaderboardGoals:
+		return stats.Goals
+	case LeaderboardAssists:
+		return stats.Assists
+	case LeaderboardCleanSheets:
+		return stats.CleanSheets
+	case LeaderboardSaves:
+		return stats.Saves
+	case LeaderboardYellowCards:
+		return stats.YellowCards
+	case LeaderboardRedCards:
+		return stats.RedCards
+	case LeaderboardBonus:
+		return stats.BonusPoints
+	case LeaderboardPoints:
+		return stats.TotalPoints
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+)
+
+type stubLeaderboardPlayerStatsRepository struct {
+	playerstats.Repository
+	totals       []playerstats.PlayerTotals
+	fromGameweek int
+	toGameweek   int
+}
+
+func (s *stubLeaderboardPlayerStatsRepository) ListPlayerTotalsByLeague(_ context.Context, _ string, fromGameweek, toGameweek int) ([]playerstats.PlayerTotals, error) {
+	s.fromGameweek = fromGameweek
+	s.toGameweek = toGameweek
+	return s.totals, nil
+}
+
+func TestLeaderboardService_List(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		leagueID: {ID: leagueID, Name: "Liga 1 Indonesia"},
+	}}
+	statsRepo := &stubLeaderboardPlayerStatsRepository{totals: []playerstats.PlayerTotals{
+		{PlayerID: "p-1", TeamID: "t-1", SeasonStats: playerstats.SeasonStats{Goals: 3, Assists: 1, TotalPoints: 30}},
+		{PlayerID: "p-2", TeamID: "t-2", SeasonStats: playerstats.SeasonStats{Goals: 3, BonusPoints: 2, TotalPoints: 35}},
+		{PlayerID: "p-3", TeamID: "t-1", SeasonStats: playerstats.SeasonStats{Goals: 1, YellowCards: 2, TotalPoints: 12}},
+		{PlayerID: "p-4", TeamID: "t-2", SeasonStats: playerstats.SeasonStats{Saves: 9, TotalPoints: 12}},
+	}}
+	svc := NewLeaderboardService(leagues, statsRepo)
+
+	boards, err := svc.List(ctx, LeaderboardQuery{LeagueID: leagueID, Category: "Goals", FromGameweek: 2, ToGameweek: 5})
+	if err != nil {
+		t.Fatalf("list goals leaderboard: %v", err)
+	}
+	if statsRepo.fromGameweek != 2 || statsRepo.toGameweek != 5 {
+		t.Fatalf("expected gameweek range 2-5, got %d-%d", statsRepo.fromGameweek, statsRepo.toGameweek)
+	}
+	if len(boards) != 1 || boards[0].Category != LeaderboardGoals {
+		t.Fatalf("expected only the goals leaderboard, got %+v", boards)
+	}
+	entries := boards[0].Entries
+	if len(entries) != 3 {
+		t.Fatalf("expected players without goals to be left out, got %+v", entries)
+	}
+	if entries[0].PlayerID != "p-2" || entries[0].Rank != 1 || entries[1].PlayerID != "p-1" || entries[1].Rank != 1 {
+		t.Fatalf("expected tied scorers to share rank 1 ordered by points, got %+v", entries[:2])
+	}
+	if entries[2].PlayerID != "p-3" || entries[2].Rank != 3 {
+		t.Fatalf("expected third scorer at rank 3, got %+v", entries[2])
+	}
+
+	boards, err = svc.List(ctx, LeaderboardQuery{LeagueID: leagueID, Limit: 1})
+	if err != nil {
+		t.Fatalf("list every leaderboard: %v", err)
+	}
+	if len(boards) != len(LeaderboardCategories) {
+		t.Fatalf("expected %d leaderboards, got %d", len(LeaderboardCategories), len(boards))
+	}
+	for _, board := range boards {
+		if len(board.Entries) > 1 {
+			t.Fatalf("expected limit to cap %s, got %+v", board.Category, board.Entries)
+		}
+		if board.Category == LeaderboardSaves && board.Entries[0].PlayerID != "p-4" {
+			t.Fatalf("unexpected saves leader: %+v", board.Entries)
+		}
+		if board.Category == LeaderboardRedCards && len(board.Entries) != 0 {
+			t.Fatalf("expected empty red cards leaderboard, got %+v", board.Entries)
+		}
+	}
+
+	if _, err := svc.List(ctx, LeaderboardQuery{LeagueID: leagueID, Category: "tackles"}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected invalid input for unknown category, got %v", err)
+	}
+	if _, err := svc.List(ctx, LeaderboardQuery{LeagueID: leagueID, FromGameweek: 6, ToGameweek: 5}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected invalid input for reversed range, got %v", err)
+	}
+	if _, err := svc.List(ctx, LeaderboardQuery{LeagueID: "unknown"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for unknown league, got %v", err)
+	}
+}
@@ -55,5 +55,16 @@ func (s *LeagueService) ListTeamsByLeague(ctx context.Context, leagueID string)
 		return nil, fmt.Errorf("list teams by league: %w", err)
 	}
 
-	return teams, nil
+	return visibleTeams(teams), nil
+}
+
+// visibleTeams drops teams an admin has hidden from the catalog.
+func visibleTeams(items []team.Team) []team.Team {
+	out := make([]team.Team, 0, len(items))
+	for _, item := range items {
+		if !item.Hidden {
+			out = append(out, item)
+		}
+	}
+	return out
 }
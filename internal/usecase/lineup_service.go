@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
 	"github.com/riskibarqy/fantasy-league/internal/domain/league"
 	"github.com/riskibarqy/fantasy-league/internal/domain/lineup"
@@ -45,6 +46,7 @@ type LineupService struct {
 	lineupRepo lineup.Repository
 	squadRepo  fantasy.Repository
 	scorer     leagueScoringUpdater
+	audit      *AuditRecorder
 	now        func() time.Time
 }
 
@@ -67,6 +69,10 @@ func (s *LineupService) SetScoringUpdater(scorer leagueScoringUpdater) {
 	s.scorer = scorer
 }
 
+func (s *LineupService) SetAuditRecorder(audit *AuditRecorder) {
+	s.audit = audit
+}
+
 func (s *LineupService) GetByUserAndLeague(ctx context.Context, userID, leagueID string) (lineup.Lineup, bool, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.LineupService.GetByUserAndLeague")
 	defer span.End()
@@ -297,21 +303,48 @@ func (s *LineupService) Save(ctx context.Context, input SaveLineupInput) (lineup
 		UpdatedAt:     s.now().UTC(),
 	}
 
+	var before map[string]any
+	if s.audit != nil {
+		previous, exists, err := s.lineupRepo.GetByUserAndLeague(ctx, input.UserID, input.LeagueID)
+		if err != nil {
+			return lineup.Lineup{}, fmt.Errorf("get lineup before save: %w", err)
+		}
+		if exists {
+			before = lineupAuditData(previous)
+		}
+	}
+
 	if err := s.lineupRepo.Upsert(ctx, item); err != nil {
 		return lineup.Lineup{}, fmt.Errorf("save lineup: %w", err)
 	}
+	s.audit.Record(ctx, "lineup.save", auditlog.EntityLineup, item.UserID, item.LeagueID, before, lineupAuditData(item))
 
 	return item, nil
 }
 
+func lineupAuditData(item lineup.Lineup) map[string]any {
+	return map[string]any{
+		"goalkeeper_id":   item.GoalkeeperID,
+		"defender_ids":    item.DefenderIDs,
+		"midfielder_ids":  item.MidfielderIDs,
+		"forward_ids":     item.ForwardIDs,
+		"substitute_ids":  item.SubstituteIDs,
+		"captain_id":      item.CaptainID,
+		"vice_captain_id": item.ViceCaptainID,
+	}
+}
+
 func (s *LineupService) validateLeague(ctx context.Context, leagueID string) error {
-	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	item, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
 	if err != nil {
 		return fmt.Errorf("get league by id: %w", err)
 	}
 	if !exists {
 		return fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
 	}
+	if item.IsArchived() {
+		return fmt.Errorf("%w: league=%s season %s is archived", ErrInvalidInput, leagueID, item.Season)
+	}
 
 	return nil
 }
@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/masterdata"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/team"
+)
+
+var leagueIDRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+const maxOverrideNoteLength = 500
+
+type CreateLeagueInput struct {
+	ActorUserID string
+	ID          string
+	Name        string
+	CountryCode string
+	Season      string
+	IsDefault   bool
+	LeagueRefID int64
+	SeasonRefID int64
+}
+
+// UpdateLeagueInput changes only the non-nil fields.
+type UpdateLeagueInput struct {
+	ActorUserID string
+	LeagueID    string
+	Name        *string
+	CountryCode *string
+	Season      *string
+	IsDefault   *bool
+	LeagueRefID *int64
+	SeasonRefID *int64
+}
+
+type SetTeamOverrideInput struct {
+	ActorUserID string
+	LeagueID    string
+	TeamID      string
+	Hidden      bool
+	Note        string
+}
+
+// SetPlayerOverrideInput replaces the override of one player. Nil fields keep
+// the provider value.
+type SetPlayerOverrideInput struct {
+	ActorUserID string
+	LeagueID    string
+	PlayerID    string
+	Position    *string
+	Price       *int64
+	Available   *bool
+	Hidden      bool
+	Note        string
+}
+
+// MasterDataService lets admins edit leagues and override provider teams and
+// players. Every change is written together with its audit entry, which the
+// shared AuditRecorder builds.
+type MasterDataService struct {
+	leagueRepo league.Repository
+	teamRepo   team.Repository
+	playerRepo player.Repository
+	repo       masterdata.Repository
+	audit      *AuditRecorder
+	now        func() time.Time
+}
+
+func NewMasterDataService(
+	leagueRepo league.Repository,
+	teamRepo team.Repository,
+	playerRepo player.Repository,
+	repo masterdata.Repository,
+) *MasterDataService {
+	return &MasterDataService{
+		leagueRepo: leagueRepo,
+		teamRepo:   teamRepo,
+		playerRepo: playerRepo,
+		repo:       repo,
+		now:        time.Now,
+	}
+}
+
+func (s *MasterDataService) SetAuditRecorder(audit *AuditRecorder) {
+	s.audit = audit
+}
+
+func (s *MasterDataService) CreateLeague(ctx context.Context, input CreateLeagueInput) (league.League, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.MasterDataService.CreateLeague")
+	defer span.End()
+
+	item := league.League{
+		ID:          strings.TrimSpace(input.ID),
+		Name:        strings.TrimSpace(input.Name),
+		CountryCode: strings.ToUpper(strings.TrimSpace(input.CountryCode)),
+		Season:      strings.TrimSpace(input.Season),
+		IsDefault:   input.IsDefault,
+		LeagueRefID: input.LeagueRefID,
+		SeasonRefID: input.SeasonRefID,
+	}
+	if err := validateAdminLeague(item); err != nil {
+		return league.League{}, err
+	}
+
+	_, exists, err := s.leagueRepo.GetByID(ctx, item.ID)
+	if err != nil {
+		return league.League{}, fmt.Errorf("get league: %w", err)
+	}
+	if exists {
+		return league.League{}, fmt.Errorf("%w: league %s already exists", ErrConflict, item.ID)
+	}
+
+	entry := s.audit.Entry(ctx, "league.create", auditlog.EntityLeague, item.ID, item.ID, nil, leagueAuditData(item))
+	if err := s.repo.SaveLeague(ctx, item, entry); err != nil {
+		return league.League{}, fmt.Errorf("save league: %w", err)
+	}
+	return item, nil
+}
+
+func (s *MasterDataService) UpdateLeague(ctx context.Context, input UpdateLeagueInput) (league.League, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.MasterDataService.UpdateLeague")
+	defer span.End()
+
+	current, err := s.getLeague(ctx, input.LeagueID)
+	if err != nil {
+		return league.League{}, err
+	}
+
+	item := current
+	if input.Name != nil {
+		item.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.CountryCode != nil {
+		item.CountryCode = strings.ToUpper(strings.TrimSpace(*input.CountryCode))
+	}
+	if input.Season != nil {
+		item.Season = strings.TrimSpace(*input.Season)
+	}
+	if input.IsDefault != nil {
+		item.IsDefault = *input.IsDefault
+	}
+	if input.LeagueRefID != nil {
+		item.LeagueRefID = *input.LeagueRefID
+	}
+	if input.SeasonRefID != nil {
+		item.SeasonRefID = *input.SeasonRefID
+	}
+	if err := validateAdminLeague(item); err != nil {
+		return league.League{}, err
+	}
+
+	entry := s.audit.Entry(ctx, "league.update", auditlog.EntityLeague, item.ID, item.ID, leagueAuditData(current), leagueAuditData(item))
+	if err := s.repo.SaveLeague(ctx, item, entry); err != nil {
+		return league.League{}, fmt.Errorf("save league: %w", err)
+	}
+	return item, nil
+}
+
+func (s *MasterDataService) SetTeamOverride(ctx context.Context, input SetTeamOverrideInput) (masterdata.TeamOverride, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.MasterDataService.SetTeamOverride")
+	defer span.End()
+
+	input.TeamID = strings.TrimSpace(input.TeamID)
+	input.Note = strings.TrimSpace(input.Note)
+	if input.TeamID == "" {
+		return masterdata.TeamOverride{}, fmt.Errorf("%w: team id is required", ErrInvalidInput)
+	}
+	if len(input.Note) > maxOverrideNoteLength {
+		return masterdata.TeamOverride{}, fmt.Errorf("%w: note must be at most %d characters", ErrInvalidInput, maxOverrideNoteLength)
+	}
+	lg, err := s.getLeague(ctx, input.LeagueID)
+	if err != nil {
+		return masterdata.TeamOverride{}, err
+	}
+
+	current, exists, err := s.teamRepo.GetByID(ctx, lg.ID, input.TeamID)
+	if err != nil {
+		return masterdata.TeamOverride{}, fmt.Errorf("get team: %w", err)
+	}
+	if !exists {
+		return masterdata.TeamOverride{}, fmt.Errorf("%w: team=%s league=%s", ErrNotFound, input.TeamID, lg.ID)
+	}
+
+	item := masterdata.TeamOverride{
+		LeagueID:  lg.ID,
+		TeamID:    current.ID,
+		Hidden:    input.Hidden,
+		Note:      input.Note,
+		UpdatedBy: input.ActorUserID,
+		UpdatedAt: s.now().UTC(),
+	}
+	entry := s.audit.Entry(ctx, "team.override", auditlog.EntityTeam, current.ID, lg.ID,
+		teamAuditData(current), teamAuditData(item.Apply(current)))
+	entry.After["note"] = item.Note
+	if err := s.repo.SaveTeamOverride(ctx, item, entry); err != nil {
+		return masterdata.TeamOverride{}, fmt.Errorf("save team override: %w", err)
+	}
+	return item, nil
+}
+
+func (s *MasterDataService) SetPlayerOverride(ctx context.Context, input SetPlayerOverrideInput) (masterdata.PlayerOverride, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.MasterDataService.SetPlayerOverride")
+	defer span.End()
+
+	input.PlayerID = strings.TrimSpace(input.PlayerID)
+	input.Note = strings.TrimSpace(input.Note)
+	if input.PlayerID == "" {
+		return masterdata.PlayerOverride{}, fmt.Errorf("%w: player id is required", ErrInvalidInput)
+	}
+	if len(input.Note) > maxOverrideNoteLength {
+		return masterdata.PlayerOverride{}, fmt.Errorf("%w: note must be at most %d characters", ErrInvalidInput, maxOverrideNoteLength)
+	}
+	item := masterdata.PlayerOverride{
+		PlayerID:  input.PlayerID,
+		Available: input.Available,
+		Hidden:    input.Hidden,
+		Note:      input.Note,
+		UpdatedBy: input.ActorUserID,
+		UpdatedAt: s.now().UTC(),
+	}
+	if input.Position != nil {
+		position := player.Position(strings.ToUpper(strings.TrimSpace(*input.Position)))
+		if _, ok := player.AllPositions[position]; !ok {
+			return masterdata.PlayerOverride{}, fmt.Errorf("%w: invalid position %q", ErrInvalidInput, *input.Position)
+		}
+		item.Position = &position
+	}
+	if input.Price != nil {
+		if *input.Price <= 0 {
+			return masterdata.PlayerOverride{}, fmt.Errorf("%w: price must be positive", ErrInvalidInput)
+		}
+		price := *input.Price
+		item.Price = &price
+	}
+
+	lg, err := s.getLeague(ctx, input.LeagueID)
+	if err != nil {
+		return masterdata.PlayerOverride{}, err
+	}
+	item.LeagueID = lg.ID
+
+	players, err := s.playerRepo.GetByIDs(ctx, lg.ID, []string{input.PlayerID})
+	if err != nil {
+		return masterdata.PlayerOverride{}, fmt.Errorf("get player: %w", err)
+	}
+	if len(players) == 0 {
+		return masterdata.PlayerOverride{}, fmt.Errorf("%w: player=%s league=%s", ErrNotFound, input.PlayerID, lg.ID)
+	}
+	current := players[0]
+
+	entry := s.audit.Entry(ctx, "player.override", auditlog.EntityPlayer, current.ID, lg.ID,
+		playerAuditData(current), playerAuditData(item.Apply(current)))
+	entry.After["note"] = item.Note
+	if err := s.repo.SavePlayerOverride(ctx, item, entry); err != nil {
+		return masterdata.PlayerOverride{}, fmt.Errorf("save player override: %w", err)
+	}
+	return item, nil
+}
+
+func (s *MasterDataService) ListOverrides(ctx context.Context, leagueID string) (masterdata.Overrides, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.MasterDataService.ListOverrides")
+	defer span.End()
+
+	lg, err := s.getLeague(ctx, leagueID)
+	if err != nil {
+		return masterdata.Overrides{}, err
+	}
+	out, err := s.repo.ListOverrides(ctx, lg.ID)
+	if err != nil {
+		return masterdata.Overrides{}, fmt.Errorf("list master data overrides: %w", err)
+	}
+	return out, nil
+}
+
+func (s *MasterDataService) getLeague(ctx context.Context, leagueID string) (league.League, error) {
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return league.League{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	lg, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return league.League{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return league.League{}, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+	return lg, nil
+}
+
+func validateAdminLeague(item league.League) error {
+	if err := item.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	if !leagueIDRegex.MatchString(item.ID) {
+		return fmt.Errorf("%w: league id must be lowercase letters, digits and dashes", ErrInvalidInput)
+	}
+	if n := len(item.CountryCode); n < 2 || n > 3 {
+		return fmt.Errorf("%w: country code must be 2 or 3 letters", ErrInvalidInput)
+	}
+	if item.LeagueRefID < 0 || item.SeasonRefID < 0 {
+		return fmt.Errorf("%w: provider reference ids must not be negative", ErrInvalidInput)
+	}
+	return nil
+}
+
+func leagueAuditData(item league.League) map[string]any {
+	return map[string]any{
+		"name":          item.Name,
+		"country_code":  item.CountryCode,
+		"season":        item.Season,
+		"is_default":    item.IsDefault,
+		"league_ref_id": item.LeagueRefID,
+		"season_ref_id": item.SeasonRefID,
+	}
+}
+
+func teamAuditData(item team.Team) map[string]any {
+	return map[string]any{
+		"hidden": item.Hidden,
+	}
+}
+
+func playerAuditData(item player.Player) map[string]any {
+	return map[string]any{
+		"position":  string(item.Position),
+		"price":     item.Price,
+		"available": !item.Unavailable,
+		"hidden":    item.Hidden,
+	}
+}
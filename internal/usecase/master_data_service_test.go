@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/masterdata"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/user"
+	"github.com/riskibarqy/fantasy-league/internal/infrastructure/repository/memory"
+)
+
+type stubMasterDataRepository struct {
+	masterdata.Repository
+	leagues []league.League
+	players []masterdata.PlayerOverride
+	entries []auditlog.Entry
+}
+
+func (s *stubMasterDataRepository) SaveLeague(_ context.Context, item league.League, entry auditlog.Entry) error {
+	s.leagues = append(s.leagues, item)
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *stubMasterDataRepository) SavePlayerOverride(_ context.Context, item masterdata.PlayerOverride, entry auditlog.Entry) error {
+	s.players = append(s.players, item)
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func newTestMasterDataService(repo masterdata.Repository) *MasterDataService {
+	svc := NewMasterDataService(
+		memory.NewLeagueRepository(memory.SeedLeagues()),
+		memory.NewTeamRepository(memory.SeedTeams()),
+		memory.NewPlayerRepository(memory.SeedPlayers()),
+		repo,
+	)
+	svc.SetAuditRecorder(NewAuditRecorder(nil, nil))
+	return svc
+}
+
+func TestMasterDataService_CreateLeague(t *testing.T) {
+	t.Parallel()
+
+	ctx := user.WithPrincipal(context.Background(), user.Principal{UserID: "admin-1"})
+	repo := &stubMasterDataRepository{}
+	svc := newTestMasterDataService(repo)
+
+	_, err := svc.CreateLeague(ctx, CreateLeagueInput{ActorUserID: "admin-1", ID: memory.LeagueIDLiga1Indonesia, Name: "Liga 1", CountryCode: "ID", Season: "2025/2026"})
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected existing league to conflict, got %v", err)
+	}
+	_, err = svc.CreateLeague(ctx, CreateLeagueInput{ActorUserID: "admin-1", ID: "Liga 2", Name: "Liga 2", CountryCode: "ID", Season: "2026"})
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected malformed league id to be rejected, got %v", err)
+	}
+
+	item, err := svc.CreateLeague(ctx, CreateLeagueInput{ActorUserID: "admin-1", ID: "idn-liga-2-2026", Name: "Liga 2", CountryCode: "id", Season: "2026"})
+	if err != nil {
+		t.Fatalf("create league: %v", err)
+	}
+	if item.CountryCode != "ID" || len(repo.leagues) != 1 {
+		t.Fatalf("unexpected league %+v, saved %d", item, len(repo.leagues))
+	}
+	entry := repo.entries[0]
+	if entry.Action != "league.create" || entry.ActorUserID != "admin-1" || entry.Before != nil || entry.After["name"] != "Liga 2" {
+		t.Fatalf("unexpected audit entry %+v", entry)
+	}
+}
+
+func TestMasterDataService_SetPlayerOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx := user.WithPrincipal(context.Background(), user.Principal{UserID: "admin-1"})
+	repo := &stubMasterDataRepository{}
+	svc := newTestMasterDataService(repo)
+
+	price := int64(95)
+	position := "fwd"
+	available := false
+	item, err := svc.SetPlayerOverride(ctx, SetPlayerOverrideInput{
+		ActorUserID: "admin-1",
+		LeagueID:    memory.LeagueIDLiga1Indonesia,
+		PlayerID:    "idn-def-04",
+		Position:    &position,
+		Price:       &price,
+		Available:   &available,
+	})
+	if err != nil {
+		t.Fatalf("set player override: %v", err)
+	}
+	if item.Position == nil || *item.Position != player.PositionForward || len(repo.players) != 1 {
+		t.Fatalf("unexpected override %+v", item)
+	}
+	entry := repo.entries[0]
+	if entry.Before["price"] != int64(80) || entry.After["price"] != int64(95) || entry.After["available"] != false {
+		t.Fatalf("unexpected audit before/after %+v -> %+v", entry.Before, entry.After)
+	}
+	if _, ok := entry.After["hidden"]; ok || entry.ActorUserID != "admin-1" {
+		t.Fatalf("expected only changed fields from the request principal, got %+v", entry)
+	}
+
+	_, err = svc.SetPlayerOverride(ctx, SetPlayerOverrideInput{LeagueID: memory.LeagueIDLiga1Indonesia, PlayerID: "missing"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected unknown player to be not found, got %v", err)
+	}
+}
+
+func TestMapExternalPlayersToDomain_AppliesOverrides(t *testing.T) {
+	t.Parallel()
+
+	price := int64(120)
+	available := false
+	overrides := masterDataOverrides{
+		players: map[string]masterdata.PlayerOverride{
+			"sm-lg-player-10": {PlayerID: "sm-lg-player-10", Price: &price, Available: &available, Hidden: true},
+		},
+	}
+	teams := teamMappings{source: SportDataSourceSportMonks, byRefID: map[int64]string{1: "team-1"}}
+	players := playerMappings{byRefID: map[int64]string{10: "sm-lg-player-10"}}
+
+	out := mapExternalPlayersToDomain("lg", []ExternalPlayer{
+		{ExternalID: 10, TeamExternalID: 1, Name: "A", Position: "Attacker", Price: 60},
+		{ExternalID: 11, TeamExternalID: 1, Name: "B", Position: "Attacker", Price: 60},
+	}, teams, players, overrides)
+	if len(out) != 2 {
+		t.Fatalf("expected 2 players, got %d", len(out))
+	}
+	if out[0].Price != 120 || !out[0].Hidden || !out[0].Unavailable {
+		t.Fatalf("expected override applied, got %+v", out[0])
+	}
+	if out[1].Price != 60 || out[1].Hidden || out[1].Unavailable {
+		t.Fatalf("expected provider values kept, got %+v", out[1])
+	}
+}
@@ -0,0 +1,621 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/lineup"
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+)
+
+// NotificationDeadlineLeads are the times before a gameweek deadline at which
+// managers get a reminder, longest first.
+var NotificationDeadlineLeads = []time.Duration{24 * time.Hour, time.Hour}
+
+// customLeagueJoinLookback bounds how old a membership can be and still be
+// announced, so enabling notifications does not replay every past join.
+const customLeagueJoinLookback = 7 * 24 * time.Hour
+
+// Notifier delivers a message over one channel. Implementations live in
+// external/notify.
+type Notifier interface {
+	Channel() notification.Channel
+	Notify(ctx context.Context, recipient onboarding.NotificationPreferences, msg notification.Message) error
+}
+
+// NotificationService builds the manager notifications of a league and sends
+// them through every configured Notifier the recipient has enabled.
+type NotificationService struct {
+	leagueRepo   league.Repository
+	fixtureRepo  fixture.Repository
+	squadRepo    fantasy.Repository
+	lineupRepo   lineup.Repository
+	playerRepo   player.Repository
+	scoringRepo  scoring.Repository
+	groupRepo    customleague.Repository
+	prefsRepo    onboarding.NotificationPreferencesRepository
+	deliveryRepo notification.Repository
+	notifiers    []Notifier
+	logger       *logging.Logger
+	now          func() time.Time
+}
+
+type UpdateNotificationPreferencesInput struct {
+	UserID string
+	// DefaultEmail is used when Email is empty, usually the account email.
+	DefaultEmail     string
+	Email            string
+	EmailEnabled     bool
+	PushEnabled      bool
+	PushSubscription onboarding.PushSubscription
+	WebhookURL       string
+	WebhookEnabled   bool
+	MutedEvents      []string
+}
+
+func NewNotificationService(
+	leagueRepo league.Repository,
+	fixtureRepo fixture.Repository,
+	squadRepo fantasy.Repository,
+	lineupRepo lineup.Repository,
+	playerRepo player.Repository,
+	scoringRepo scoring.Repository,
+	groupRepo customleague.Repository,
+	prefsRepo onboarding.NotificationPreferencesRepository,
+	deliveryRepo notification.Repository,
+	notifiers []Notifier,
+	logger *logging.Logger,
+) *NotificationService {
+	if logger == nil {
+		logger = logging.Default()
+	}
+	return &NotificationService{
+		leagueRepo:   leagueRepo,
+		fixtureRepo:  fixtureRepo,
+		squadRepo:    squadRepo,
+		lineupRepo:   lineupRepo,
+		playerRepo:   playerRepo,
+		scoringRepo:  scoringRepo,
+		groupRepo:    groupRepo,
+		prefsRepo:    prefsRepo,
+		deliveryRepo: deliveryRepo,
+		notifiers:    notifiers,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// GetPreferences returns the notification settings of a user. Users who never
+// saved any get everything disabled.
+func (s *NotificationService) GetPreferences(ctx context.Context, userID string) (onboarding.NotificationPreferences, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.NotificationService.GetPreferences")
+	defer span.End()
+
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("%w: user id is required", ErrInvalidInput)
+	}
+
+	prefs, exists, err := s.prefsRepo.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("get notification preferences: %w", err)
+	}
+	if !exists {
+		return onboarding.NotificationPreferences{UserID: userID, MutedEvents: []string{}}, nil
+	}
+	return prefs, nil
+}
+
+func (s *NotificationService) UpdatePreferences(ctx context.Context, input UpdateNotificationPreferencesInput) (onboarding.NotificationPreferences, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.NotificationService.UpdatePreferences")
+	defer span.End()
+
+	prefs := onboarding.NotificationPreferences{
+		UserID:         strings.TrimSpace(input.UserID),
+		Email:          strings.TrimSpace(input.Email),
+		EmailEnabled:   input.EmailEnabled,
+		PushEnabled:    input.PushEnabled,
+		WebhookURL:     strings.TrimSpace(input.WebhookURL),
+		WebhookEnabled: input.WebhookEnabled,
+		PushSubscription: onboarding.PushSubscription{
+			Endpoint: strings.TrimSpace(input.PushSubscription.Endpoint),
+			P256DH:   strings.TrimSpace(input.PushSubscription.P256DH),
+			Auth:     strings.TrimSpace(input.PushSubscription.Auth),
+		},
+		MutedEvents: uniqueTrimmed(input.MutedEvents),
+	}
+	if prefs.UserID == "" {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("%w: user id is required", ErrInvalidInput)
+	}
+	if prefs.Email == "" {
+		prefs.Email = strings.TrimSpace(input.DefaultEmail)
+	}
+	if prefs.Email != "" {
+		if _, err := mail.ParseAddress(prefs.Email); err != nil {
+			return onboarding.NotificationPreferences{}, fmt.Errorf("%w: invalid email address", ErrInvalidInput)
+		}
+	}
+	if prefs.EmailEnabled && prefs.Email == "" {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("%w: email is required to enable email notifications", ErrInvalidInput)
+	}
+	if prefs.PushSubscription.Endpoint != "" && !isHTTPSURL(prefs.PushSubscription.Endpoint) {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("%w: push endpoint must be an https url", ErrInvalidInput)
+	}
+	if prefs.PushEnabled && prefs.PushSubscription.IsZero() {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("%w: push subscription is required to enable push notifications", ErrInvalidInput)
+	}
+	if prefs.WebhookURL != "" && !isHTTPSURL(prefs.WebhookURL) {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("%w: webhook url must be an https url", ErrInvalidInput)
+	}
+	if prefs.WebhookEnabled && prefs.WebhookURL == "" {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("%w: webhook url is required to enable webhook notifications", ErrInvalidInput)
+	}
+	for _, event := range prefs.MutedEvents {
+		if _, ok := notification.AllEvents[notification.Event(event)]; !ok {
+			return onboarding.NotificationPreferences{}, fmt.Errorf("%w: unknown notification event %q", ErrInvalidInput, event)
+		}
+	}
+
+	if err := s.prefsRepo.UpsertNotificationPreferences(ctx, prefs); err != nil {
+		return onboarding.NotificationPreferences{}, fmt.Errorf("upsert notification preferences: %w", err)
+	}
+	return s.GetPreferences(ctx, prefs.UserID)
+}
+
+// NotifyLeague sends the due notifications of one league: deadline
+// reminders, the result of the latest finalized gameweek, unavailable
+// starters before the next deadline and new custom league members. Every
+// message is sent once per user.
+func (s *NotificationService) NotifyLeague(ctx context.Context, leagueID string) error {
+	ctx, span := startUsecaseSpan(ctx, "usecase.NotificationService.NotifyLeague")
+	defer span.End()
+
+	if len(s.notifiers) == 0 {
+		return nil
+	}
+	leagueID = strings.TrimSpace(leagueID)
+	lg, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+
+	fixtures, err := s.fixtureRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return fmt.Errorf("list fixtures for notifications: %w", err)
+	}
+	now := s.now().UTC()
+
+	var messages []notification.Message
+	nextGameweek, deadline, hasNext := nextGameweekDeadline(fixtures, now)
+	if hasNext {
+		reminders, err := s.deadlineMessages(ctx, lg, nextGameweek, deadline, now)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, reminders...)
+
+		unavailable, err := s.starterUnavailableMessages(ctx, lg, nextGameweek, deadline, now)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, unavailable...)
+	}
+
+	results, err := s.gameweekResultMessages(ctx, lg, fixtures)
+	if err != nil {
+		return err
+	}
+	messages = append(messages, results...)
+
+	joins, err := s.customLeagueJoinMessages(ctx, lg, now)
+	if err != nil {
+		return err
+	}
+	messages = append(messages, joins...)
+
+	return s.dispatch(ctx, messages, now)
+}
+
+func (s *NotificationService) deadlineMessages(ctx context.Context, lg league.League, gameweek int, deadline, now time.Time) ([]notification.Message, error) {
+	remaining := deadline.Sub(now)
+	event := notification.Event("")
+	for idx, lead := range NotificationDeadlineLeads {
+		if remaining <= lead {
+			event = deadlineEvent(idx)
+		}
+	}
+	if event == "" {
+		return nil, nil
+	}
+
+	squads, err := s.squadRepo.ListByLeague(ctx, lg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list squads for deadline reminders: %w", err)
+	}
+
+	hours := int(remaining.Round(time.Hour) / time.Hour)
+	when := fmt.Sprintf("in %d hours", hours)
+	if hours <= 1 {
+		when = "within the hour"
+	}
+	out := make([]notification.Message, 0, len(squads))
+	for _, squad := range squads {
+		out = append(out, notification.Message{
+			UserID: squad.UserID,
+			Event:  event,
+			Title:  fmt.Sprintf("%s gameweek %d deadline", lg.Name, gameweek),
+			Body:   fmt.Sprintf("Gameweek %d locks %s. Check your lineup and captain.", gameweek, when),
+			Data: map[string]any{
+				"league_id":   lg.ID,
+				"gameweek":    gameweek,
+				"deadline_at": deadline.Format(time.RFC3339),
+			},
+			DedupKey: fmt.Sprintf("%s:%s:%d", event, lg.ID, gameweek),
+		})
+	}
+	return out, nil
+}
+
+func deadlineEvent(leadIndex int) notification.Event {
+	if leadIndex == len(NotificationDeadlineLeads)-1 {
+		return notification.EventDeadline1h
+	}
+	return notification.EventDeadline24h
+}
+
+// starterUnavailableMessages warns managers whose starting lineup holds a
+// player marked unavailable, from 24 hours before the deadline. Availability
+// comes from the admin player override; the sports data sync carries no
+// injury feed, so injuries only show up once an admin marks the player.
+func (s *NotificationService) starterUnavailableMessages(ctx context.Context, lg league.League, gameweek int, deadline, now time.Time) ([]notification.Message, error) {
+	if deadline.Sub(now) > NotificationDeadlineLeads[0] {
+		return nil, nil
+	}
+
+	players, err := s.playerRepo.ListByLeague(ctx, lg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list players for availability: %w", err)
+	}
+	unavailable := make(map[string]player.Player)
+	for _, item := range players {
+		if item.Unavailable {
+			unavailable[item.ID] = item
+		}
+	}
+	if len(unavailable) == 0 {
+		return nil, nil
+	}
+
+	lineups, err := s.lineupRepo.ListByLeague(ctx, lg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list lineups for availability: %w", err)
+	}
+
+	var out []notification.Message
+	for _, item := range lineups {
+		starters := make([]string, 0, 11)
+		starters = append(starters, item.GoalkeeperID)
+		starters = append(starters, item.DefenderIDs...)
+		starters = append(starters, item.MidfielderIDs...)
+		starters = append(starters, item.ForwardIDs...)
+		for _, playerID := range starters {
+			p, ok := unavailable[playerID]
+			if !ok {
+				continue
+			}
+			out = append(out, notification.Message{
+				UserID: item.UserID,
+				Event:  notification.EventStarterUnavailable,
+				Title:  fmt.Sprintf("%s is unavailable", p.Name),
+				Body:   fmt.Sprintf("%s is in your starting lineup for gameweek %d but is unavailable. Consider a substitution before the deadline.", p.Name, gameweek),
+				Data: map[string]any{
+					"league_id": lg.ID,
+					"gameweek":  gameweek,
+					"player_id": p.ID,
+				},
+				DedupKey: fmt.Sprintf("%s:%s:%d:%s", notification.EventStarterUnavailable, lg.ID, gameweek, p.ID),
+			})
+		}
+	}
+	return out, nil
+}
+
+// gameweekResultMessages tells every manager with points in the latest
+// finalized gameweek their points and overall rank movement.
+func (s *NotificationService) gameweekResultMessages(ctx context.Context, lg league.League, fixtures []fixture.Fixture) ([]notification.Message, error) {
+	byGameweek := make(map[int][]fixture.Fixture)
+	for _, item := range fixtures {
+		if item.Gameweek > 0 {
+			byGameweek[item.Gameweek] = append(byGameweek[item.Gameweek], item)
+		}
+	}
+	latest := 0
+	for gameweek, items := range byGameweek {
+		if gameweek > latest && isFinalizedGameweek(items) {
+			latest = gameweek
+		}
+	}
+	if latest == 0 {
+		return nil, nil
+	}
+
+	rows, err := s.scoringRepo.ListUserGameweekPointsByLeague(ctx, lg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list user gameweek points for notifications: %w", err)
+	}
+	pointsByUser := make(map[string]int)
+	for _, row := range rows {
+		if row.Gameweek == latest {
+			pointsByUser[row.UserID] = row.Points
+		}
+	}
+	if len(pointsByUser) == 0 {
+		return nil, nil
+	}
+
+	rankNow := overallRanksThrough(rows, latest)
+	rankBefore := overallRanksThrough(rows, latest-1)
+
+	out := make([]notification.Message, 0, len(pointsByUser))
+	for userID, points := range pointsByUser {
+		rank := rankNow[userID]
+		movement := "new entry"
+		if before, ok := rankBefore[userID]; ok {
+			switch {
+			case before > rank:
+				movement = fmt.Sprintf("up %d", before-rank)
+			case before < rank:
+				movement = fmt.Sprintf("down %d", rank-before)
+			default:
+				movement = "unchanged"
+			}
+		}
+		data := map[string]any{
+			"league_id": lg.ID,
+			"gameweek":  latest,
+			"points":    points,
+			"rank":      rank,
+		}
+		if before, ok := rankBefore[userID]; ok {
+			data["previous_rank"] = before
+		}
+		out = append(out, notification.Message{
+			UserID:   userID,
+			Event:    notification.EventGameweekFinalized,
+			Title:    fmt.Sprintf("%s gameweek %d is final", lg.Name, latest),
+			Body:     fmt.Sprintf("You scored %d points. Overall rank %d (%s).", points, rank, movement),
+			Data:     data,
+			DedupKey: fmt.Sprintf("%s:%s:%d", notification.EventGameweekFinalized, lg.ID, latest),
+		})
+	}
+	return out, nil
+}
+
+// overallRanksThrough ranks users by their points summed up to and including
+// a gameweek. Users level on points share a rank.
+func overallRanksThrough(rows []scoring.UserGameweekPoints, gameweek int) map[string]int {
+	totals := make(map[string]int)
+	for _, row := range rows {
+		if row.Gameweek <= gameweek {
+			totals[row.UserID] += row.Points
+		}
+	}
+	userIDs := make([]string, 0, len(totals))
+	for userID := range totals {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool {
+		if totals[userIDs[i]] != totals[userIDs[j]] {
+			return totals[userIDs[i]] > totals[userIDs[j]]
+		}
+		return userIDs[i] < userIDs[j]
+	})
+
+	ranks := make(map[string]int, len(userIDs))
+	for idx, userID := range userIDs {
+		if idx > 0 && totals[userID] == totals[userIDs[idx-1]] {
+			ranks[userID] = ranks[userIDs[idx-1]]
+			continue
+		}
+		ranks[userID] = idx + 1
+	}
+	return ranks
+}
+
+// customLeagueJoinMessages tells custom league owners about members who
+// joined recently. Default leagues are skipped.
+func (s *NotificationService) customLeagueJoinMessages(ctx context.Context, lg league.League, now time.Time) ([]notification.Message, error) {
+	groups, err := s.groupRepo.ListGroupsByLeague(ctx, lg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom leagues for notifications: %w", err)
+	}
+	groupByID := make(map[string]customleague.Group, len(groups))
+	for _, group := range groups {
+		if !group.IsDefault && group.OwnerUserID != "" {
+			groupByID[group.ID] = group
+		}
+	}
+	if len(groupByID) == 0 {
+		return nil, nil
+	}
+
+	memberships, err := s.groupRepo.ListMembershipsByLeague(ctx, lg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom league memberships for notifications: %w", err)
+	}
+
+	var out []notification.Message
+	for _, item := range memberships {
+		group, ok := groupByID[item.GroupID]
+		if !ok || item.UserID == group.OwnerUserID || now.Sub(item.JoinedAt) > customLeagueJoinLookback {
+			continue
+		}
+		out = append(out, notification.Message{
+			UserID: group.OwnerUserID,
+			Event:  notification.EventCustomLeagueJoined,
+			Title:  fmt.Sprintf("New member in %s", group.Name),
+			Body:   fmt.Sprintf("A new manager joined your custom league %s.", group.Name),
+			Data: map[string]any{
+				"league_id":        lg.ID,
+				"custom_league_id": group.ID,
+				"member_user_id":   item.UserID,
+			},
+			DedupKey: fmt.Sprintf("%s:%s:%s", notification.EventCustomLeagueJoined, group.ID, item.UserID),
+		})
+	}
+	return out, nil
+}
+
+// dispatch sends each message over the channels its recipient enabled and
+// records it once any channel accepted it. Channel failures are logged and
+// retried on the next run.
+func (s *NotificationService) dispatch(ctx context.Context, messages []notification.Message, now time.Time) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	userIDs := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		userIDs = append(userIDs, msg.UserID)
+	}
+	prefsList, err := s.prefsRepo.ListNotificationPreferencesByUsers(ctx, uniqueTrimmed(userIDs))
+	if err != nil {
+		return fmt.Errorf("list notification preferences: %w", err)
+	}
+	prefsByUser := make(map[string]onboarding.NotificationPreferences, len(prefsList))
+	for _, item := range prefsList {
+		prefsByUser[item.UserID] = item
+	}
+
+	for _, msg := range messages {
+		prefs, ok := prefsByUser[msg.UserID]
+		if !ok {
+			prefs = onboarding.NotificationPreferences{UserID: msg.UserID}
+		}
+		if prefs.IsMuted(string(msg.Event)) {
+			continue
+		}
+		notifiers := make([]Notifier, 0, len(s.notifiers))
+		for _, notifier := range s.notifiers {
+			if channelEnabled(prefs, notifier.Channel()) {
+				notifiers = append(notifiers, notifier)
+			}
+		}
+		if len(notifiers) == 0 {
+			continue
+		}
+
+		sent, err := s.deliveryRepo.HasDelivery(ctx, msg.UserID, msg.DedupKey)
+		if err != nil {
+			return fmt.Errorf("check notification delivery: %w", err)
+		}
+		if sent {
+			continue
+		}
+
+		delivered := make([]notification.Channel, 0, len(notifiers))
+		for _, notifier := range notifiers {
+			if err := notifier.Notify(ctx, prefs, msg); err != nil {
+				s.logger.WarnContext(ctx, "send notification failed",
+					"user_id", msg.UserID,
+					"event", string(msg.Event),
+					"channel", string(notifier.Channel()),
+					"error", err,
+				)
+				continue
+			}
+			delivered = append(delivered, notifier.Channel())
+		}
+		if len(delivered) == 0 {
+			continue
+		}
+
+		if err := s.deliveryRepo.RecordDelivery(ctx, notification.Delivery{
+			UserID:   msg.UserID,
+			DedupKey: msg.DedupKey,
+			Event:    msg.Event,
+			Channels: delivered,
+			SentAt:   now,
+		}); err != nil {
+			return fmt.Errorf("record notification delivery: %w", err)
+		}
+	}
+	return nil
+}
+
+// channelEnabled reports whether a user can be reached on a channel. The
+// file sink is a local testing aid and receives every message.
+func channelEnabled(prefs onboarding.NotificationPreferences, channel notification.Channel) bool {
+	switch channel {
+	case notification.ChannelEmail:
+		return prefs.EmailEnabled && prefs.Email != ""
+	case notification.ChannelPush:
+		return prefs.PushEnabled && !prefs.PushSubscription.IsZero()
+	case notification.ChannelWebhook:
+		return prefs.WebhookEnabled && prefs.WebhookURL != ""
+	case notification.ChannelFile:
+		return true
+	default:
+		return false
+	}
+}
+
+// nextGameweekDeadline returns the first gameweek whose earliest kickoff is
+// still ahead.
+func nextGameweekDeadline(fixtures []fixture.Fixture, now time.Time) (int, time.Time, bool) {
+	byGameweek := make(map[int][]fixture.Fixture)
+	for _, item := range fixtures {
+		if item.Gameweek > 0 {
+			byGameweek[item.Gameweek] = append(byGameweek[item.Gameweek], item)
+		}
+	}
+
+	nextGameweek := 0
+	var nextDeadline time.Time
+	for gameweek, items := range byGameweek {
+		deadline, ok := minKickoff(items)
+		if !ok || !deadline.After(now) {
+			continue
+		}
+		if nextGameweek == 0 || deadline.Before(nextDeadline) {
+			nextGameweek = gameweek
+			nextDeadline = deadline
+		}
+	}
+	return nextGameweek, nextDeadline, nextGameweek > 0
+}
+
+func isHTTPSURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return parsed.Scheme == "https" && parsed.Host != ""
+}
+
+// PushPublicKey returns the VAPID public key browsers subscribe with, or an
+// empty string when web push is not configured.
+func (s *NotificationService) PushPublicKey() string {
+	for _, notifier := range s.notifiers {
+		if keyed, ok := notifier.(interface{ PublicKey() string }); ok && notifier.Channel() == notification.ChannelPush {
+			return keyed.PublicKey()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/lineup"
+	"github.com/riskibarqy/fantasy-league/internal/domain/notification"
+	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+)
+
+type stubNotificationSquadRepository struct {
+	fantasy.Repository
+	squads []fantasy.Squad
+}
+
+func (s *stubNotificationSquadRepository) ListByLeague(_ context.Context, _ string) ([]fantasy.Squad, error) {
+	return s.squads, nil
+}
+
+type stubNotificationLineupRepository struct {
+	lineup.Repository
+	lineups []lineup.Lineup
+}
+
+func (s *stubNotificationLineupRepository) ListByLeague(_ context.Context, _ string) ([]lineup.Lineup, error) {
+	return s.lineups, nil
+}
+
+type stubNotificationPlayerRepository struct {
+	player.Repository
+	players []player.Player
+}
+
+func (s *stubNotificationPlayerRepository) ListByLeague(_ context.Context, _ string) ([]player.Player, error) {
+	return s.players, nil
+}
+
+type stubNotificationScoringRepository struct {
+	scoring.Repository
+	rows []scoring.UserGameweekPoints
+}
+
+func (s *stubNotificationScoringRepository) ListUserGameweekPointsByLeague(_ context.Context, _ string) ([]scoring.UserGameweekPoints, error) {
+	return s.rows, nil
+}
+
+type stubNotificationGroupRepository struct {
+	customleague.Repository
+	groups      []customleague.Group
+	memberships []customleague.Membership
+}
+
+func (s *stubNotificationGroupRepository) ListGroupsByLeague(_ context.Context, _ string) ([]customleague.Group, error) {
+	return s.groups, nil
+}
+
+func (s *stubNotificationGroupRepository) ListMembershipsByLeague(_ context.Context, _ string) ([]customleague.Membership, error) {
+	return s.memberships, nil
+}
+
+type inMemoryNotificationPreferencesRepo struct {
+	byUser map[string]onboarding.NotificationPreferences
+}
+
+func (r *inMemoryNotificationPreferencesRepo) GetNotificationPreferences(_ context.Context, userID string) (onboarding.NotificationPreferences, bool, error) {
+	item, ok := r.byUser[userID]
+	return item, ok, nil
+}
+
+func (r *inMemoryNotificationPreferencesRepo) ListNotificationPreferencesByUsers(_ context.Context, userIDs []string) ([]onboarding.NotificationPreferences, error) {
+	out := make([]onboarding.NotificationPreferences, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if item, ok := r.byUser[userID]; ok {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+func (r *inMemoryNotificationPreferencesRepo) UpsertNotificationPreferences(_ context.Context, prefs onboarding.NotificationPreferences) error {
+	r.byUser[prefs.UserID] = prefs
+	return nil
+}
+
+type inMemoryNotificationDeliveryRepo struct {
+	deliveries map[string]notification.Delivery
+}
+
+func (r *inMemoryNotificationDeliveryRepo) HasDelivery(_ context.Context, userID, dedupKey string) (bool, error) {
+	_, ok := r.deliveries[userID+"|"+dedupKey]
+	return ok, nil
+}
+
+func (r *inMemoryNotificationDeliveryRepo) RecordDelivery(_ context.Context, delivery notification.Delivery) error {
+	r.deliveries[delivery.UserID+"|"+delivery.DedupKey] = delivery
+	return nil
+}
+
+type recordingNotifier struct {
+	channel notification.Channel
+	sent    []notification.Message
+}
+
+func (n *recordingNotifier) Channel() notification.Channel {
+	return n.channel
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, _ onboarding.NotificationPreferences, msg notification.Message) error {
+	n.sent = append(n.sent, msg)
+	return nil
+}
+
+func TestNotificationService_NotifyLeague(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	now := time.Date(2026, 3, 7, 11, 30, 0, 0, time.UTC)
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		leagueID: {ID: leagueID, Name: "Liga 1 Indonesia"},
+	}}
+	fixtures := &stubFixtureRepository{byLeague: map[string][]fixture.Fixture{
+		leagueID: {
+			{ID: "f-1", LeagueID: leagueID, Gameweek: 1, KickoffAt: now.Add(-7 * 24 * time.Hour), Status: fixture.StatusFinished},
+			{ID: "f-2", LeagueID: leagueID, Gameweek: 2, KickoffAt: now.Add(-3 * 24 * time.Hour), Status: fixture.StatusFinished},
+			{ID: "f-3", LeagueID: leagueID, Gameweek: 3, KickoffAt: now.Add(45 * time.Minute), Status: fixture.StatusScheduled},
+		},
+	}}
+	squads := &stubNotificationSquadRepository{squads: []fantasy.Squad{
+		{ID: "s-1", UserID: "u-1", LeagueID: leagueID},
+		{ID: "s-2", UserID: "u-2", LeagueID: leagueID},
+	}}
+	lineups := &stubNotificationLineupRepository{lineups: []lineup.Lineup{
+		{UserID: "u-1", LeagueID: leagueID, GoalkeeperID: "gk-1", ForwardIDs: []string{"fw-1"}},
+	}}
+	players := &stubNotificationPlayerRepository{players: []player.Player{
+		{ID: "gk-1", Name: "Keeper"},
+		{ID: "fw-1", Name: "Striker", Unavailable: true},
+	}}
+	scores := &stubNotificationScoringRepository{rows: []scoring.UserGameweekPoints{
+		{LeagueID: leagueID, Gameweek: 1, UserID: "u-1", Points: 60},
+		{LeagueID: leagueID, Gameweek: 1, UserID: "u-2", Points: 50},
+		{LeagueID: leagueID, Gameweek: 2, UserID: "u-1", Points: 40},
+		{LeagueID: leagueID, Gameweek: 2, UserID: "u-2", Points: 70},
+	}}
+	groups := &stubNotificationGroupRepository{
+		groups: []customleague.Group{
+			{ID: "g-1", LeagueID: leagueID, OwnerUserID: "u-1", Name: "Office"},
+		},
+		memberships: []customleague.Membership{
+			{GroupID: "g-1", UserID: "u-1", JoinedAt: now.Add(-48 * time.Hour)},
+			{GroupID: "g-1", UserID: "u-2", JoinedAt: now.Add(-time.Hour)},
+		},
+	}
+	prefs := &inMemoryNotificationPreferencesRepo{byUser: map[string]onboarding.NotificationPreferences{
+		"u-1": {UserID: "u-1", Email: "u1@example.com", EmailEnabled: true},
+		"u-2": {UserID: "u-2", Email: "u2@example.com", EmailEnabled: true, MutedEvents: []string{string(notification.EventDeadline1h)}},
+	}}
+	deliveries := &inMemoryNotificationDeliveryRepo{deliveries: make(map[string]notification.Delivery)}
+	email := &recordingNotifier{channel: notification.ChannelEmail}
+	push := &recordingNotifier{channel: notification.ChannelPush}
+
+	svc := NewNotificationService(leagues, fixtures, squads, lineups, players, scores, groups, prefs, deliveries, []Notifier{email, push}, nil)
+	svc.now = func() time.Time { return now }
+
+	if err := svc.NotifyLeague(ctx, leagueID); err != nil {
+		t.Fatalf("notify league: %v", err)
+	}
+	if len(push.sent) != 0 {
+		t.Fatalf("expected no push without subscriptions, got %+v", push.sent)
+	}
+
+	byKey := make(map[string]notification.Message, len(email.sent))
+	for _, msg := range email.sent {
+		byKey[msg.UserID+"|"+string(msg.Event)] = msg
+	}
+	if len(byKey) != len(email.sent) {
+		t.Fatalf("expected one message per user and event, got %+v", email.sent)
+	}
+	if _, ok := byKey["u-1|"+string(notification.EventDeadline1h)]; !ok {
+		t.Fatalf("expected 1h deadline reminder for u-1, got %+v", email.sent)
+	}
+	if _, ok := byKey["u-2|"+string(notification.EventDeadline1h)]; ok {
+		t.Fatalf("expected muted deadline reminder for u-2 to be skipped")
+	}
+	if msg, ok := byKey["u-1|"+string(notification.EventStarterUnavailable)]; !ok || msg.Data["player_id"] != "fw-1" {
+		t.Fatalf("expected unavailable starter warning for fw-1, got %+v", msg)
+	}
+	result, ok := byKey["u-1|"+string(notification.EventGameweekFinalized)]
+	if !ok {
+		t.Fatalf("expected gameweek result for u-1, got %+v", email.sent)
+	}
+	if result.Data["gameweek"] != 2 || result.Data["rank"] != 2 || result.Data["previous_rank"] != 1 {
+		t.Fatalf("unexpected gameweek result data: %+v", result.Data)
+	}
+	if msg, ok := byKey["u-1|"+string(notification.EventCustomLeagueJoined)]; !ok || msg.Data["member_user_id"] != "u-2" {
+		t.Fatalf("expected custom league join for owner u-1, got %+v", msg)
+	}
+	if len(email.sent) != 5 {
+		t.Fatalf("expected 5 emails, got %d: %+v", len(email.sent), email.sent)
+	}
+
+	if err := svc.NotifyLeague(ctx, leagueID); err != nil {
+		t.Fatalf("notify league again: %v", err)
+	}
+	if len(email.sent) != 5 {
+		t.Fatalf("expected delivered messages not to be sent twice, got %d", len(email.sent))
+	}
+}
+
+func TestNotificationService_UpdatePreferences(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prefs := &inMemoryNotificationPreferencesRepo{byUser: make(map[string]onboarding.NotificationPreferences)}
+	svc := NewNotificationService(nil, nil, nil, nil, nil, nil, nil, prefs, nil, nil, nil)
+
+	got, err := svc.GetPreferences(ctx, "u-1")
+	if err != nil {
+		t.Fatalf("get default preferences: %v", err)
+	}
+	if got.EmailEnabled || got.PushEnabled || got.WebhookEnabled {
+		t.Fatalf("expected every channel disabled by default, got %+v", got)
+	}
+
+	got, err = svc.UpdatePreferences(ctx, UpdateNotificationPreferencesInput{
+		UserID:       "u-1",
+		DefaultEmail: "manager@example.com",
+		EmailEnabled: true,
+		MutedEvents:  []string{" deadline_24h ", "deadline_24h"},
+	})
+	if err != nil {
+		t.Fatalf("update preferences: %v", err)
+	}
+	if got.Email != "manager@example.com" || !got.EmailEnabled {
+		t.Fatalf("expected account email to be used, got %+v", got)
+	}
+	if len(got.MutedEvents) != 1 || got.MutedEvents[0] != string(notification.EventDeadline24h) {
+		t.Fatalf("expected muted events to be deduplicated, got %+v", got.MutedEvents)
+	}
+
+	invalid := []UpdateNotificationPreferencesInput{
+		{UserID: "u-1", EmailEnabled: true},
+		{UserID: "u-1", PushEnabled: true},
+		{UserID: "u-1", WebhookEnabled: true, WebhookURL: "http://example.com/hook"},
+		{UserID: "u-1", MutedEvents: []string{"weekly_digest"}},
+		{UserID: "u-1", Email: "not-an-email"},
+	}
+	for _, input := range invalid {
+		if _, err := svc.UpdatePreferences(ctx, input); !errors.Is(err, ErrInvalidInput) {
+			t.Fatalf("expected invalid input for %+v, got %v", input, err)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
 	"github.com/riskibarqy/fantasy-league/internal/domain/lineup"
 	"github.com/riskibarqy/fantasy-league/internal/domain/onboarding"
@@ -42,6 +43,7 @@ type OnboardingService struct {
 	squadService       *SquadService
 	lineupService      *LineupService
 	customLeagueJoiner onboardingLeagueJoiner
+	audit              *AuditRecorder
 	now                func() time.Time
 }
 
@@ -77,6 +79,10 @@ func NewOnboardingService(
 	}
 }
 
+func (s *OnboardingService) SetAuditRecorder(audit *AuditRecorder) {
+	s.audit = audit
+}
+
 func (s *OnboardingService) SaveFavoriteClub(ctx context.Context, input SaveFavoriteClubInput) (onboarding.Profile, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.OnboardingService.SaveFavoriteClub")
 	defer span.End()
@@ -172,6 +178,13 @@ func (s *OnboardingService) Complete(ctx context.Context, input CompleteOnboardi
 	if err := s.customLeagueJoiner.EnsureDefaultMemberships(ctx, input.UserID, input.LeagueID, squad.ID, profile.CountryCode); err != nil {
 		return onboarding.Profile{}, fantasy.Squad{}, lineup.Lineup{}, fmt.Errorf("auto join default custom leagues: %w", err)
 	}
+	// The IP address stays out of the audit log; squad and lineup are
+	// recorded by their own services.
+	s.audit.Record(ctx, "onboarding.complete", auditlog.EntityOnboarding, input.UserID, input.LeagueID, nil, map[string]any{
+		"squad_id":         squad.ID,
+		"favorite_team_id": profile.FavoriteTeamID,
+		"country_code":     profile.CountryCode,
+	})
 
 	return profile, squad, savedLineup, nil
 }
@@ -0,0 +1,300 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/ownership"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+)
+
+const (
+	defaultOwnershipLeadersLimit = 10
+	maxOwnershipLeadersLimit     = 50
+)
+
+type OwnershipService struct {
+	leagueRepo    league.Repository
+	scoringRepo   scoring.Repository
+	ownershipRepo ownership.Repository
+	now           func() time.Time
+}
+
+// OwnershipLeaders ranks the players of one locked gameweek by how managers
+// picked them.
+type OwnershipLeaders struct {
+	LeagueID           string
+	Gameweek           int
+	TotalManagers      int
+	MostSelected       []ownership.PlayerGameweek
+	MostCaptained      []ownership.PlayerGameweek
+	MostTransferredIn  []ownership.PlayerGameweek
+	MostTransferredOut []ownership.PlayerGameweek
+}
+
+func NewOwnershipService(
+	leagueRepo league.Repository,
+	scoringRepo scoring.Repository,
+	ownershipRepo ownership.Repository,
+) *OwnershipService {
+	return &OwnershipService{
+		leagueRepo:    leagueRepo,
+		scoringRepo:   scoringRepo,
+		ownershipRepo: ownershipRepo,
+		now:           time.Now,
+	}
+}
+
+// AggregateGameweek computes player ownership from the squad and lineup
+// snapshots taken when the gameweek locked and replaces the stored figures.
+// Managers without a snapshot in the previous gameweek count no transfers.
+func (s *OwnershipService) AggregateGameweek(ctx context.Context, leagueID string, gameweek int) (int, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.OwnershipService.AggregateGameweek")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return 0, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	if gameweek <= 0 {
+		return 0, fmt.Errorf("%w: gameweek must be greater than zero", ErrInvalidInput)
+	}
+
+	squads, err := s.scoringRepo.ListSquadSnapshotsByLeagueGameweek(ctx, leagueID, gameweek)
+	if err != nil {
+		return 0, fmt.Errorf("list squad snapshots for ownership: %w", err)
+	}
+	lineups, err := s.scoringRepo.ListLineupSnapshotsByLeagueGameweek(ctx, leagueID, gameweek)
+	if err != nil {
+		return 0, fmt.Errorf("list lineup snapshots for ownership: %w", err)
+	}
+	var previous []scoring.SquadSnapshot
+	if gameweek > 1 {
+		previous, err = s.scoringRepo.ListSquadSnapshotsByLeagueGameweek(ctx, leagueID, gameweek-1)
+		if err != nil {
+			return 0, fmt.Errorf("list previous squad snapshots for ownership: %w", err)
+		}
+	}
+
+	items := aggregateOwnership(leagueID, gameweek, squads, lineups, previous, s.now().UTC())
+	if err := s.ownershipRepo.ReplaceGameweek(ctx, leagueID, gameweek, items); err != nil {
+		return 0, fmt.Errorf("replace gameweek ownership: %w", err)
+	}
+	return len(items), nil
+}
+
+// ListLatestByLeague returns the ownership of every player in the latest
+// aggregated gameweek, keyed by player id. It is empty before the first lock.
+func (s *OwnershipService) ListLatestByLeague(ctx context.Context, leagueID string) (map[string]ownership.PlayerGameweek, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.OwnershipService.ListLatestByLeague")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return nil, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+
+	gameweek, exists, err := s.ownershipRepo.GetLatestGameweek(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("get latest ownership gameweek: %w", err)
+	}
+	if !exists {
+		return map[string]ownership.PlayerGameweek{}, nil
+	}
+
+	items, err := s.ownershipRepo.ListByLeagueGameweek(ctx, leagueID, gameweek)
+	if err != nil {
+		return nil, fmt.Errorf("list latest ownership: %w", err)
+	}
+	out := make(map[string]ownership.PlayerGameweek, len(items))
+	for _, item := range items {
+		out[item.PlayerID] = item
+	}
+	return out, nil
+}
+
+// GetLeaders returns the most selected, captained and transferred players of
+// a gameweek. A zero gameweek means the latest aggregated one.
+func (s *OwnershipService) GetLeaders(ctx context.Context, leagueID string, gameweek, limit int) (OwnershipLeaders, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.OwnershipService.GetLeaders")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return OwnershipLeaders{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	if gameweek < 0 {
+		return OwnershipLeaders{}, fmt.Errorf("%w: gameweek must be greater than zero", ErrInvalidInput)
+	}
+	if limit <= 0 {
+		limit = defaultOwnershipLeadersLimit
+	}
+	if limit > maxOwnershipLeadersLimit {
+		limit = maxOwnershipLeadersLimit
+	}
+
+	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return OwnershipLeaders{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return OwnershipLeaders{}, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+
+	if gameweek == 0 {
+		latest, found, err := s.ownershipRepo.GetLatestGameweek(ctx, leagueID)
+		if err != nil {
+			return OwnershipLeaders{}, fmt.Errorf("get latest ownership gameweek: %w", err)
+		}
+		if !found {
+			return OwnershipLeaders{}, fmt.Errorf("%w: no locked gameweek for league=%s", ErrNotFound, leagueID)
+		}
+		gameweek = latest
+	}
+
+	items, err := s.ownershipRepo.ListByLeagueGameweek(ctx, leagueID, gameweek)
+	if err != nil {
+		return OwnershipLeaders{}, fmt.Errorf("list gameweek ownership: %w", err)
+	}
+	if len(items) == 0 {
+		return OwnershipLeaders{}, fmt.Errorf("%w: ownership for league=%s gameweek=%d", ErrNotFound, leagueID, gameweek)
+	}
+
+	return OwnershipLeaders{
+		LeagueID:      leagueID,
+		Gameweek:      gameweek,
+		TotalManagers: items[0].TotalManagers,
+		MostSelected: topOwnership(items, limit, func(item ownership.PlayerGameweek) int {
+			return item.SelectedCount
+		}),
+		MostCaptained: topOwnership(items, limit, func(item ownership.PlayerGameweek) int {
+			return item.CaptainCount
+		}),
+		MostTransferredIn: topOwnership(items, limit, func(item ownership.PlayerGameweek) int {
+			return item.TransfersIn
+		}),
+		MostTransferredOut: topOwnership(items, limit, func(item ownership.PlayerGameweek) int {
+			return item.TransfersOut
+		}),
+	}, nil
+}
+
+func aggregateOwnership(
+	leagueID string,
+	gameweek int,
+	squads []scoring.SquadSnapshot,
+	lineups []scoring.LineupSnapshot,
+	previous []scoring.SquadSnapshot,
+	now time.Time,
+) []ownership.PlayerGameweek {
+	byPlayer := make(map[string]*ownership.PlayerGameweek)
+	row := func(playerID string) *ownership.PlayerGameweek {
+		item, ok := byPlayer[playerID]
+		if !ok {
+			item = &ownership.PlayerGameweek{LeagueID: leagueID, Gameweek: gameweek, PlayerID: playerID}
+			byPlayer[playerID] = item
+		}
+		return item
+	}
+
+	previousByUser := make(map[string]map[string]struct{}, len(previous))
+	for _, snapshot := range previous {
+		picks := make(map[string]struct{}, len(snapshot.Squad.Picks))
+		for _, pick := range snapshot.Squad.Picks {
+			picks[pick.PlayerID] = struct{}{}
+		}
+		previousByUser[snapshot.Squad.UserID] = picks
+	}
+
+	for _, snapshot := range squads {
+		current := make(map[string]struct{}, len(snapshot.Squad.Picks))
+		for _, pick := range snapshot.Squad.Picks {
+			current[pick.PlayerID] = struct{}{}
+			row(pick.PlayerID).SelectedCount++
+		}
+
+		before, ok := previousByUser[snapshot.Squad.UserID]
+		if !ok {
+			continue
+		}
+		for playerID := range current {
+			if _, kept := before[playerID]; !kept {
+				row(playerID).TransfersIn++
+			}
+		}
+		for playerID := range before {
+			if _, kept := current[playerID]; !kept {
+				row(playerID).TransfersOut++
+			}
+		}
+	}
+
+	for _, snapshot := range lineups {
+		item := snapshot.Lineup
+		starters := []string{item.GoalkeeperID}
+		starters = append(starters, item.DefenderIDs...)
+		starters = append(starters, item.MidfielderIDs...)
+		starters = append(starters, item.ForwardIDs...)
+		for _, playerID := range starters {
+			if strings.TrimSpace(playerID) == "" {
+				continue
+			}
+			row(playerID).StarterCount++
+		}
+		if captainID := strings.TrimSpace(item.CaptainID); captainID != "" {
+			row(captainID).CaptainCount++
+		}
+	}
+
+	totalManagers := len(squads)
+	out := make([]ownership.PlayerGameweek, 0, len(byPlayer))
+	for _, item := range byPlayer {
+		item.TotalManagers = totalManagers
+		item.SelectedPercent = ownershipPercent(item.SelectedCount, totalManagers)
+		item.CaptainPercent = ownershipPercent(item.CaptainCount, totalManagers)
+		item.StarterPercent = ownershipPercent(item.StarterCount, totalManagers)
+		item.ComputedAt = now
+		out = append(out, *item)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].SelectedCount != out[j].SelectedCount {
+			return out[i].SelectedCount > out[j].SelectedCount
+		}
+		return out[i].PlayerID < out[j].PlayerID
+	})
+	return out
+}
+
+func ownershipPercent(count, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(count)*10000/float64(total)) / 100
+}
+
+// topOwnership returns up to limit players with a positive metric, highest
+// first and by player id on ties.
+func topOwnership(items []ownership.PlayerGameweek, limit int, metric func(ownership.PlayerGameweek) int) []ownership.PlayerGameweek {
+	out := make([]ownership.PlayerGameweek, 0, limit)
+	for _, item := range items {
+		if metric(item) > 0 {
+			out = append(out, item)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		left, right := metric(out[i]), metric(out[j])
+		if left != right {
+			return left > right
+		}
+		return out[i].PlayerID < out[j].PlayerID
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/lineup"
+	"github.com/riskibarqy/fantasy-league/internal/domain/ownership"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+)
+
+type stubOwnershipScoringRepository struct {
+	scoring.Repository
+	squads  map[int][]scoring.SquadSnapshot
+	lineups map[int][]scoring.LineupSnapshot
+}
+
+func (s *stubOwnershipScoringRepository) ListSquadSnapshotsByLeagueGameweek(_ context.Context, _ string, gameweek int) ([]scoring.SquadSnapshot, error) {
+	return append([]scoring.SquadSnapshot(nil), s.squads[gameweek]...), nil
+}
+
+func (s *stubOwnershipScoringRepository) ListLineupSnapshotsByLeagueGameweek(_ context.Context, _ string, gameweek int) ([]scoring.LineupSnapshot, error) {
+	return append([]scoring.LineupSnapshot(nil), s.lineups[gameweek]...), nil
+}
+
+type recordingOwnershipRepository struct {
+	items map[int][]ownership.PlayerGameweek
+}
+
+func (r *recordingOwnershipRepository) ReplaceGameweek(_ context.Context, _ string, gameweek int, items []ownership.PlayerGameweek) error {
+	if r.items == nil {
+		r.items = make(map[int][]ownership.PlayerGameweek)
+	}
+	r.items[gameweek] = append([]ownership.PlayerGameweek(nil), items...)
+	return nil
+}
+
+func (r *recordingOwnershipRepository) ListByLeagueGameweek(_ context.Context, _ string, gameweek int) ([]ownership.PlayerGameweek, error) {
+	return append([]ownership.PlayerGameweek(nil), r.items[gameweek]...), nil
+}
+
+func (r *recordingOwnershipRepository) GetLatestGameweek(_ context.Context, _ string) (int, bool, error) {
+	latest := 0
+	for gameweek := range r.items {
+		if gameweek > latest {
+			latest = gameweek
+		}
+	}
+	return latest, latest > 0, nil
+}
+
+func ownershipSquadSnapshot(gameweek int, userID string, playerIDs ...string) scoring.SquadSnapshot {
+	picks := make([]fantasy.SquadPick, 0, len(playerIDs))
+	for _, playerID := range playerIDs {
+		picks = append(picks, fantasy.SquadPick{PlayerID: playerID})
+	}
+	return scoring.SquadSnapshot{
+		LeagueID: "idn-liga-1-2025",
+		Gameweek: gameweek,
+		Squad:    fantasy.Squad{UserID: userID, LeagueID: "idn-liga-1-2025", Picks: picks},
+	}
+}
+
+func TestOwnershipService_AggregateGameweek(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		"idn-liga-1-2025": {ID: "idn-liga-1-2025", Name: "Liga 1 Indonesia"},
+	}}
+	scoringRepo := &stubOwnershipScoringRepository{
+		squads: map[int][]scoring.SquadSnapshot{
+			1: {
+				ownershipSquadSnapshot(1, "user-a", "p-1", "p-2"),
+			},
+			2: {
+				ownershipSquadSnapshot(2, "user-a", "p-1", "p-3"),
+				ownershipSquadSnapshot(2, "user-b", "p-1", "p-2"),
+				ownershipSquadSnapshot(2, "user-c", "p-3", "p-4"),
+			},
+		},
+		lineups: map[int][]scoring.LineupSnapshot{
+			2: {
+				{Gameweek: 2, Lineup: lineup.Lineup{UserID: "user-a", GoalkeeperID: "p-1", ForwardIDs: []string{"p-3"}, CaptainID: "p-3"}},
+				{Gameweek: 2, Lineup: lineup.Lineup{UserID: "user-b", GoalkeeperID: "p-1", CaptainID: "p-1"}},
+				{Gameweek: 2, Lineup: lineup.Lineup{UserID: "user-c", ForwardIDs: []string{"p-3"}, CaptainID: "p-3"}},
+			},
+		},
+	}
+	ownershipRepo := &recordingOwnershipRepository{}
+	svc := NewOwnershipService(leagues, scoringRepo, ownershipRepo)
+	computedAt := time.Date(2026, 2, 14, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return computedAt }
+
+	count, err := svc.AggregateGameweek(ctx, "idn-liga-1-2025", 2)
+	if err != nil {
+		t.Fatalf("aggregate gameweek: %v", err)
+	}
+	if count != 4 {
+		t.Fatalf("expected 4 owned players, got %d", count)
+	}
+
+	byPlayer := make(map[string]ownership.PlayerGameweek)
+	for _, item := range ownershipRepo.items[2] {
+		byPlayer[item.PlayerID] = item
+	}
+
+	p1 := byPlayer["p-1"]
+	if p1.SelectedCount != 2 || p1.SelectedPercent != 66.67 || p1.StarterPercent != 66.67 || p1.CaptainPercent != 33.33 {
+		t.Fatalf("unexpected p-1 ownership: %+v", p1)
+	}
+	if p1.TotalManagers != 3 || !p1.ComputedAt.Equal(computedAt) {
+		t.Fatalf("expected 3 managers computed at %s, got %+v", computedAt, p1)
+	}
+
+	// Only user-a had a squad in gameweek 1: p-2 was sold for p-3. The new
+	// managers user-b and user-c count no transfers.
+	if got := byPlayer["p-3"]; got.TransfersIn != 1 || got.TransfersOut != 0 || got.CaptainCount != 2 {
+		t.Fatalf("unexpected p-3 ownership: %+v", got)
+	}
+	if got := byPlayer["p-2"]; got.TransfersOut != 1 || got.TransfersIn != 0 || got.SelectedCount != 1 {
+		t.Fatalf("unexpected p-2 ownership: %+v", got)
+	}
+	if got := byPlayer["p-4"]; got.TransfersIn != 0 || got.StarterCount != 0 {
+		t.Fatalf("unexpected p-4 ownership: %+v", got)
+	}
+}
+
+func TestOwnershipService_GetLeaders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		"idn-liga-1-2025": {ID: "idn-liga-1-2025", Name: "Liga 1 Indonesia"},
+	}}
+	ownershipRepo := &recordingOwnershipRepository{items: map[int][]ownership.PlayerGameweek{
+		1: {
+			{PlayerID: "p-9", TotalManagers: 2, SelectedCount: 2},
+		},
+		2: {
+			{PlayerID: "p-1", TotalManagers: 4, SelectedCount: 3, CaptainCount: 1},
+			{PlayerID: "p-2", TotalManagers: 4, SelectedCount: 3, CaptainCount: 2, TransfersOut: 2},
+			{PlayerID: "p-3", TotalManagers: 4, SelectedCount: 1, TransfersIn: 1},
+		},
+	}}
+	svc := NewOwnershipService(leagues, &stubOwnershipScoringRepository{}, ownershipRepo)
+
+	leaders, err := svc.GetLeaders(ctx, "idn-liga-1-2025", 0, 2)
+	if err != nil {
+		t.Fatalf("get leaders: %v", err)
+	}
+	if leaders.Gameweek != 2 || leaders.TotalManagers != 4 {
+		t.Fatalf("expected latest gameweek 2 with 4 managers, got %+v", leaders)
+	}
+	if len(leaders.MostSelected) != 2 || leaders.MostSelected[0].PlayerID != "p-1" || leaders.MostSelected[1].PlayerID != "p-2" {
+		t.Fatalf("unexpected most selected: %+v", leaders.MostSelected)
+	}
+	if len(leaders.MostCaptained) != 2 || leaders.MostCaptained[0].PlayerID != "p-2" {
+		t.Fatalf("unexpected most captained: %+v", leaders.MostCaptained)
+	}
+	if len(leaders.MostTransferredIn) != 1 || leaders.MostTransferredIn[0].PlayerID != "p-3" {
+		t.Fatalf("unexpected most transferred in: %+v", leaders.MostTransferredIn)
+	}
+	if len(leaders.MostTransferredOut) != 1 || leaders.MostTransferredOut[0].PlayerID != "p-2" {
+		t.Fatalf("unexpected most transferred out: %+v", leaders.MostTransferredOut)
+	}
+
+	if _, err := svc.GetLeaders(ctx, "idn-liga-1-2025", 5, 0); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for gameweek without ownership, got %v", err)
+	}
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statvalue"
+)
+
+const (
+	minComparedPlayers = 2
+	maxComparedPlayers = 5
+	maxComparedStats   = 10
+)
+
+// DefaultComparisonStatKeys are the provider season stats returned when a
+// comparison does not pick its own.
+var DefaultComparisonStatKeys = []string{
+	"expected goals",
+	"expected assists",
+	"shots on target",
+	"key passes",
+	"big chances created",
+	"tackles",
+	"interceptions",
+}
+
+type fixtureTickerReader interface {
+	GetTicker(ctx context.Context, leagueID string, gameweeks int) (FixtureTicker, error)
+}
+
+type PlayerComparisonService struct {
+	leagueRepo      league.Repository
+	playerRepo      player.Repository
+	playerStatsRepo playerstats.Repository
+	statValueRepo   statvalue.Repository
+	ticker          fixtureTickerReader
+}
+
+type PlayerComparison struct {
+	LeagueID string
+	StatKeys []string
+	Players  []PlayerComparisonItem
+}
+
+type PlayerComparisonItem struct {
+	Player player.Player
+	Season playerstats.SeasonStats
+	Per90  PlayerPer90Rates
+	// PointsPerMillion is total fantasy points over the price in millions.
+	PointsPerMillion float64
+	// Form averages the fantasy points of the latest appearances, and
+	// RecentPoints lists them newest first.
+	Form              float64
+	RecentPoints      []int
+	UpcomingFixtures  []TickerFixture
+	AverageDifficulty float64
+	// AdvancedStats holds the numeric provider season stats by stat key.
+	AdvancedStats map[string]float64
+}
+
+type PlayerPer90Rates struct {
+	Goals         float64
+	Assists       float64
+	Saves         float64
+	FantasyPoints float64
+}
+
+func NewPlayerComparisonService(
+	leagueRepo league.Repository,
+	playerRepo player.Repository,
+	playerStatsRepo playerstats.Repository,
+	statValueRepo statvalue.Repository,
+	ticker fixtureTickerReader,
+) *PlayerComparisonService {
+	return &PlayerComparisonService{
+		leagueRepo:      leagueRepo,
+		playerRepo:      playerRepo,
+		playerStatsRepo: playerStatsRepo,
+		statValueRepo:   statValueRepo,
+		ticker:          ticker,
+	}
+}
+
+// Compare returns side-by-side numbers of the given players in request
+// order. Empty statKeys selects DefaultComparisonStatKeys.
+func (s *PlayerComparisonService) Compare(ctx context.Context, leagueID string, playerIDs, statKeys []string) (PlayerComparison, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.PlayerComparisonService.Compare")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return PlayerComparison{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	playerIDs = uniqueTrimmed(playerIDs)
+	if len(playerIDs) < minComparedPlayers || len(playerIDs) > maxComparedPlayers {
+		return PlayerComparison{}, fmt.Errorf("%w: compare between %d and %d distinct players", ErrInvalidInput, minComparedPlayers, maxComparedPlayers)
+	}
+	statKeys = uniqueTrimmed(statKeys)
+	if len(statKeys) == 0 {
+		statKeys = DefaultComparisonStatKeys
+	}
+	if len(statKeys) > maxComparedStats {
+		return PlayerComparison{}, fmt.Errorf("%w: at most %d stats can be compared", ErrInvalidInput, maxComparedStats)
+	}
+
+	lg, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return PlayerComparison{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return PlayerComparison{}, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+
+	players, err := s.playerRepo.GetByIDs(ctx, leagueID, playerIDs)
+	if err != nil {
+		return PlayerComparison{}, fmt.Errorf("get players for comparison: %w", err)
+	}
+	playerByID := make(map[string]player.Player, len(players))
+	for _, item := range players {
+		playerByID[item.ID] = item
+	}
+	for _, playerID := range playerIDs {
+		if _, ok := playerByID[playerID]; !ok {
+			return PlayerComparison{}, fmt.Errorf("%w: player=%s league=%s", ErrNotFound, playerID, leagueID)
+		}
+	}
+
+	values, err := s.statValueRepo.ListPlayerValues(ctx, statvalue.PlayerValueFilter{
+		LeagueID:    leagueID,
+		SeasonRefID: lg.SeasonRefID,
+		PlayerIDs:   playerIDs,
+		StatKeys:    statKeys,
+		Scope:       "total",
+	})
+	if err != nil {
+		return PlayerComparison{}, fmt.Errorf("list player stat values for comparison: %w", err)
+	}
+	advancedByPlayer := make(map[string]map[string]float64, len(playerIDs))
+	for _, item := range values {
+		if item.ValueNum == nil {
+			continue
+		}
+		stats, ok := advancedByPlayer[item.PlayerID]
+		if !ok {
+			stats = make(map[string]float64, len(statKeys))
+			advancedByPlayer[item.PlayerID] = stats
+		}
+		// Rows come newest season first, keep the first value per key.
+		if _, seen := stats[item.StatKey]; !seen {
+			stats[item.StatKey] = *item.ValueNum
+		}
+	}
+
+	var fixturesByTeam map[string][]TickerFixture
+	if s.ticker != nil {
+		ticker, err := s.ticker.GetTicker(ctx, leagueID, 0)
+		if err != nil {
+			return PlayerComparison{}, err
+		}
+		fixturesByTeam = make(map[string][]TickerFixture, len(ticker.Teams))
+		for _, item := range ticker.Teams {
+			fixturesByTeam[item.TeamID] = item.Fixtures
+		}
+	}
+
+	out := PlayerComparison{
+		LeagueID: leagueID,
+		StatKeys: statKeys,
+		Players:  make([]PlayerComparisonItem, 0, len(playerIDs)),
+	}
+	for _, playerID := range playerIDs {
+		item := playerByID[playerID]
+		season, err := s.playerStatsRepo.GetSeasonStatsByLeagueAndPlayer(ctx, leagueID, playerID)
+		if err != nil {
+			return PlayerComparison{}, fmt.Errorf("get season stats for comparison player=%s: %w", playerID, err)
+		}
+		history, err := s.playerStatsRepo.ListMatchHistoryByLeagueAndPlayer(ctx, leagueID, playerID, player.MarketFormWindow)
+		if err != nil {
+			return PlayerComparison{}, fmt.Errorf("list match history for comparison player=%s: %w", playerID, err)
+		}
+
+		compared := PlayerComparisonItem{
+			Player:           item,
+			Season:           season,
+			Per90:            per90Rates(season),
+			RecentPoints:     make([]int, 0, len(history)),
+			UpcomingFixtures: fixturesByTeam[item.TeamID],
+			AdvancedStats:    advancedByPlayer[playerID],
+		}
+		if item.Price > 0 {
+			// Prices are stored in tenths of a million.
+			compared.PointsPerMillion = round2(float64(season.TotalPoints) * 10 / float64(item.Price))
+		}
+		recentTotal := 0
+		for _, match := range history {
+			compared.RecentPoints = append(compared.RecentPoints, match.FantasyPoints)
+			recentTotal += match.FantasyPoints
+		}
+		if len(history) > 0 {
+			compared.Form = math.Round(float64(recentTotal)*10/float64(len(history))) / 10
+		}
+		if compared.UpcomingFixtures == nil {
+			compared.UpcomingFixtures = []TickerFixture{}
+		}
+		if len(compared.UpcomingFixtures) > 0 {
+			difficultyTotal := 0
+			for _, fixture := range compared.UpcomingFixtures {
+				difficultyTotal += fixture.Difficulty
+			}
+			compared.AverageDifficulty = round2(float64(difficultyTotal) / float64(len(compared.UpcomingFixtures)))
+		}
+		if compared.AdvancedStats == nil {
+			compared.AdvancedStats = map[string]float64{}
+		}
+		out.Players = append(out.Players, compared)
+	}
+	return out, nil
+}
+
+func per90Rates(stats playerstats.SeasonStats) PlayerPer90Rates {
+	if stats.MinutesPlayed <= 0 {
+		return PlayerPer90Rates{}
+	}
+	rate := func(value int) float64 {
+		return round2(float64(value) * 90 / float64(stats.MinutesPlayed))
+	}
+	return PlayerPer90Rates{
+		Goals:         rate(stats.Goals),
+		Assists:       rate(stats.Assists),
+		Saves:         rate(stats.Saves),
+		FantasyPoints: rate(stats.TotalPoints),
+	}
+}
+
+func round2(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func uniqueTrimmed(items []string) []string {
+	out := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		out = append(out, item)
+	}
+	return out
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statvalue"
+	"github.com/riskibarqy/fantasy-league/internal/infrastructure/repository/memory"
+)
+
+type stubComparisonPlayerStatsRepository struct {
+	playerstats.Repository
+	seasonByPlayer  map[string]playerstats.SeasonStats
+	historyByPlayer map[string][]playerstats.MatchHistory
+}
+
+func (s *stubComparisonPlayerStatsRepository) GetSeasonStatsByLeagueAndPlayer(_ context.Context, _, playerID string) (playerstats.SeasonStats, error) {
+	return s.seasonByPlayer[playerID], nil
+}
+
+func (s *stubComparisonPlayerStatsRepository) ListMatchHistoryByLeagueAndPlayer(_ context.Context, _, playerID string, limit int) ([]playerstats.MatchHistory, error) {
+	items := s.historyByPlayer[playerID]
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+type stubComparisonStatValueRepository struct {
+	statvalue.Repository
+	values []statvalue.PlayerValue
+	filter statvalue.PlayerValueFilter
+}
+
+func (s *stubComparisonStatValueRepository) ListPlayerValues(_ context.Context, filter statvalue.PlayerValueFilter) ([]statvalue.PlayerValue, error) {
+	s.filter = filter
+	return s.values, nil
+}
+
+type stubFixtureTickerReader struct {
+	ticker FixtureTicker
+}
+
+func (s *stubFixtureTickerReader) GetTicker(_ context.Context, _ string, _ int) (FixtureTicker, error) {
+	return s.ticker, nil
+}
+
+func TestPlayerComparisonService_Compare(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		leagueID: {ID: leagueID, Name: "Liga 1 Indonesia", SeasonRefID: 2025},
+	}}
+	players := memory.NewPlayerRepository([]player.Player{
+		{ID: "p-1", LeagueID: leagueID, TeamID: "t-1", Name: "Striker", Position: player.PositionForward, Price: 80},
+		{ID: "p-2", LeagueID: leagueID, TeamID: "t-2", Name: "Keeper", Position: player.PositionGoalkeeper, Price: 50},
+	})
+	statsRepo := &stubComparisonPlayerStatsRepository{
+		seasonByPlayer: map[string]playerstats.SeasonStats{
+			"p-1": {MinutesPlayed: 450, Goals: 4, Assists: 1, Appearances: 5, TotalPoints: 40},
+			"p-2": {MinutesPlayed: 270, Saves: 12, Appearances: 3, TotalPoints: 15},
+		},
+		historyByPlayer: map[string][]playerstats.MatchHistory{
+			"p-1": {{FantasyPoints: 12}, {FantasyPoints: 2}, {FantasyPoints: 9}, {FantasyPoints: 5}, {FantasyPoints: 6}, {FantasyPoints: 6}},
+		},
+	}
+	xg, staleXG := 3.4, 1.1
+	statValues := &stubComparisonStatValueRepository{values: []statvalue.PlayerValue{
+		{PlayerID: "p-1", SeasonRefID: 2025, StatKey: "expected goals", ValueNum: &xg},
+		{PlayerID: "p-1", SeasonRefID: 2024, StatKey: "expected goals", ValueNum: &staleXG},
+		{PlayerID: "p-1", SeasonRefID: 2025, StatKey: "key passes", ValueText: "n/a"},
+	}}
+	ticker := &stubFixtureTickerReader{ticker: FixtureTicker{
+		LeagueID: leagueID,
+		Teams: []TeamFixtureTicker{
+			{TeamID: "t-1", Fixtures: []TickerFixture{
+				{FixtureID: "f-1", Gameweek: 4, OpponentTeamID: "t-2", IsHome: true, Difficulty: 2},
+				{FixtureID: "f-2", Gameweek: 5, OpponentTeamID: "t-3", Difficulty: 5},
+			}},
+		},
+	}}
+
+	svc := NewPlayerComparisonService(leagues, players, statsRepo, statValues, ticker)
+	got, err := svc.Compare(ctx, leagueID, []string{"p-2", " p-1", "p-2"}, nil)
+	if err != nil {
+		t.Fatalf("compare players: %v", err)
+	}
+
+	if statValues.filter.SeasonRefID != 2025 || len(statValues.filter.StatKeys) != len(DefaultComparisonStatKeys) {
+		t.Fatalf("expected league season and default stat keys in filter, got %+v", statValues.filter)
+	}
+	if len(got.Players) != 2 || got.Players[0].Player.ID != "p-2" || got.Players[1].Player.ID != "p-1" {
+		t.Fatalf("expected players in request order without duplicates, got %+v", got.Players)
+	}
+
+	striker := got.Players[1]
+	if striker.Per90.Goals != 0.8 || striker.Per90.FantasyPoints != 8 {
+		t.Fatalf("unexpected per 90 rates: %+v", striker.Per90)
+	}
+	if striker.PointsPerMillion != 5 {
+		t.Fatalf("expected 40 points at 8.0m to be 5 per million, got %v", striker.PointsPerMillion)
+	}
+	if striker.Form != 6.8 || len(striker.RecentPoints) != player.MarketFormWindow {
+		t.Fatalf("expected form over the latest %d appearances, got %v from %v", player.MarketFormWindow, striker.Form, striker.RecentPoints)
+	}
+	if len(striker.UpcomingFixtures) != 2 || striker.AverageDifficulty != 3.5 {
+		t.Fatalf("unexpected upcoming fixtures: %+v avg=%v", striker.UpcomingFixtures, striker.AverageDifficulty)
+	}
+	if len(striker.AdvancedStats) != 1 || striker.AdvancedStats["expected goals"] != xg {
+		t.Fatalf("expected only the current numeric xG, got %+v", striker.AdvancedStats)
+	}
+
+	keeper := got.Players[0]
+	if keeper.Per90.Saves != 4 || keeper.Form != 0 || len(keeper.UpcomingFixtures) != 0 || keeper.AdvancedStats == nil {
+		t.Fatalf("unexpected keeper comparison: %+v", keeper)
+	}
+
+	if _, err := svc.Compare(ctx, leagueID, []string{"p-1"}, nil); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected invalid input for a single player, got %v", err)
+	}
+	if _, err := svc.Compare(ctx, leagueID, []string{"p-1", "p-9"}, nil); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for unknown player, got %v", err)
+	}
+}
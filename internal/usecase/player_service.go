@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -43,7 +44,83 @@ func (s *PlayerService) ListPlayersByLeague(ctx context.Context, leagueID string
 		return nil, fmt.Errorf("list players by league: %w", err)
 	}
 
-	return players, nil
+	return visiblePlayers(players), nil
+}
+
+// visiblePlayers drops players an admin has hidden from the catalog.
+func visiblePlayers(items []player.Player) []player.Player {
+	out := make([]player.Player, 0, len(items))
+	for _, item := range items {
+		if !item.Hidden {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+const (
+	defaultMarketPageSize = 50
+	maxMarketPageSize     = 100
+)
+
+// SearchMarket returns one page of the league transfer market. Without a limit
+// or cursor the whole matching market is returned.
+func (s *PlayerService) SearchMarket(ctx context.Context, query player.MarketQuery) (player.MarketPage, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.PlayerService.SearchMarket")
+	defer span.End()
+
+	query.LeagueID = strings.TrimSpace(query.LeagueID)
+	query.Name = strings.TrimSpace(query.Name)
+	query.Cursor = strings.TrimSpace(query.Cursor)
+	if query.LeagueID == "" {
+		return player.MarketPage{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	for _, position := range query.Positions {
+		if _, ok := player.AllPositions[position]; !ok {
+			return player.MarketPage{}, fmt.Errorf("%w: invalid position %q", ErrInvalidInput, position)
+		}
+	}
+	if query.Sort != player.MarketSortDefault {
+		if _, ok := player.AllMarketSorts[query.Sort]; !ok {
+			return player.MarketPage{}, fmt.Errorf("%w: invalid sort %q", ErrInvalidInput, query.Sort)
+		}
+	}
+	if query.MinPrice < 0 || query.MaxPrice < 0 {
+		return player.MarketPage{}, fmt.Errorf("%w: price range must not be negative", ErrInvalidInput)
+	}
+	if query.MaxPrice > 0 && query.MinPrice > query.MaxPrice {
+		return player.MarketPage{}, fmt.Errorf("%w: min price must not exceed max price", ErrInvalidInput)
+	}
+	if query.MinMinutes < 0 {
+		return player.MarketPage{}, fmt.Errorf("%w: min minutes must not be negative", ErrInvalidInput)
+	}
+	if query.Limit < 0 {
+		return player.MarketPage{}, fmt.Errorf("%w: limit must not be negative", ErrInvalidInput)
+	}
+	if query.Limit == 0 && query.Cursor != "" {
+		query.Limit = defaultMarketPageSize
+	}
+	if query.Limit > maxMarketPageSize {
+		query.Limit = maxMarketPageSize
+	}
+
+	_, exists, err := s.leagueRepo.GetByID(ctx, query.LeagueID)
+	if err != nil {
+		return player.MarketPage{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return player.MarketPage{}, fmt.Errorf("%w: league=%s", ErrNotFound, query.LeagueID)
+	}
+
+	page, err := s.playerRepo.SearchMarket(ctx, query)
+	if err != nil {
+		if errors.Is(err, player.ErrInvalidCursor) {
+			return player.MarketPage{}, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		return player.MarketPage{}, fmt.Errorf("search player market: %w", err)
+	}
+
+	return page, nil
 }
 
 func (s *PlayerService) GetPlayerByLeagueAndID(ctx context.Context, leagueID, playerID string) (player.Player, error) {
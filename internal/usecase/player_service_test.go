@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/infrastructure/repository/memory"
+)
+
+func newMarketTestService() *PlayerService {
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		"idn-liga-1-2025": {ID: "idn-liga-1-2025", Name: "Liga 1 Indonesia"},
+	}}
+	players := memory.NewPlayerRepository([]player.Player{
+		{ID: "p-1", LeagueID: "idn-liga-1-2025", TeamID: "idn-persija", Name: "Marko Šimić", Position: player.PositionForward, Price: 110},
+		{ID: "p-2", LeagueID: "idn-liga-1-2025", TeamID: "idn-persib", Name: "Ciro Alves", Position: player.PositionForward, Price: 105},
+		{ID: "p-3", LeagueID: "idn-liga-1-2025", TeamID: "idn-persija", Name: "Rizky Ridho", Position: player.PositionDefender, Price: 75},
+		{ID: "p-4", LeagueID: "idn-liga-1-2025", TeamID: "idn-psm", Name: "Yakob Sayuri", Position: player.PositionMidfielder, Price: 80},
+		{ID: "p-5", LeagueID: "idn-liga-1-2025", TeamID: "idn-persib", Name: "David da Silva", Position: player.PositionForward, Price: 95},
+	})
+	return NewPlayerService(leagues, players)
+}
+
+func TestPlayerService_SearchMarket_Filters(t *testing.T) {
+	t.Parallel()
+
+	svc := newMarketTestService()
+	ctx := context.Background()
+
+	page, err := svc.SearchMarket(ctx, player.MarketQuery{LeagueID: "idn-liga-1-2025", Name: "simic"})
+	if err != nil {
+		t.Fatalf("SearchMarket error: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != "p-1" {
+		t.Fatalf("expected accent-insensitive name match, got %+v", page.Items)
+	}
+
+	page, err = svc.SearchMarket(ctx, player.MarketQuery{
+		LeagueID:  "idn-liga-1-2025",
+		Positions: []player.Position{player.PositionForward},
+		TeamIDs:   []string{"idn-persib"},
+		MaxPrice:  100,
+	})
+	if err != nil {
+		t.Fatalf("SearchMarket error: %v", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != "p-5" {
+		t.Fatalf("expected position, team and price filters to match p-5, got %+v", page.Items)
+	}
+}
+
+func TestPlayerService_SearchMarket_CursorPagination(t *testing.T) {
+	t.Parallel()
+
+	svc := newMarketTestService()
+	ctx := context.Background()
+	query := player.MarketQuery{
+		LeagueID:   "idn-liga-1-2025",
+		Sort:       player.MarketSortPrice,
+		Descending: true,
+		Limit:      2,
+	}
+
+	var got []string
+	for pages := 0; pages < 5; pages++ {
+		page, err := svc.SearchMarket(ctx, query)
+		if err != nil {
+			t.Fatalf("SearchMarket error: %v", err)
+		}
+		for _, item := range page.Items {
+			got = append(got, item.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		query.Cursor = page.NextCursor
+	}
+
+	want := []string{"p-1", "p-2", "p-5", "p-4", "p-3"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestPlayerService_SearchMarket_RejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	svc := newMarketTestService()
+	ctx := context.Background()
+
+	cases := []player.MarketQuery{
+		{LeagueID: "idn-liga-1-2025", Sort: "goals"},
+		{LeagueID: "idn-liga-1-2025", Positions: []player.Position{"ST"}},
+		{LeagueID: "idn-liga-1-2025", MinPrice: 100, MaxPrice: 50},
+		{LeagueID: "idn-liga-1-2025", Cursor: "not-a-cursor!"},
+	}
+	for _, query := range cases {
+		if _, err := svc.SearchMarket(ctx, query); !errors.Is(err, ErrInvalidInput) {
+			t.Fatalf("expected invalid input for %+v, got %v", query, err)
+		}
+	}
+
+	if _, err := svc.SearchMarket(ctx, player.MarketQuery{LeagueID: "unknown"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for unknown league, got %v", err)
+	}
+}
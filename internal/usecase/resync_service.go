@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/panjf2000/ants/v2"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
 	"github.com/riskibarqy/fantasy-league/internal/domain/player"
 	"github.com/riskibarqy/fantasy-league/internal/domain/rawdata"
 	"github.com/riskibarqy/fantasy-league/internal/domain/statvalue"
@@ -80,6 +81,8 @@ type resyncLeagueState struct {
 	leagueID string
 	seasonID int64
 	syncer   *SportDataSyncService
+	provider SportDataSyncProvider
+	source   string
 	dryRun   bool
 
 	gameweekFilter map[int]struct{}
@@ -142,7 +145,7 @@ func (s *SportDataSyncService) Resync(ctx context.Context, input ResyncInput) (R
 	if !s.cfg.Enabled {
 		return ResyncResult{}, fmt.Errorf("%w: sport data sync is disabled (SPORTMONKS_ENABLED=false)", ErrDependencyUnavailable)
 	}
-	if s.provider == nil || s.ingestion == nil || s.teamRepo == nil || s.playerRepo == nil {
+	if !s.hasProvider() || s.ingestion == nil || s.teamRepo == nil || s.playerRepo == nil {
 		return ResyncResult{}, fmt.Errorf("%w: sport data sync is not fully configured", ErrDependencyUnavailable)
 	}
 
@@ -155,11 +158,78 @@ func (s *SportDataSyncService) Resync(ctx context.Context, input ResyncInput) (R
 		return ResyncResult{}, err
 	}
 
-	targets, err := s.resolveResyncTargets(input.LeagueID, input.SeasonID)
+	targets, err := s.resolveSeasonLeagueTargets(ctx, input.LeagueID, input.SeasonID)
 	if err != nil {
 		return ResyncResult{}, err
 	}
+	if len(targets) == 0 {
+		targets, err = s.resolveResyncTargets(input.LeagueID, input.SeasonID)
+		if err != nil {
+			return ResyncResult{}, err
+		}
+	}
+
+	return s.runResync(ctx, targets, kinds, rawKinds, gameweekFilter, input)
+}
+
+// SyncSeasonMasterData pulls teams, players, fixtures and standings for a
+// league opened by a season rollover. Teams go first because players and
+// fixtures map onto them.
+func (s *SportDataSyncService) SyncSeasonMasterData(ctx context.Context, lg league.League) (ResyncResult, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.SportDataSyncService.SyncSeasonMasterData")
+	defer span.End()
+
+	if !s.cfg.Enabled {
+		return ResyncResult{}, fmt.Errorf("%w: sport data sync is disabled (SPORTMONKS_ENABLED=false)", ErrDependencyUnavailable)
+	}
+	if !s.hasProvider() || s.ingestion == nil || s.teamRepo == nil || s.playerRepo == nil {
+		return ResyncResult{}, fmt.Errorf("%w: sport data sync is not fully configured", ErrDependencyUnavailable)
+	}
+	s.rememberLeague(lg)
+	seasonID, ok := s.seasonIDForLeague(lg)
+	if !ok {
+		return ResyncResult{}, fmt.Errorf("%w: missing season id for league=%s", ErrDependencyUnavailable, lg.ID)
+	}
+
+	targets := []resyncLeagueTarget{{leagueID: lg.ID, seasonID: seasonID}}
+	stages := [][]resyncDataKind{
+		{resyncDataTeam},
+		{resyncDataPlayers},
+		{resyncDataFixtures, resyncDataStanding},
+	}
+	result := ResyncResult{LeagueCount: len(targets)}
+	for _, kinds := range stages {
+		rawKinds := make([]string, 0, len(kinds))
+		for _, kind := range kinds {
+			rawKinds = append(rawKinds, string(kind))
+		}
+		stage, err := s.runResync(ctx, targets, kinds, rawKinds, nil, ResyncInput{})
+		if err != nil {
+			return ResyncResult{}, err
+		}
+		result.TaskCount += stage.TaskCount
+		result.SuccessCount += stage.SuccessCount
+		result.FailedCount += stage.FailedCount
+		result.SkippedCount += stage.SkippedCount
+		result.WorkerCount = max(result.WorkerCount, stage.WorkerCount)
+		result.Tasks = append(result.Tasks, stage.Tasks...)
+		result.RequestedData = append(result.RequestedData, stage.RequestedData...)
+		if stage.FailedCount > 0 {
+			return result, fmt.Errorf("%w: season master data sync failed at %s", ErrDependencyUnavailable, strings.Join(rawKinds, ","))
+		}
+	}
+
+	return result, nil
+}
 
+func (s *SportDataSyncService) runResync(
+	ctx context.Context,
+	targets []resyncLeagueTarget,
+	kinds []resyncDataKind,
+	rawKinds []string,
+	gameweekFilter map[int]struct{},
+	input ResyncInput,
+) (ResyncResult, error) {
 	tasks := make([]resyncTask, 0, len(targets)*len(kinds))
 	for _, target := range targets {
 		for _, kind := range kinds {
@@ -184,10 +254,13 @@ func (s *SportDataSyncService) Resync(ctx context.Context, input ResyncInput) (R
 
 	leagueStates := make(map[string]*resyncLeagueState, len(targets))
 	for _, target := range targets {
+		provider, source := s.providerForLeague(target.leagueID)
 		leagueStates[target.leagueID] = &resyncLeagueState{
 			leagueID:       target.leagueID,
 			seasonID:       target.seasonID,
 			syncer:         s,
+			provider:       provider,
+			source:         source,
 			dryRun:         input.DryRun,
 			gameweekFilter: gameweekFilter,
 		}
@@ -377,7 +450,7 @@ func syncResyncFixtures(ctx context.Context, state *resyncLeagueState) (int, err
 	}
 
 	eventsByFixture := mapExternalFixtureEventsByFixture(state.leagueID, bundle.Events, teamMappings, playerMappings)
-	fixtureIDs := fixtureIDsFromExternalFixtures(state.leagueID, bundle.Fixtures)
+	fixtureIDs := fixtureIDsFromExternalFixtures(state.source, state.leagueID, bundle.Fixtures)
 	if !state.dryRun {
 		for _, fixtureID := range fixtureIDs {
 			if err := state.syncer.ingestion.ReplaceFixtureEvents(ctx, fixtureID, eventsByFixture[fixtureID]); err != nil {
@@ -388,7 +461,7 @@ func syncResyncFixtures(ctx context.Context, state *resyncLeagueState) (int, err
 
 	if len(bundle.RawPayloads) > 0 && !state.dryRun {
 		payloads := applyLeagueToPayloads(state.leagueID, bundle.RawPayloads)
-		if err := state.syncer.ingestion.UpsertRawPayloads(ctx, "sportmonks", payloads); err != nil {
+		if err := state.syncer.ingestion.UpsertRawPayloads(ctx, state.source, payloads); err != nil {
 			return 0, fmt.Errorf("upsert fixture raw payloads league=%s: %w", state.leagueID, err)
 		}
 	}
@@ -415,7 +488,7 @@ func syncResyncStanding(ctx context.Context, state *resyncLeagueState) (int, err
 			}
 		}
 		if len(payloads) > 0 {
-			if err := state.syncer.ingestion.UpsertRawPayloads(ctx, "sportmonks", payloads); err != nil {
+			if err := state.syncer.ingestion.UpsertRawPayloads(ctx, state.source, payloads); err != nil {
 				return 0, fmt.Errorf("upsert standings raw payloads league=%s: %w", state.leagueID, err)
 			}
 		}
@@ -439,7 +512,7 @@ func syncResyncPlayerFixtureStats(ctx context.Context, state *resyncLeagueState)
 	}
 
 	statsByFixture := mapExternalPlayerStatsByFixture(state.leagueID, bundle.PlayerStats, teamMappings, playerMappings)
-	fixtureIDs := fixtureIDsFromExternalFixtures(state.leagueID, bundle.Fixtures)
+	fixtureIDs := fixtureIDsFromExternalFixtures(state.source, state.leagueID, bundle.Fixtures)
 	if !state.dryRun {
 		for _, fixtureID := range fixtureIDs {
 			if err := state.syncer.ingestion.UpsertPlayerFixtureStats(ctx, fixtureID, statsByFixture[fixtureID]); err != nil {
@@ -462,7 +535,7 @@ func syncResyncTeamFixtureStats(ctx context.Context, state *resyncLeagueState) (
 	}
 
 	statsByFixture := mapExternalTeamStatsByFixture(state.leagueID, bundle.TeamStats, teamMappings)
-	fixtureIDs := fixtureIDsFromExternalFixtures(state.leagueID, bundle.Fixtures)
+	fixtureIDs := fixtureIDsFromExternalFixtures(state.source, state.leagueID, bundle.Fixtures)
 	if !state.dryRun {
 		for _, fixtureID := range fixtureIDs {
 			if err := state.syncer.ingestion.UpsertTeamFixtureStats(ctx, fixtureID, statsByFixture[fixtureID]); err != nil {
@@ -492,7 +565,12 @@ func syncResyncTeams(ctx context.Context, state *resyncLeagueState) (int, error)
 		return 0, err
 	}
 
-	teams := mapExternalTeamsToDomain(state.leagueID, bundle.Teams, existingMappings)
+	overrides, err := state.syncer.loadOverrides(ctx, state.leagueID)
+	if err != nil {
+		return 0, err
+	}
+
+	teams := mapExternalTeamsToDomain(state.leagueID, bundle.Teams, existingMappings, overrides)
 	if len(teams) == 0 {
 		return 0, nil
 	}
@@ -538,7 +616,12 @@ func syncResyncPlayers(ctx context.Context, state *resyncLeagueState) (int, erro
 		return 0, err
 	}
 
-	players := mapExternalPlayersToDomain(state.leagueID, bundle.Players, teamMappings, playerMappings)
+	overrides, err := state.syncer.loadOverrides(ctx, state.leagueID)
+	if err != nil {
+		return 0, err
+	}
+
+	players := mapExternalPlayersToDomain(state.leagueID, bundle.Players, teamMappings, playerMappings, overrides)
 	if len(players) == 0 {
 		return 0, nil
 	}
@@ -580,7 +663,7 @@ func syncResyncStatTypes(ctx context.Context, state *resyncLeagueState) (int, er
 		}
 		if len(payloads) > 0 {
 			payloads = applyLeagueToPayloads(state.leagueID, payloads)
-			if err := state.syncer.ingestion.UpsertRawPayloads(ctx, "sportmonks", payloads); err != nil {
+			if err := state.syncer.ingestion.UpsertRawPayloads(ctx, state.source, payloads); err != nil {
 				return 0, fmt.Errorf("upsert stat types raw payloads league=%s: %w", state.leagueID, err)
 			}
 		}
@@ -618,7 +701,7 @@ func syncResyncTeamStatistics(ctx context.Context, state *resyncLeagueState) (in
 		}
 		if len(payloads) > 0 {
 			payloads = applyLeagueToPayloads(state.leagueID, payloads)
-			if err := state.syncer.ingestion.UpsertRawPayloads(ctx, "sportmonks", payloads); err != nil {
+			if err := state.syncer.ingestion.UpsertRawPayloads(ctx, state.source, payloads); err != nil {
 				return 0, fmt.Errorf("upsert team statistics raw payloads league=%s: %w", state.leagueID, err)
 			}
 		}
@@ -660,7 +743,7 @@ func syncResyncPlayerStatistics(ctx context.Context, state *resyncLeagueState) (
 		}
 		if len(payloads) > 0 {
 			payloads = applyLeagueToPayloads(state.leagueID, payloads)
-			if err := state.syncer.ingestion.UpsertRawPayloads(ctx, "sportmonks", payloads); err != nil {
+			if err := state.syncer.ingestion.UpsertRawPayloads(ctx, state.source, payloads); err != nil {
 				return 0, fmt.Errorf("upsert player statistics raw payloads league=%s: %w", state.leagueID, err)
 			}
 		}
@@ -671,11 +754,11 @@ func syncResyncPlayerStatistics(ctx context.Context, state *resyncLeagueState) (
 
 func (state *resyncLeagueState) loadBundle(ctx context.Context) (ExternalFixtureBundle, error) {
 	state.bundleOnce.Do(func() {
-		if state.syncer == nil {
+		if state.syncer == nil || state.provider == nil {
 			state.bundleErr = fmt.Errorf("sport data sync service is not configured")
 			return
 		}
-		bundle, err := state.syncer.provider.FetchFixtureBundleBySeason(ctx, state.seasonID)
+		bundle, err := state.provider.FetchFixtureBundleBySeason(ctx, state.seasonID)
 		if err != nil {
 			state.bundleErr = fmt.Errorf("fetch fixture bundle season_id=%d league=%s: %w", state.seasonID, state.leagueID, err)
 			return
@@ -704,11 +787,11 @@ func (state *resyncLeagueState) loadBundleForFixtureSync(ctx context.Context) (E
 
 func (state *resyncLeagueState) loadStandings(ctx context.Context) ([]ExternalStanding, []rawdata.Payload, error) {
 	state.standingsOnce.Do(func() {
-		if state.syncer == nil {
+		if state.syncer == nil || state.provider == nil {
 			state.standingsErr = fmt.Errorf("sport data sync service is not configured")
 			return
 		}
-		items, payloads, err := state.syncer.provider.FetchStandingsBySeason(ctx, state.seasonID)
+		items, payloads, err := state.provider.FetchStandingsBySeason(ctx, state.seasonID)
 		if err != nil {
 			state.standingsErr = fmt.Errorf("fetch standings season_id=%d league=%s: %w", state.seasonID, state.leagueID, err)
 			return
@@ -750,11 +833,11 @@ func (state *resyncLeagueState) loadPlayerMappings(ctx context.Context) (playerM
 
 func (state *resyncLeagueState) loadStatisticTypes(ctx context.Context) ([]ExternalStatType, []rawdata.Payload, error) {
 	state.statTypesOnce.Do(func() {
-		if state.syncer == nil {
+		if state.syncer == nil || state.provider == nil {
 			state.statTypesErr = fmt.Errorf("sport data sync service is not configured")
 			return
 		}
-		items, payloads, err := state.syncer.provider.FetchStatisticTypes(ctx)
+		items, payloads, err := state.provider.FetchStatisticTypes(ctx)
 		if err != nil {
 			state.statTypesErr = fmt.Errorf("fetch statistic types league=%s: %w", state.leagueID, err)
 			return
@@ -774,11 +857,11 @@ func (state *resyncLeagueState) loadStatisticTypes(ctx context.Context) ([]Exter
 
 func (state *resyncLeagueState) loadTeamStatistics(ctx context.Context) ([]ExternalTeamStatValue, []rawdata.Payload, error) {
 	state.teamStatsOnce.Do(func() {
-		if state.syncer == nil {
+		if state.syncer == nil || state.provider == nil {
 			state.teamStatsErr = fmt.Errorf("sport data sync service is not configured")
 			return
 		}
-		items, payloads, err := state.syncer.provider.FetchTeamStatisticsBySeason(ctx, state.seasonID)
+		items, payloads, err := state.provider.FetchTeamStatisticsBySeason(ctx, state.seasonID)
 		if err != nil {
 			state.teamStatsErr = fmt.Errorf("fetch team statistics season_id=%d league=%s: %w", state.seasonID, state.leagueID, err)
 			return
@@ -798,11 +881,11 @@ func (state *resyncLeagueState) loadTeamStatistics(ctx context.Context) ([]Exter
 
 func (state *resyncLeagueState) loadPlayerStatistics(ctx context.Context) ([]ExternalPlayerStatValue, []rawdata.Payload, error) {
 	state.playerStatsOnce.Do(func() {
-		if state.syncer == nil {
+		if state.syncer == nil || state.provider == nil {
 			state.playerStatsErr = fmt.Errorf("sport data sync service is not configured")
 			return
 		}
-		items, payloads, err := state.syncer.provider.FetchPlayerStatisticsBySeason(ctx, state.seasonID)
+		items, payloads, err := state.provider.FetchPlayerStatisticsBySeason(ctx, state.seasonID)
 		if err != nil {
 			state.playerStatsErr = fmt.Errorf("fetch player statistics season_id=%d league=%s: %w", state.seasonID, state.leagueID, err)
 			return
@@ -820,6 +903,33 @@ func (state *resyncLeagueState) loadPlayerStatistics(ctx context.Context) ([]Ext
 	return state.playerStats, payloads, nil
 }
 
+// resolveSeasonLeagueTargets resolves leagues opened by a season rollover,
+// which carry their provider season on the league record instead of config.
+// It returns no targets for every other league.
+func (s *SportDataSyncService) resolveSeasonLeagueTargets(ctx context.Context, leagueID string, seasonID int64) ([]resyncLeagueTarget, error) {
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" || s.leagueRepo == nil {
+		return nil, nil
+	}
+
+	lg, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("get league for resync: %w", err)
+	}
+	if !exists || lg.SeasonRefID <= 0 {
+		return nil, nil
+	}
+	if seasonID > 0 && seasonID != lg.SeasonRefID {
+		return nil, fmt.Errorf("%w: season_id=%d does not match league season_id=%d for league=%s", ErrInvalidInput, seasonID, lg.SeasonRefID, leagueID)
+	}
+
+	s.rememberLeague(lg)
+	return []resyncLeagueTarget{{
+		leagueID: lg.ID,
+		seasonID: lg.SeasonRefID,
+	}}, nil
+}
+
 func (s *SportDataSyncService) resolveResyncTargets(leagueID string, seasonID int64) ([]resyncLeagueTarget, error) {
 	leagueID = strings.TrimSpace(leagueID)
 	if leagueID != "" {
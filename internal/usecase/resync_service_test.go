@@ -2,11 +2,14 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/riskibarqy/fantasy-league/internal/domain/player"
 	"github.com/riskibarqy/fantasy-league/internal/domain/rawdata"
 	"github.com/riskibarqy/fantasy-league/internal/domain/team"
+	teammock "github.com/riskibarqy/fantasy-league/internal/mocks/domain/team"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestSportDataSyncService_Resync_SkippedKinds(t *testing.T) {
@@ -124,3 +127,34 @@ func (stubResyncPlayerRepo) ListByLeague(_ context.Context, _ string) ([]player.
 func (stubResyncPlayerRepo) GetByIDs(_ context.Context, _ string, _ []string) ([]player.Player, error) {
 	return []player.Player{}, nil
 }
+
+func (stubResyncPlayerRepo) SearchMarket(_ context.Context, _ player.MarketQuery) (player.MarketPage, error) {
+	return player.MarketPage{}, nil
+}
+
+func TestSportDataSyncService_LoadTeamMappingsRefusesProviderSwitch(t *testing.T) {
+	t.Parallel()
+
+	leagueID := "idn-liga-1-2025"
+	teams := teammock.NewRepository(t)
+	teams.
+		On("ListByLeague", mock.Anything, leagueID).
+		Return([]team.Team{
+			{ID: "sm-idn-liga-1-2025-team-1", LeagueID: leagueID, Name: "Persija", TeamRefID: 1, RefSource: SportDataSourceSportMonks},
+			{ID: "idn-persib", LeagueID: leagueID, Name: "Persib"},
+		}, nil)
+	svc := &SportDataSyncService{teamRepo: teams}
+
+	mappings, err := svc.loadTeamMappings(context.Background(), leagueID)
+	if err != nil {
+		t.Fatalf("load team mappings for the league's own provider: %v", err)
+	}
+	if mappings.byRefID[1] != "sm-idn-liga-1-2025-team-1" {
+		t.Fatalf("unexpected mappings %+v", mappings.byRefID)
+	}
+
+	svc.SetLeagueProvider(leagueID, SportDataSourceAPIFootball, stubResyncProvider{})
+	if _, err := svc.loadTeamMappings(context.Background(), leagueID); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected switching a league with sportmonks data to conflict, got %v", err)
+	}
+}
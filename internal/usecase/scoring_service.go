@@ -3,10 +3,12 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/awards"
 	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
@@ -23,6 +25,9 @@ type ScoringService struct {
 	playerStatsRepo playerstats.Repository
 	groupRepo       customleague.Repository
 	scoringRepo     scoring.Repository
+	ownership       ownershipAggregator
+	awards          gameweekAwarder
+	difficulty      fixtureDifficultyRefresher
 	now             func() time.Time
 	ensureFlight    resilience.SingleFlight
 	ensureMu        sync.Mutex
@@ -32,6 +37,19 @@ type ScoringService struct {
 
 const defaultScoringEnsureInterval = 30 * time.Second
 
+type ownershipAggregator interface {
+	AggregateGameweek(ctx context.Context, leagueID string, gameweek int) (int, error)
+}
+
+type gameweekAwarder interface {
+	ListAwardedGameweeks(ctx context.Context, leagueID string) ([]int, error)
+	ComputeGameweek(ctx context.Context, leagueID string, gameweek int) (awards.GameweekAwards, error)
+}
+
+type fixtureDifficultyRefresher interface {
+	EnsureFresh(ctx context.Context, leagueID string, finalizedGameweek int) error
+}
+
 type UserSeasonPointsSummary struct {
 	LeagueID              string
 	UserID                string
@@ -41,6 +59,17 @@ type UserSeasonPointsSummary struct {
 	Gameweeks             int
 	CurrentGameweek       int
 	CurrentGameweekPoints int
+	// Comparisons holds the user points against the league average and
+	// highest score of every summarized gameweek.
+	Comparisons []UserGameweekComparison
+}
+
+type UserGameweekComparison struct {
+	Gameweek              int
+	Points                int
+	AveragePoints         float64
+	HighestPoints         int
+	DifferenceFromAverage float64
 }
 
 type UserPlayerPoints struct {
@@ -83,6 +112,24 @@ func NewScoringService(
 	}
 }
 
+// SetOwnershipAggregator computes player ownership from the snapshots taken
+// each time a gameweek locks.
+func (s *ScoringService) SetOwnershipAggregator(aggregator ownershipAggregator) {
+	s.ownership = aggregator
+}
+
+// SetGameweekAwarder computes the dream teams and awards of each gameweek
+// once all of its fixtures are finished.
+func (s *ScoringService) SetGameweekAwarder(awarder gameweekAwarder) {
+	s.awards = awarder
+}
+
+// SetFixtureDifficultyRefresher recomputes team fixture difficulty after
+// each finalized gameweek.
+func (s *ScoringService) SetFixtureDifficultyRefresher(refresher fixtureDifficultyRefresher) {
+	s.difficulty = refresher
+}
+
 func (s *ScoringService) EnsureLeagueUpToDate(ctx context.Context, leagueID string) error {
 	ctx, span := startUsecaseSpan(ctx, "usecase.ScoringService.EnsureLeagueUpToDate")
 	defer span.End()
@@ -150,6 +197,26 @@ func (s *ScoringService) ensureLeagueUpToDateOnce(ctx context.Context, leagueID
 		hasSnapshotByGameweek[gameweek] = struct{}{}
 	}
 
+	existingSummaries, err := s.scoringRepo.ListGameweekSummariesByLeague(ctx, leagueID)
+	if err != nil {
+		return fmt.Errorf("list gameweek summaries by league: %w", err)
+	}
+	hasSummary := make(map[int]struct{}, len(existingSummaries))
+	for _, item := range existingSummaries {
+		hasSummary[item.Gameweek] = struct{}{}
+	}
+
+	awardedGameweeks := make(map[int]struct{})
+	if s.awards != nil {
+		awarded, err := s.awards.ListAwardedGameweeks(ctx, leagueID)
+		if err != nil {
+			return fmt.Errorf("list awarded gameweeks: %w", err)
+		}
+		for _, gameweek := range awarded {
+			awardedGameweeks[gameweek] = struct{}{}
+		}
+	}
+
 	byGameweek := make(map[int][]fixture.Fixture)
 	gameweeks := make([]int, 0)
 	for _, item := range fixtures {
@@ -163,8 +230,12 @@ func (s *ScoringService) ensureLeagueUpToDateOnce(ctx context.Context, leagueID
 	}
 	sort.Ints(gameweeks)
 
+	latestFinalized := 0
 	for _, gameweek := range gameweeks {
 		items := byGameweek[gameweek]
+		if isFinalizedGameweek(items) {
+			latestFinalized = gameweek
+		}
 		deadline, ok := minKickoff(items)
 		if !ok || now.Before(deadline) {
 			continue
@@ -176,10 +247,20 @@ func (s *ScoringService) ensureLeagueUpToDateOnce(ctx context.Context, leagueID
 		}
 		if lockedNow {
 			hasSnapshotByGameweek[gameweek] = struct{}{}
+			if s.ownership != nil {
+				if _, err := s.ownership.AggregateGameweek(ctx, leagueID, gameweek); err != nil {
+					return fmt.Errorf("aggregate ownership gameweek=%d: %w", gameweek, err)
+				}
+			}
 		}
 
 		_, alreadyCalculated := hasCalculatedPoints[gameweek]
-		if !lockedNow && alreadyCalculated && isFinalizedGameweek(items) {
+		_, summarized := hasSummary[gameweek]
+		finalized := isFinalizedGameweek(items)
+		if !lockedNow && alreadyCalculated && summarized && finalized {
+			if err := s.ensureGameweekAwards(ctx, leagueID, gameweek, awardedGameweeks, false); err != nil {
+				return err
+			}
 			continue
 		}
 		if _, exists := hasSnapshotByGameweek[gameweek]; !exists {
@@ -190,15 +271,66 @@ func (s *ScoringService) ensureLeagueUpToDateOnce(ctx context.Context, leagueID
 			return err
 		}
 		hasCalculatedPoints[gameweek] = struct{}{}
+		if finalized {
+			if err := s.ensureGameweekAwards(ctx, leagueID, gameweek, awardedGameweeks, true); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err := s.recalculateStandings(ctx, leagueID, now); err != nil {
 		return err
 	}
 
+	if s.difficulty != nil {
+		if err := s.difficulty.EnsureFresh(ctx, leagueID, latestFinalized); err != nil {
+			return fmt.Errorf("refresh fixture difficulty: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// RescoreGameweek recalculates the points of every user for one gameweek,
+// its awards once finalized and the custom league standings. It runs after
+// fixture stats of an already scored gameweek change.
+func (s *ScoringService) RescoreGameweek(ctx context.Context, leagueID string, gameweek int) error {
+	ctx, span := startUsecaseSpan(ctx, "usecase.ScoringService.RescoreGameweek")
+	defer span.End()
+
+	now := s.now().UTC()
+	if err := s.recalculateGameweekPoints(ctx, leagueID, gameweek, now); err != nil {
+		return err
+	}
+
+	fixtures, err := s.fixtureRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return fmt.Errorf("list fixtures by league for rescoring: %w", err)
+	}
+	items := make([]fixture.Fixture, 0)
+	for _, item := range fixtures {
+		if item.Gameweek == gameweek {
+			items = append(items, item)
+		}
+	}
+	if isFinalizedGameweek(items) {
+		if err := s.ensureGameweekAwards(ctx, leagueID, gameweek, map[int]struct{}{}, true); err != nil {
+			return err
+		}
+	}
+
+	return s.recalculateStandings(ctx, leagueID, now)
+}
+
+// RefreshStandings recomputes custom league standings of a league from the
+// stored gameweek points, e.g. after a manager left.
+func (s *ScoringService) RefreshStandings(ctx context.Context, leagueID string) error {
+	ctx, span := startUsecaseSpan(ctx, "usecase.ScoringService.RefreshStandings")
+	defer span.End()
+
+	return s.recalculateStandings(ctx, leagueID, s.now().UTC())
+}
+
 func (s *ScoringService) GetUserLeagueSummary(ctx context.Context, leagueID, userID string) (int, int, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.ScoringService.GetUserLeagueSummary")
 	defer span.End()
@@ -298,6 +430,25 @@ func (s *ScoringService) GetUserSeasonPointsSummary(ctx context.Context, leagueI
 		currentGameweek = 1
 	}
 
+	summaries, err := s.scoringRepo.ListGameweekSummariesByLeague(ctx, leagueID)
+	if err != nil {
+		return UserSeasonPointsSummary{}, fmt.Errorf("list gameweek summaries for season summary: %w", err)
+	}
+	comparisons := make([]UserGameweekComparison, 0, len(summaries))
+	for _, item := range summaries {
+		points := pointsByGameweek[item.Gameweek]
+		comparisons = append(comparisons, UserGameweekComparison{
+			Gameweek:              item.Gameweek,
+			Points:                points,
+			AveragePoints:         item.AveragePoints,
+			HighestPoints:         item.HighestPoints,
+			DifferenceFromAverage: math.Round((float64(points)-item.AveragePoints)*100) / 100,
+		})
+	}
+	sort.SliceStable(comparisons, func(i, j int) bool {
+		return comparisons[i].Gameweek < comparisons[j].Gameweek
+	})
+
 	return UserSeasonPointsSummary{
 		LeagueID:              leagueID,
 		UserID:                userID,
@@ -307,9 +458,30 @@ func (s *ScoringService) GetUserSeasonPointsSummary(ctx context.Context, leagueI
 		Gameweeks:             currentGameweek,
 		CurrentGameweek:       currentGameweek,
 		CurrentGameweekPoints: pointsByGameweek[currentGameweek],
+		Comparisons:           comparisons,
 	}, nil
 }
 
+// ListGameweekSummaries returns the league-wide stats of every calculated
+// gameweek, oldest first.
+func (s *ScoringService) ListGameweekSummaries(ctx context.Context, leagueID string) ([]scoring.GameweekSummary, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.ScoringService.ListGameweekSummaries")
+	defer span.End()
+
+	if err := s.EnsureLeagueUpToDate(ctx, leagueID); err != nil {
+		return nil, err
+	}
+
+	items, err := s.scoringRepo.ListGameweekSummariesByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("list gameweek summaries: %w", err)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Gameweek < items[j].Gameweek
+	})
+	return items, nil
+}
+
 func (s *ScoringService) ListUserPlayerPointsByLeague(ctx context.Context, leagueID, userID string, gameweek *int) ([]UserGameweekPlayerPoints, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.ScoringService.ListUserPlayerPointsByLeague")
 	defer span.End()
@@ -469,6 +641,28 @@ func (s *ScoringService) ensureGameweekLocked(
 	return true, nil
 }
 
+// ensureGameweekAwards computes the awards of a finalized gameweek when they
+// are missing, or always after its points were recalculated.
+func (s *ScoringService) ensureGameweekAwards(
+	ctx context.Context,
+	leagueID string,
+	gameweek int,
+	awardedGameweeks map[int]struct{},
+	recalculated bool,
+) error {
+	if s.awards == nil {
+		return nil
+	}
+	if _, awarded := awardedGameweeks[gameweek]; awarded && !recalculated {
+		return nil
+	}
+	if _, err := s.awards.ComputeGameweek(ctx, leagueID, gameweek); err != nil {
+		return fmt.Errorf("compute awards gameweek=%d: %w", gameweek, err)
+	}
+	awardedGameweeks[gameweek] = struct{}{}
+	return nil
+}
+
 func (s *ScoringService) recalculateGameweekPoints(ctx context.Context, leagueID string, gameweek int, now time.Time) error {
 	lineupSnapshots, err := s.scoringRepo.ListLineupSnapshotsByLeagueGameweek(ctx, leagueID, gameweek)
 	if err != nil {
@@ -483,6 +677,14 @@ func (s *ScoringService) recalculateGameweekPoints(ctx context.Context, leagueID
 		return fmt.Errorf("get fantasy points by gameweek: %w", err)
 	}
 
+	summary := scoring.GameweekSummary{
+		LeagueID:     leagueID,
+		Gameweek:     gameweek,
+		Managers:     len(lineupSnapshots),
+		CalculatedAt: now,
+	}
+	totalPoints := 0
+	captainCount := make(map[string]int)
 	for _, snapshot := range lineupSnapshots {
 		points := calculateLineupPoints(snapshot.Lineup, playerPoints)
 		if err := s.scoringRepo.UpsertUserGameweekPoints(ctx, scoring.UserGameweekPoints{
@@ -494,6 +696,28 @@ func (s *ScoringService) recalculateGameweekPoints(ctx context.Context, leagueID
 		}); err != nil {
 			return fmt.Errorf("upsert user gameweek points user=%s gameweek=%d: %w", snapshot.Lineup.UserID, gameweek, err)
 		}
+
+		totalPoints += points
+		if summary.HighestUserID == "" || points > summary.HighestPoints ||
+			(points == summary.HighestPoints && snapshot.Lineup.UserID < summary.HighestUserID) {
+			summary.HighestPoints = points
+			summary.HighestUserID = snapshot.Lineup.UserID
+		}
+		if captainID := snapshot.Lineup.CaptainID; captainID != "" {
+			captainCount[captainID]++
+		}
+	}
+
+	summary.AveragePoints = math.Round(float64(totalPoints)*100/float64(len(lineupSnapshots))) / 100
+	for playerID, count := range captainCount {
+		if count > summary.MostCaptainedCount ||
+			(count == summary.MostCaptainedCount && playerID < summary.MostCaptainedPlayerID) {
+			summary.MostCaptainedPlayerID = playerID
+			summary.MostCaptainedCount = count
+		}
+	}
+	if err := s.scoringRepo.UpsertGameweekSummary(ctx, summary); err != nil {
+		return fmt.Errorf("upsert gameweek summary gameweek=%d: %w", gameweek, err)
 	}
 	return nil
 }
@@ -24,6 +24,10 @@ func TestScoringService_GetUserSeasonPointsSummary(t *testing.T) {
 			{LeagueID: leagueID, Gameweek: 2, UserID: userID, Points: 58},
 			{LeagueID: leagueID, Gameweek: 2, UserID: "user-2", Points: 61},
 		},
+		summaries: []scoring.GameweekSummary{
+			{LeagueID: leagueID, Gameweek: 2, Managers: 2, AveragePoints: 59.5, HighestPoints: 61, HighestUserID: "user-2"},
+			{LeagueID: leagueID, Gameweek: 1, Managers: 1, AveragePoints: 42, HighestPoints: 42, HighestUserID: userID},
+		},
 	}
 
 	service := NewScoringService(nil, nil, nil, &stubPointsPlayerStatsRepository{}, nil, scoringRepo)
@@ -46,6 +50,65 @@ func TestScoringService_GetUserSeasonPointsSummary(t *testing.T) {
 	if got.AveragePoints != 50 {
 		t.Fatalf("unexpected average points: got=%v want=50", got.AveragePoints)
 	}
+	if len(got.Comparisons) != 2 || got.Comparisons[0].Gameweek != 1 {
+		t.Fatalf("expected comparisons of gameweeks 1 and 2, got %+v", got.Comparisons)
+	}
+	if cmp := got.Comparisons[1]; cmp.Points != 58 || cmp.HighestPoints != 61 || cmp.DifferenceFromAverage != -1.5 {
+		t.Fatalf("unexpected gameweek 2 comparison: %+v", cmp)
+	}
+}
+
+type stubSummaryScoringRepository struct {
+	stubPointsScoringRepository
+	snapshots []scoring.LineupSnapshot
+}
+
+func (s *stubSummaryScoringRepository) ListLineupSnapshotsByLeagueGameweek(_ context.Context, _ string, _ int) ([]scoring.LineupSnapshot, error) {
+	return append([]scoring.LineupSnapshot(nil), s.snapshots...), nil
+}
+
+func TestScoringService_RecalculateGameweekPoints_PersistsSummary(t *testing.T) {
+	t.Parallel()
+
+	const leagueID = "idn-liga-1-2025"
+
+	starters := func(userID, captainID string, forwardIDs ...string) lineup.Lineup {
+		return lineup.Lineup{UserID: userID, GoalkeeperID: "gk-1", ForwardIDs: forwardIDs, CaptainID: captainID}
+	}
+	scoringRepo := &stubSummaryScoringRepository{snapshots: []scoring.LineupSnapshot{
+		{Gameweek: 3, Lineup: starters("user-a", "fwd-1", "fwd-1")},
+		{Gameweek: 3, Lineup: starters("user-b", "fwd-2", "fwd-2")},
+		{Gameweek: 3, Lineup: starters("user-c", "fwd-1", "fwd-2")},
+	}}
+	statsRepo := &stubPointsPlayerStatsRepository{pointsByGameweek: map[int]map[string]int{
+		3: {"gk-1": 2, "fwd-1": 5, "fwd-2": 8},
+	}}
+	service := NewScoringService(nil, nil, nil, statsRepo, nil, scoringRepo)
+
+	now := time.Date(2026, 2, 28, 21, 0, 0, 0, time.UTC)
+	if err := service.recalculateGameweekPoints(context.Background(), leagueID, 3, now); err != nil {
+		t.Fatalf("recalculateGameweekPoints error: %v", err)
+	}
+
+	if len(scoringRepo.summaries) != 1 {
+		t.Fatalf("expected one summary, got %d", len(scoringRepo.summaries))
+	}
+	// user-a: 2+5+5=12, user-b: 2+8+8=18, user-c: 2+8+5=15.
+	got := scoringRepo.summaries[0]
+	want := scoring.GameweekSummary{
+		LeagueID:              leagueID,
+		Gameweek:              3,
+		Managers:              3,
+		AveragePoints:         15,
+		HighestPoints:         18,
+		HighestUserID:         "user-b",
+		MostCaptainedPlayerID: "fwd-1",
+		MostCaptainedCount:    2,
+		CalculatedAt:          now,
+	}
+	if got != want {
+		t.Fatalf("unexpected summary:\n got=%+v\nwant=%+v", got, want)
+	}
 }
 
 func TestScoringService_ListUserPlayerPointsByLeague_FilteredGameweek(t *testing.T) {
@@ -135,6 +198,7 @@ func TestScoringService_ListUserPlayerPointsByLeague_FilteredGameweek(t *testing
 type stubPointsScoringRepository struct {
 	userRows          []scoring.UserGameweekPoints
 	lineupsByGameweek map[int]lineup.Lineup
+	summaries         []scoring.GameweekSummary
 }
 
 func (s *stubPointsScoringRepository) GetGameweekLock(_ context.Context, _ string, _ int) (scoring.GameweekLock, bool, error) {
@@ -157,6 +221,10 @@ func (s *stubPointsScoringRepository) UpsertSquadSnapshot(_ context.Context, _ s
 	return nil
 }
 
+func (s *stubPointsScoringRepository) ListSquadSnapshotsByLeagueGameweek(_ context.Context, _ string, _ int) ([]scoring.SquadSnapshot, error) {
+	return nil, nil
+}
+
 func (s *stubPointsScoringRepository) GetLineupSnapshot(_ context.Context, leagueID string, gameweek int, userID string) (scoring.LineupSnapshot, bool, error) {
 	item, ok := s.lineupsByGameweek[gameweek]
 	if !ok || item.UserID != userID {
@@ -191,6 +259,23 @@ func (s *stubPointsScoringRepository) ListUserGameweekPointsByLeague(_ context.C
 	return out, nil
 }
 
+func (s *stubPointsScoringRepository) UpsertGameweekSummary(_ context.Context, summary scoring.GameweekSummary) error {
+	for idx := range s.summaries {
+		if s.summaries[idx].Gameweek == summary.Gameweek {
+			s.summaries[idx] = summary
+			return nil
+		}
+	}
+	s.summaries = append(s.summaries, summary)
+	return nil
+}
+
+func (s *stubPointsScoringRepository) ListGameweekSummariesByLeague(_ context.Context, _ string) ([]scoring.GameweekSummary, error) {
+	out := make([]scoring.GameweekSummary, len(s.summaries))
+	copy(out, s.summaries)
+	return out, nil
+}
+
 type stubPointsPlayerStatsRepository struct {
 	pointsByGameweek map[int]map[string]int
 }
@@ -230,5 +315,9 @@ func (s *stubPointsPlayerStatsRepository) GetFantasyPointsByLeagueAndGameweek(_
 	return out, nil
 }
 
+func (s *stubPointsPlayerStatsRepository) ListPlayerTotalsByLeague(_ context.Context, _ string, _, _ int) ([]playerstats.PlayerTotals, error) {
+	return nil, nil
+}
+
 var _ scoring.Repository = (*stubPointsScoringRepository)(nil)
 var _ playerstats.Repository = (*stubPointsPlayerStatsRepository)(nil)
@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+	"github.com/riskibarqy/fantasy-league/internal/domain/season"
+)
+
+type SeasonHistoryService struct {
+	leagueRepo  league.Repository
+	scoringRepo scoring.Repository
+	squadRepo   fantasy.Repository
+	groupRepo   customleague.Repository
+	seasonRepo  season.Repository
+	scorer      leagueScoringUpdater
+	now         func() time.Time
+}
+
+func NewSeasonHistoryService(
+	leagueRepo league.Repository,
+	scoringRepo scoring.Repository,
+	squadRepo fantasy.Repository,
+	groupRepo customleague.Repository,
+	seasonRepo season.Repository,
+	scorer leagueScoringUpdater,
+) *SeasonHistoryService {
+	return &SeasonHistoryService{
+		leagueRepo:  leagueRepo,
+		scoringRepo: scoringRepo,
+		squadRepo:   squadRepo,
+		groupRepo:   groupRepo,
+		seasonRepo:  seasonRepo,
+		scorer:      scorer,
+		now:         time.Now,
+	}
+}
+
+// SummarizeSeason computes the final result of every manager in a league
+// season and stores it in the season summary table. It is run at season end,
+// before the league is archived, and can be rerun to backfill a season.
+func (s *SeasonHistoryService) SummarizeSeason(ctx context.Context, leagueID string) (int, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.SeasonHistoryService.SummarizeSeason")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return 0, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+
+	lg, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return 0, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return 0, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+	if s.scorer != nil && !lg.IsArchived() {
+		if err := s.scorer.EnsureLeagueUpToDate(ctx, lg.ID); err != nil {
+			return 0, fmt.Errorf("ensure league points before season summary: %w", err)
+		}
+	}
+
+	pointsRows, err := s.scoringRepo.ListUserGameweekPointsByLeague(ctx, lg.ID)
+	if err != nil {
+		return 0, fmt.Errorf("list user gameweek points for season summary: %w", err)
+	}
+	squads, err := s.squadRepo.ListByLeague(ctx, lg.ID)
+	if err != nil {
+		return 0, fmt.Errorf("list squads for season summary: %w", err)
+	}
+	finishesByUser, err := s.customLeagueFinishesByUser(ctx, lg.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	now := s.now().UTC()
+	summaryByUser := make(map[string]*season.UserSummary)
+	summaryFor := func(userID string) *season.UserSummary {
+		item, ok := summaryByUser[userID]
+		if !ok {
+			item = &season.UserSummary{
+				LeagueID:   lg.ID,
+				Season:     lg.Season,
+				UserID:     userID,
+				ComputedAt: now,
+			}
+			summaryByUser[userID] = item
+		}
+		return item
+	}
+
+	for _, squad := range squads {
+		item := summaryFor(squad.UserID)
+		item.SquadID = squad.ID
+		item.SquadName = squad.Name
+		item.FinalPicks = append([]fantasy.SquadPick(nil), squad.Picks...)
+	}
+	for _, row := range pointsRows {
+		if strings.TrimSpace(row.UserID) == "" || row.Gameweek <= 0 {
+			continue
+		}
+		item := summaryFor(row.UserID)
+		item.TotalPoints += row.Points
+		item.GameweeksPlayed++
+		if item.BestGameweek == 0 || row.Points > item.BestGameweekPoints {
+			item.BestGameweek = row.Gameweek
+			item.BestGameweekPoints = row.Points
+		}
+	}
+
+	summaries := make([]season.UserSummary, 0, len(summaryByUser))
+	for userID, item := range summaryByUser {
+		item.CustomLeagues = finishesByUser[userID]
+		summaries = append(summaries, *item)
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if summaries[i].TotalPoints != summaries[j].TotalPoints {
+			return summaries[i].TotalPoints > summaries[j].TotalPoints
+		}
+		return summaries[i].UserID < summaries[j].UserID
+	})
+
+	lastPoints := 0
+	rank := 0
+	for idx := range summaries {
+		if idx == 0 || summaries[idx].TotalPoints != lastPoints {
+			rank++
+			lastPoints = summaries[idx].TotalPoints
+		}
+		summaries[idx].OverallRank = rank
+		summaries[idx].TotalManagers = len(summaries)
+	}
+
+	if err := s.seasonRepo.UpsertUserSummaries(ctx, summaries); err != nil {
+		return 0, fmt.Errorf("upsert user season summaries league=%s: %w", lg.ID, err)
+	}
+
+	return len(summaries), nil
+}
+
+func (s *SeasonHistoryService) customLeagueFinishesByUser(ctx context.Context, leagueID string) (map[string][]season.CustomLeagueFinish, error) {
+	groups, err := s.groupRepo.ListGroupsByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom leagues for season summary: %w", err)
+	}
+
+	out := make(map[string][]season.CustomLeagueFinish)
+	for _, group := range groups {
+		standings, err := s.groupRepo.ListStandingsByGroup(ctx, group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("list standings for season summary group=%s: %w", group.ID, err)
+		}
+		for _, standing := range standings {
+			out[standing.UserID] = append(out[standing.UserID], season.CustomLeagueFinish{
+				GroupID:   group.ID,
+				Name:      group.Name,
+				IsDefault: group.IsDefault,
+				Rank:      standing.Rank,
+				Points:    standing.Points,
+				Members:   len(standings),
+			})
+		}
+	}
+
+	for userID := range out {
+		items := out[userID]
+		sort.SliceStable(items, func(i, j int) bool {
+			if items[i].IsDefault != items[j].IsDefault {
+				return items[i].IsDefault
+			}
+			return items[i].Name < items[j].Name
+		})
+	}
+	return out, nil
+}
+
+// ListUserHistory returns the stored summaries of every season the user
+// played, most recent first.
+func (s *SeasonHistoryService) ListUserHistory(ctx context.Context, userID string) ([]season.UserSummary, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.SeasonHistoryService.ListUserHistory")
+	defer span.End()
+
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", ErrInvalidInput)
+	}
+
+	items, err := s.seasonRepo.ListUserSummariesByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list user season summaries: %w", err)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Season != items[j].Season {
+			return items[i].Season > items[j].Season
+		}
+		return items[i].LeagueID < items[j].LeagueID
+	})
+
+	return items, nil
+}
+
+// ListSharedHistory returns the season summaries of a manager that a viewer
+// may see: only seasons in which both finished in the same private custom
+// league, and only the custom leagues they shared.
+func (s *SeasonHistoryService) ListSharedHistory(ctx context.Context, viewerUserID, managerUserID string) ([]season.UserSummary, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.SeasonHistoryService.ListSharedHistory")
+	defer span.End()
+
+	viewerUserID = strings.TrimSpace(viewerUserID)
+	if viewerUserID == "" {
+		return nil, fmt.Errorf("%w: viewer user id is required", ErrInvalidInput)
+	}
+	items, err := s.ListUserHistory(ctx, managerUserID)
+	if err != nil {
+		return nil, err
+	}
+	viewerItems, err := s.seasonRepo.ListUserSummariesByUser(ctx, viewerUserID)
+	if err != nil {
+		return nil, fmt.Errorf("list viewer season summaries: %w", err)
+	}
+
+	viewerGroups := make(map[string]struct{})
+	for _, item := range viewerItems {
+		for _, finish := range item.CustomLeagues {
+			viewerGroups[finish.GroupID] = struct{}{}
+		}
+	}
+
+	out := make([]season.UserSummary, 0, len(items))
+	for _, item := range items {
+		shared := make([]season.CustomLeagueFinish, 0, len(item.CustomLeagues))
+		sharesPrivate := false
+		for _, finish := range item.CustomLeagues {
+			if _, ok := viewerGroups[finish.GroupID]; !ok {
+				continue
+			}
+			shared = append(shared, finish)
+			sharesPrivate = sharesPrivate || !finish.IsDefault
+		}
+		if !sharesPrivate {
+			continue
+		}
+		item.CustomLeagues = shared
+		out = append(out, item)
+	}
+	return out, nil
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+	"github.com/riskibarqy/fantasy-league/internal/domain/season"
+	"github.com/riskibarqy/fantasy-league/internal/infrastructure/repository/memory"
+)
+
+type stubHistoryScoringRepository struct {
+	scoring.Repository
+	points []scoring.UserGameweekPoints
+}
+
+func (s *stubHistoryScoringRepository) ListUserGameweekPointsByLeague(_ context.Context, leagueID string) ([]scoring.UserGameweekPoints, error) {
+	out := make([]scoring.UserGameweekPoints, 0, len(s.points))
+	for _, item := range s.points {
+		if item.LeagueID == leagueID {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+type stubHistoryGroupRepository struct {
+	customleague.Repository
+	groups    []customleague.Group
+	standings map[string][]customleague.Standing
+}
+
+func (s *stubHistoryGroupRepository) ListGroupsByLeague(_ context.Context, _ string) ([]customleague.Group, error) {
+	return append([]customleague.Group(nil), s.groups...), nil
+}
+
+func (s *stubHistoryGroupRepository) ListStandingsByGroup(_ context.Context, groupID string) ([]customleague.Standing, error) {
+	return append([]customleague.Standing(nil), s.standings[groupID]...), nil
+}
+
+type countingScoringUpdater struct {
+	calls int
+}
+
+func (c *countingScoringUpdater) EnsureLeagueUpToDate(_ context.Context, _ string) error {
+	c.calls++
+	return nil
+}
+
+func TestSeasonHistoryService_SummarizeSeason(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		"idn-liga-1-2025": {ID: "idn-liga-1-2025", Name: "Liga 1 Indonesia", Season: "2025/2026"},
+	}}
+	scoringRepo := &stubHistoryScoringRepository{points: []scoring.UserGameweekPoints{
+		{LeagueID: "idn-liga-1-2025", Gameweek: 1, UserID: "user-a", Points: 40},
+		{LeagueID: "idn-liga-1-2025", Gameweek: 2, UserID: "user-a", Points: 72},
+		{LeagueID: "idn-liga-1-2025", Gameweek: 1, UserID: "user-b", Points: 90},
+		{LeagueID: "idn-liga-1-2025", Gameweek: 2, UserID: "user-b", Points: 22},
+		{LeagueID: "idn-liga-1-2025", Gameweek: 1, UserID: "user-c", Points: 30},
+	}}
+	squadRepo := memory.NewSquadRepository()
+	if err := squadRepo.Upsert(ctx, fantasy.Squad{
+		ID:        "squad-a",
+		UserID:    "user-a",
+		LeagueID:  "idn-liga-1-2025",
+		Name:      "Garuda XI",
+		BudgetCap: 1000,
+		Picks:     []fantasy.SquadPick{{PlayerID: "p-1", TeamID: "t-1", Position: player.PositionGoalkeeper, Price: 50}},
+	}); err != nil {
+		t.Fatalf("seed squad: %v", err)
+	}
+	groupRepo := &stubHistoryGroupRepository{
+		groups: []customleague.Group{
+			{ID: "group-office", LeagueID: "idn-liga-1-2025", Name: "Office League"},
+			{ID: "default-league-idn-liga-1-2025", LeagueID: "idn-liga-1-2025", Name: "Global", IsDefault: true},
+		},
+		standings: map[string][]customleague.Standing{
+			"group-office": {
+				{GroupID: "group-office", UserID: "user-a", Points: 112, Rank: 1},
+				{GroupID: "group-office", UserID: "user-c", Points: 30, Rank: 2},
+			},
+			"default-league-idn-liga-1-2025": {
+				{GroupID: "default-league-idn-liga-1-2025", UserID: "user-a", Points: 112, Rank: 1},
+				{GroupID: "default-league-idn-liga-1-2025", UserID: "user-b", Points: 112, Rank: 1},
+				{GroupID: "default-league-idn-liga-1-2025", UserID: "user-c", Points: 30, Rank: 2},
+			},
+		},
+	}
+	seasons := &recordingSeasonRepository{leagues: leagues}
+	scorer := &countingScoringUpdater{}
+
+	svc := NewSeasonHistoryService(leagues, scoringRepo, squadRepo, groupRepo, seasons, scorer)
+	svc.now = func() time.Time { return time.Date(2026, 5, 30, 0, 0, 0, 0, time.UTC) }
+
+	count, err := svc.SummarizeSeason(ctx, "idn-liga-1-2025")
+	if err != nil {
+		t.Fatalf("SummarizeSeason error: %v", err)
+	}
+	if count != 3 || scorer.calls != 1 {
+		t.Fatalf("unexpected summarize count=%d scorer calls=%d", count, scorer.calls)
+	}
+
+	history, err := svc.ListUserHistory(ctx, "user-a")
+	if err != nil {
+		t.Fatalf("ListUserHistory error: %v", err)
+	}
+	if len(history) != 1 {
+		t.Fatalf("expected one season for user-a, got %d", len(history))
+	}
+	got := history[0]
+	if got.Season != "2025/2026" || got.TotalPoints != 112 || got.OverallRank != 1 || got.TotalManagers != 3 {
+		t.Fatalf("unexpected summary totals: %+v", got)
+	}
+	if got.GameweeksPlayed != 2 || got.BestGameweek != 2 || got.BestGameweekPoints != 72 {
+		t.Fatalf("unexpected best gameweek: %+v", got)
+	}
+	if got.SquadID != "squad-a" || got.SquadName != "Garuda XI" || len(got.FinalPicks) != 1 || got.FinalPicks[0].PlayerID != "p-1" {
+		t.Fatalf("unexpected final squad: %+v", got)
+	}
+	if len(got.CustomLeagues) != 2 || !got.CustomLeagues[0].IsDefault || got.CustomLeagues[0].Members != 3 || got.CustomLeagues[1].GroupID != "group-office" {
+		t.Fatalf("unexpected custom league finishes: %+v", got.CustomLeagues)
+	}
+
+	ranks := make(map[string]int, len(seasons.summaries))
+	for _, item := range seasons.summaries {
+		ranks[item.UserID] = item.OverallRank
+	}
+	if ranks["user-b"] != 1 || ranks["user-c"] != 2 {
+		t.Fatalf("tied totals must share a rank, got %+v", ranks)
+	}
+}
+
+func TestSeasonHistoryService_SummarizeSeason_ArchivedLeagueSkipsScoring(t *testing.T) {
+	t.Parallel()
+
+	archivedAt := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		"idn-liga-1-2024": {ID: "idn-liga-1-2024", Season: "2024/2025", ArchivedAt: &archivedAt},
+	}}
+	seasons := &recordingSeasonRepository{leagues: leagues}
+	scorer := &countingScoringUpdater{}
+	svc := NewSeasonHistoryService(
+		leagues,
+		&stubHistoryScoringRepository{points: []scoring.UserGameweekPoints{{LeagueID: "idn-liga-1-2024", Gameweek: 1, UserID: "user-a", Points: 10}}},
+		memory.NewSquadRepository(),
+		&stubHistoryGroupRepository{},
+		seasons,
+		scorer,
+	)
+
+	count, err := svc.SummarizeSeason(context.Background(), "idn-liga-1-2024")
+	if err != nil {
+		t.Fatalf("SummarizeSeason error: %v", err)
+	}
+	if count != 1 || scorer.calls != 0 {
+		t.Fatalf("archived season must be summarized without rescoring, count=%d calls=%d", count, scorer.calls)
+	}
+}
+
+func TestSeasonHistoryService_ListSharedHistory(t *testing.T) {
+	t.Parallel()
+
+	seasons := &recordingSeasonRepository{summaries: []season.UserSummary{
+		{LeagueID: "lg-2024", Season: "2024/2025", UserID: "user-b", CustomLeagues: []season.CustomLeagueFinish{
+			{GroupID: "default-2024", Name: "Liga 1", IsDefault: true, Rank: 10},
+			{GroupID: "kantor-2024", Name: "Kantor", Rank: 2},
+			{GroupID: "keluarga-2024", Name: "Keluarga", Rank: 1},
+		}},
+		{LeagueID: "lg-2023", Season: "2023/2024", UserID: "user-b", CustomLeagues: []season.CustomLeagueFinish{
+			{GroupID: "default-2023", Name: "Liga 1", IsDefault: true, Rank: 40},
+		}},
+		{LeagueID: "lg-2024", Season: "2024/2025", UserID: "user-a", CustomLeagues: []season.CustomLeagueFinish{
+			{GroupID: "default-2024", Name: "Liga 1", IsDefault: true, Rank: 7},
+			{GroupID: "kantor-2024", Name: "Kantor", Rank: 1},
+		}},
+		{LeagueID: "lg-2023", Season: "2023/2024", UserID: "user-a", CustomLeagues: []season.CustomLeagueFinish{
+			{GroupID: "default-2023", Name: "Liga 1", IsDefault: true, Rank: 12},
+		}},
+	}}
+	svc := NewSeasonHistoryService(nil, nil, nil, nil, seasons, nil)
+
+	items, err := svc.ListSharedHistory(context.Background(), "user-a", "user-b")
+	if err != nil {
+		t.Fatalf("list shared history: %v", err)
+	}
+	if len(items) != 1 || items[0].Season != "2024/2025" {
+		t.Fatalf("expected only the season with a shared private custom league, got %+v", items)
+	}
+	groups := items[0].CustomLeagues
+	if len(groups) != 2 || groups[0].GroupID != "default-2024" || groups[1].GroupID != "kantor-2024" {
+		t.Fatalf("expected the custom leagues the viewer was not in to be left out, got %+v", groups)
+	}
+}
@@ -0,0 +1,327 @@
+package usecase
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/season"
+	idgen "github.com/riskibarqy/fantasy-league/internal/platform/id"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+)
+
+var seasonSuffixRegex = regexp.MustCompile(`-\d{4}$`)
+
+type SeasonRolloverInput struct {
+	LeagueID string
+	// NewLeagueID defaults to LeagueID with its trailing year replaced by the
+	// first year of Season, e.g. idn-liga-1-2025 -> idn-liga-1-2026.
+	NewLeagueID string
+	Season      string
+	SeasonRefID int64
+	// Name defaults to the current league name.
+	Name string
+	// Force skips the check that every fixture of the current season is done.
+	Force bool
+}
+
+type SeasonRolloverResult struct {
+	Rollover         season.Rollover
+	League           league.League
+	SummarizedUsers  int
+	MasterDataSynced bool
+	MasterData       ResyncResult
+	SyncError        string
+}
+
+type seasonMasterDataSyncer interface {
+	SyncSeasonMasterData(ctx context.Context, lg league.League) (ResyncResult, error)
+}
+
+type seasonSummarizer interface {
+	SummarizeSeason(ctx context.Context, leagueID string) (int, error)
+}
+
+type SeasonRolloverService struct {
+	leagueRepo  league.Repository
+	fixtureRepo fixture.Repository
+	groupRepo   customleague.Repository
+	seasonRepo  season.Repository
+	syncer      seasonMasterDataSyncer
+	summarizer  seasonSummarizer
+	idGen       idgen.Generator
+	logger      *logging.Logger
+	now         func() time.Time
+}
+
+func NewSeasonRolloverService(
+	leagueRepo league.Repository,
+	fixtureRepo fixture.Repository,
+	groupRepo customleague.Repository,
+	seasonRepo season.Repository,
+	syncer seasonMasterDataSyncer,
+	idGen idgen.Generator,
+	logger *logging.Logger,
+) *SeasonRolloverService {
+	if logger == nil {
+		logger = logging.Default()
+	}
+
+	return &SeasonRolloverService{
+		leagueRepo:  leagueRepo,
+		fixtureRepo: fixtureRepo,
+		groupRepo:   groupRepo,
+		seasonRepo:  seasonRepo,
+		syncer:      syncer,
+		idGen:       idGen,
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// SetSeasonSummarizer stores the final user summaries of a season before it is
+// archived.
+func (s *SeasonRolloverService) SetSeasonSummarizer(summarizer seasonSummarizer) {
+	s.summarizer = summarizer
+}
+
+// Rollover archives the current season of a league and opens the next one.
+// Squads, lineups, points and custom league standings stay on the archived
+// league as read-only history. Custom leagues are recreated for the new league
+// with fresh invite codes; members rejoin them with zero points once they pick
+// a squad for the new season.
+func (s *SeasonRolloverService) Rollover(ctx context.Context, input SeasonRolloverInput) (SeasonRolloverResult, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.SeasonRolloverService.Rollover")
+	defer span.End()
+
+	input.LeagueID = strings.TrimSpace(input.LeagueID)
+	input.NewLeagueID = strings.TrimSpace(input.NewLeagueID)
+	input.Season = strings.TrimSpace(input.Season)
+	input.Name = strings.TrimSpace(input.Name)
+	if input.LeagueID == "" {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	if input.Season == "" {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: season is required", ErrInvalidInput)
+	}
+	if input.SeasonRefID <= 0 {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: season ref id must be positive", ErrInvalidInput)
+	}
+
+	current, exists, err := s.leagueRepo.GetByID(ctx, input.LeagueID)
+	if err != nil {
+		return SeasonRolloverResult{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: league=%s", ErrNotFound, input.LeagueID)
+	}
+	if current.IsArchived() {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: league=%s is already archived", ErrInvalidInput, current.ID)
+	}
+	if strings.EqualFold(current.Season, input.Season) {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: league=%s is already on season %s", ErrInvalidInput, current.ID, input.Season)
+	}
+
+	if input.NewLeagueID == "" {
+		input.NewLeagueID, err = nextSeasonLeagueID(current.ID, input.Season)
+		if err != nil {
+			return SeasonRolloverResult{}, err
+		}
+	}
+	if input.NewLeagueID == current.ID {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: new league id must differ from league=%s", ErrInvalidInput, current.ID)
+	}
+	if _, exists, err := s.leagueRepo.GetByID(ctx, input.NewLeagueID); err != nil {
+		return SeasonRolloverResult{}, fmt.Errorf("get new season league: %w", err)
+	} else if exists {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: league=%s already exists", ErrInvalidInput, input.NewLeagueID)
+	}
+
+	if !input.Force {
+		if err := s.ensureSeasonFinished(ctx, current.ID); err != nil {
+			return SeasonRolloverResult{}, err
+		}
+	}
+
+	next := league.League{
+		ID:               input.NewLeagueID,
+		Name:             input.Name,
+		CountryCode:      current.CountryCode,
+		Season:           input.Season,
+		IsDefault:        current.IsDefault,
+		LeagueRefID:      current.LeagueRefID,
+		SeasonRefID:      input.SeasonRefID,
+		OriginLeagueID:   current.ConfigKey(),
+		PreviousLeagueID: current.ID,
+	}
+	if next.Name == "" {
+		next.Name = current.Name
+	}
+	if err := next.Validate(); err != nil {
+		return SeasonRolloverResult{}, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	groups, err := s.carryOverGroups(ctx, current, next)
+	if err != nil {
+		return SeasonRolloverResult{}, err
+	}
+	summarized := 0
+	if s.summarizer != nil {
+		summarized, err = s.summarizer.SummarizeSeason(ctx, current.ID)
+		if err != nil {
+			return SeasonRolloverResult{}, fmt.Errorf("summarize season league=%s: %w", current.ID, err)
+		}
+	}
+	rolloverID, err := s.idGen.NewID()
+	if err != nil {
+		return SeasonRolloverResult{}, fmt.Errorf("generate season rollover id: %w", err)
+	}
+
+	rollover, err := s.seasonRepo.Rollover(ctx, season.RolloverPlan{
+		RolloverID: rolloverID,
+		From:       current,
+		To:         next,
+		Groups:     groups,
+	})
+	if err != nil {
+		if isDuplicateConstraintError(err) {
+			return SeasonRolloverResult{}, fmt.Errorf("%w: duplicate league, season, or invite code", ErrInvalidInput)
+		}
+		return SeasonRolloverResult{}, fmt.Errorf("rollover league=%s: %w", current.ID, err)
+	}
+
+	created, exists, err := s.leagueRepo.GetByID(ctx, next.ID)
+	if err != nil {
+		return SeasonRolloverResult{}, fmt.Errorf("get new season league: %w", err)
+	}
+	if exists {
+		next = created
+	}
+
+	result := SeasonRolloverResult{
+		Rollover:        rollover,
+		League:          next,
+		SummarizedUsers: summarized,
+	}
+	if s.syncer == nil {
+		return result, nil
+	}
+	// The rollover is already committed, so a failed sync is reported rather
+	// than returned and can be retried through the resync job.
+	result.MasterData, err = s.syncer.SyncSeasonMasterData(ctx, next)
+	if err != nil {
+		s.logger.WarnContext(ctx, "sync master data for new season failed",
+			"league_id", next.ID,
+			"season_ref_id", next.SeasonRefID,
+			"error", err,
+		)
+		result.SyncError = err.Error()
+		return result, nil
+	}
+	result.MasterDataSynced = true
+
+	return result, nil
+}
+
+func (s *SeasonRolloverService) ensureSeasonFinished(ctx context.Context, leagueID string) error {
+	fixtures, err := s.fixtureRepo.ListByLeague(ctx, leagueID)
+	if err != nil {
+		return fmt.Errorf("list fixtures for rollover: %w", err)
+	}
+
+	pending := 0
+	for _, item := range fixtures {
+		if fixture.IsFinishedStatus(item.Status) || fixture.IsCancelledLikeStatus(item.Status) {
+			continue
+		}
+		pending++
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: league=%s still has %d unfinished fixtures, use force to roll over anyway", ErrInvalidInput, leagueID, pending)
+	}
+	return nil
+}
+
+// carryOverGroups copies every custom league of the current season. Default
+// groups keep the deterministic ids and invite codes the migrations seed so
+// they can be recreated idempotently.
+func (s *SeasonRolloverService) carryOverGroups(ctx context.Context, current, next league.League) ([]season.CarriedGroup, error) {
+	items, err := s.groupRepo.ListGroupsByLeague(ctx, current.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom leagues for rollover: %w", err)
+	}
+
+	now := s.now().UTC()
+	out := make([]season.CarriedGroup, 0, len(items))
+	for _, item := range items {
+		group := customleague.Group{
+			LeagueID:    next.ID,
+			CountryCode: item.CountryCode,
+			OwnerUserID: item.OwnerUserID,
+			Name:        item.Name,
+			IsDefault:   item.IsDefault,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+
+		if item.IsDefault {
+			if strings.HasSuffix(group.Name, current.Name) {
+				group.Name = strings.TrimSuffix(group.Name, current.Name) + next.Name
+			}
+			group.ID, group.InviteCode = defaultGroupIdentity(next.ID, item.CountryCode)
+		} else {
+			group.ID, err = s.idGen.NewID()
+			if err != nil {
+				return nil, fmt.Errorf("generate custom league id: %w", err)
+			}
+			group.InviteCode, err = generateInviteCode(ctx, 8)
+			if err != nil {
+				return nil, fmt.Errorf("generate invite code: %w", err)
+			}
+		}
+
+		out = append(out, season.CarriedGroup{
+			PreviousGroupID: item.ID,
+			Group:           group,
+		})
+	}
+
+	return out, nil
+}
+
+// defaultGroupIdentity mirrors the ids and invite codes seeded for default
+// custom leagues in the migrations.
+func defaultGroupIdentity(leagueID, countryCode string) (string, string) {
+	countryCode = normalizeCountryCode(countryCode)
+	if countryCode == "" {
+		return "default-league-" + leagueID, "DL" + md5Prefix("default-league:"+leagueID)
+	}
+	return "default-country-" + leagueID + "-" + strings.ToLower(countryCode),
+		"DC" + md5Prefix("default-country:"+leagueID+":"+countryCode)
+}
+
+func md5Prefix(value string) string {
+	sum := md5.Sum([]byte(value))
+	return strings.ToUpper(hex.EncodeToString(sum[:])[:10])
+}
+
+func nextSeasonLeagueID(leagueID, nextSeason string) (string, error) {
+	year := ""
+	for _, part := range strings.FieldsFunc(nextSeason, func(r rune) bool { return r < '0' || r > '9' }) {
+		if len(part) == 4 {
+			year = part
+			break
+		}
+	}
+	if year == "" || !seasonSuffixRegex.MatchString(leagueID) {
+		return "", fmt.Errorf("%w: new league id is required when league=%s or season=%s has no year", ErrInvalidInput, leagueID, nextSeason)
+	}
+	return seasonSuffixRegex.ReplaceAllString(leagueID, "-"+year), nil
+}
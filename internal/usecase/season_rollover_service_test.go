@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/customleague"
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/season"
+	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
+)
+
+type stubRolloverGroupRepository struct {
+	customleague.Repository
+	byLeague map[string][]customleague.Group
+}
+
+func (s *stubRolloverGroupRepository) ListGroupsByLeague(_ context.Context, leagueID string) ([]customleague.Group, error) {
+	return append([]customleague.Group(nil), s.byLeague[leagueID]...), nil
+}
+
+type recordingSeasonRepository struct {
+	leagues   *stubLeagueRepository
+	plan      season.RolloverPlan
+	calls     int
+	summaries []season.UserSummary
+}
+
+func (r *recordingSeasonRepository) Rollover(_ context.Context, plan season.RolloverPlan) (season.Rollover, error) {
+	r.calls++
+	r.plan = plan
+	archivedAt := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	from := r.leagues.byID[plan.From.ID]
+	from.ArchivedAt = &archivedAt
+	r.leagues.byID[plan.From.ID] = from
+	r.leagues.byID[plan.To.ID] = plan.To
+
+	return season.Rollover{
+		ID:                   plan.RolloverID,
+		FromLeagueID:         plan.From.ID,
+		ToLeagueID:           plan.To.ID,
+		FromSeason:           plan.From.Season,
+		ToSeason:             plan.To.Season,
+		SeasonRefID:          plan.To.SeasonRefID,
+		CarriedCustomLeagues: len(plan.Groups),
+	}, nil
+}
+
+func (r *recordingSeasonRepository) GetRolloverByFromLeague(_ context.Context, _ string) (season.Rollover, bool, error) {
+	return season.Rollover{}, false, nil
+}
+
+func (r *recordingSeasonRepository) UpsertUserSummaries(_ context.Context, items []season.UserSummary) error {
+	r.summaries = append(r.summaries, items...)
+	return nil
+}
+
+func (r *recordingSeasonRepository) ListUserSummariesByUser(_ context.Context, userID string) ([]season.UserSummary, error) {
+	out := make([]season.UserSummary, 0)
+	for _, item := range r.summaries {
+		if item.UserID == userID {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+type recordingMasterDataSyncer struct {
+	synced []league.League
+	err    error
+}
+
+func (s *recordingMasterDataSyncer) SyncSeasonMasterData(_ context.Context, lg league.League) (ResyncResult, error) {
+	s.synced = append(s.synced, lg)
+	if s.err != nil {
+		return ResyncResult{}, s.err
+	}
+	return ResyncResult{LeagueCount: 1, TaskCount: 4, SuccessCount: 4}, nil
+}
+
+func newRolloverTestService(fixtures []fixture.Fixture, syncer seasonMasterDataSyncer) (*SeasonRolloverService, *stubLeagueRepository, *recordingSeasonRepository) {
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		"idn-liga-1-2025": {
+			ID:          "idn-liga-1-2025",
+			Name:        "Liga 1 Indonesia",
+			CountryCode: "ID",
+			Season:      "2025/2026",
+			IsDefault:   true,
+			LeagueRefID: 501,
+		},
+	}}
+	groups := &stubRolloverGroupRepository{byLeague: map[string][]customleague.Group{
+		"idn-liga-1-2025": {
+			{ID: "default-league-idn-liga-1-2025", LeagueID: "idn-liga-1-2025", OwnerUserID: "system", Name: "Global - Liga 1 Indonesia", InviteCode: "DLAAAAAAAAAA", IsDefault: true},
+			{ID: "default-country-idn-liga-1-2025-id", LeagueID: "idn-liga-1-2025", CountryCode: "ID", OwnerUserID: "system", Name: "Indonesia Fans - Liga 1 Indonesia", InviteCode: "DCAAAAAAAAAA", IsDefault: true},
+			{ID: "group-office", LeagueID: "idn-liga-1-2025", OwnerUserID: "user-1", Name: "Office League", InviteCode: "OFFICE01"},
+		},
+	}}
+	seasons := &recordingSeasonRepository{leagues: leagues}
+	fixtureRepo := &stubFixtureRepository{byLeague: map[string][]fixture.Fixture{"idn-liga-1-2025": fixtures}}
+
+	svc := NewSeasonRolloverService(leagues, fixtureRepo, groups, seasons, syncer, staticIDGenerator{id: "generated-id"}, logging.NewNop())
+	return svc, leagues, seasons
+}
+
+func TestSeasonRolloverService_Rollover_CarriesOverGroupsAndSyncs(t *testing.T) {
+	t.Parallel()
+
+	syncer := &recordingMasterDataSyncer{}
+	svc, _, seasons := newRolloverTestService([]fixture.Fixture{
+		{ID: "f-1", LeagueID: "idn-liga-1-2025", Status: fixture.StatusFinished},
+		{ID: "f-2", LeagueID: "idn-liga-1-2025", Status: fixture.StatusPostponed},
+	}, syncer)
+
+	result, err := svc.Rollover(context.Background(), SeasonRolloverInput{
+		LeagueID:    "idn-liga-1-2025",
+		Season:      "2026/2027",
+		SeasonRefID: 26001,
+	})
+	if err != nil {
+		t.Fatalf("Rollover error: %v", err)
+	}
+
+	next := seasons.plan.To
+	if next.ID != "idn-liga-1-2026" || next.SeasonRefID != 26001 || next.PreviousLeagueID != "idn-liga-1-2025" {
+		t.Fatalf("unexpected new league: %+v", next)
+	}
+	if next.OriginLeagueID != "idn-liga-1-2025" || next.Name != "Liga 1 Indonesia" || !next.IsDefault || next.LeagueRefID != 501 {
+		t.Fatalf("new league must inherit identity from previous season: %+v", next)
+	}
+	if len(seasons.plan.Groups) != 3 {
+		t.Fatalf("expected 3 carried groups, got %d", len(seasons.plan.Groups))
+	}
+
+	global, country, office := seasons.plan.Groups[0], seasons.plan.Groups[1], seasons.plan.Groups[2]
+	if global.Group.ID != "default-league-idn-liga-1-2026" || global.Group.InviteCode[:2] != "DL" || global.PreviousGroupID != "default-league-idn-liga-1-2025" {
+		t.Fatalf("unexpected global default group: %+v", global)
+	}
+	if country.Group.ID != "default-country-idn-liga-1-2026-id" || country.Group.CountryCode != "ID" || country.Group.InviteCode[:2] != "DC" {
+		t.Fatalf("unexpected country default group: %+v", country)
+	}
+	if office.Group.ID != "generated-id" || office.Group.InviteCode == "OFFICE01" || office.Group.OwnerUserID != "user-1" || office.Group.LeagueID != "idn-liga-1-2026" {
+		t.Fatalf("unexpected carried custom league: %+v", office)
+	}
+
+	if !result.MasterDataSynced || len(syncer.synced) != 1 || syncer.synced[0].ID != "idn-liga-1-2026" {
+		t.Fatalf("expected master data sync for new league, got result=%+v synced=%+v", result, syncer.synced)
+	}
+	if result.Rollover.CarriedCustomLeagues != 3 || result.League.ID != "idn-liga-1-2026" {
+		t.Fatalf("unexpected rollover result: %+v", result)
+	}
+
+	if _, err := svc.Rollover(context.Background(), SeasonRolloverInput{
+		LeagueID:    "idn-liga-1-2025",
+		Season:      "2026/2027",
+		SeasonRefID: 26001,
+	}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected archived league to be rejected, got %v", err)
+	}
+}
+
+func TestSeasonRolloverService_Rollover_RequiresFinishedSeasonUnlessForced(t *testing.T) {
+	t.Parallel()
+
+	svc, _, seasons := newRolloverTestService([]fixture.Fixture{
+		{ID: "f-1", LeagueID: "idn-liga-1-2025", Status: fixture.StatusFinished},
+		{ID: "f-2", LeagueID: "idn-liga-1-2025", Status: fixture.StatusScheduled},
+	}, nil)
+
+	input := SeasonRolloverInput{
+		LeagueID:    "idn-liga-1-2025",
+		NewLeagueID: "idn-liga-1-next",
+		Season:      "2026/2027",
+		SeasonRefID: 26001,
+	}
+	if _, err := svc.Rollover(context.Background(), input); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected unfinished season to be rejected, got %v", err)
+	}
+	if seasons.calls != 0 {
+		t.Fatalf("rollover must not be written when the season is unfinished")
+	}
+
+	input.Force = true
+	result, err := svc.Rollover(context.Background(), input)
+	if err != nil {
+		t.Fatalf("forced Rollover error: %v", err)
+	}
+	if result.League.ID != "idn-liga-1-next" || result.MasterDataSynced {
+		t.Fatalf("unexpected forced rollover result: %+v", result)
+	}
+}
+
+func TestSeasonRolloverService_Rollover_SyncFailureDoesNotFailRollover(t *testing.T) {
+	t.Parallel()
+
+	syncer := &recordingMasterDataSyncer{err: errors.New("provider down")}
+	svc, _, seasons := newRolloverTestService(nil, syncer)
+
+	result, err := svc.Rollover(context.Background(), SeasonRolloverInput{
+		LeagueID:    "idn-liga-1-2025",
+		Season:      "2026/2027",
+		SeasonRefID: 26001,
+	})
+	if err != nil {
+		t.Fatalf("Rollover error: %v", err)
+	}
+	if seasons.calls != 1 || result.MasterDataSynced || result.SyncError == "" {
+		t.Fatalf("expected committed rollover with sync error, got %+v", result)
+	}
+}
+
+func TestNextSeasonLeagueID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		leagueID string
+		season   string
+		want     string
+		wantErr  bool
+	}{
+		{leagueID: "idn-liga-1-2025", season: "2026/2027", want: "idn-liga-1-2026"},
+		{leagueID: "eng-premier-league-2025", season: "2026", want: "eng-premier-league-2026"},
+		{leagueID: "idn-liga-1", season: "2026/2027", wantErr: true},
+		{leagueID: "idn-liga-1-2025", season: "next", wantErr: true},
+	}
+	for _, tc := range tests {
+		got, err := nextSeasonLeagueID(tc.leagueID, tc.season)
+		if tc.wantErr {
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Fatalf("nextSeasonLeagueID(%q, %q) expected invalid input, got %q %v", tc.leagueID, tc.season, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Fatalf("nextSeasonLeagueID(%q, %q) = %q, %v; want %q", tc.leagueID, tc.season, got, err, tc.want)
+		}
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/riskibarqy/fantasy-league/internal/platform/logging"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
 	"github.com/riskibarqy/fantasy-league/internal/domain/league"
 	"github.com/riskibarqy/fantasy-league/internal/domain/leaguestanding"
+	"github.com/riskibarqy/fantasy-league/internal/domain/masterdata"
 	"github.com/riskibarqy/fantasy-league/internal/domain/player"
 	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
 	"github.com/riskibarqy/fantasy-league/internal/domain/rawdata"
@@ -194,21 +196,47 @@ type ExternalPlayerStatValue struct {
 	Metadata           map[string]any
 }
 
+const (
+	SportDataSourceSportMonks  = "sportmonks"
+	SportDataSourceAPIFootball = "apifootball"
+	SportDataSourceOffline     = "offline"
+)
+
+// sportDataPublicIDPrefixes keeps public ids of different providers apart so the
+// same numeric reference id from two providers never resolves to one row.
+var sportDataPublicIDPrefixes = map[string]string{
+	SportDataSourceSportMonks:  "sm",
+	SportDataSourceAPIFootball: "af",
+	SportDataSourceOffline:     "of",
+}
+
 type SportDataSyncConfig struct {
 	Enabled          bool
 	SeasonIDByLeague map[string]int64
 	LeagueIDByLeague map[string]int64
 }
 
+type leagueSportDataProvider struct {
+	source   string
+	provider SportDataSyncProvider
+}
+
 type SportDataSyncService struct {
-	topScore   topscorers.Repository
-	provider   SportDataSyncProvider
-	teamRepo   team.Repository
-	playerRepo player.Repository
-	statRepo   statvalue.Repository
-	ingestion  *IngestionService
-	cfg        SportDataSyncConfig
-	logger     *logging.Logger
+	topScore        topscorers.Repository
+	provider        SportDataSyncProvider
+	leagueProviders map[string]leagueSportDataProvider
+	leagueRepo      league.Repository
+	teamRepo        team.Repository
+	playerRepo      player.Repository
+	statRepo        statvalue.Repository
+	masterDataRepo  masterdata.Repository
+	ingestion       *IngestionService
+	cfg             SportDataSyncConfig
+	logger          *logging.Logger
+	// leagueConfigKeys maps rolled-over league ids to the origin league id
+	// used by leagueProviders and the config reference maps.
+	leagueConfigMu   sync.RWMutex
+	leagueConfigKeys map[string]string
 }
 
 func NewSportDataSyncService(
@@ -239,6 +267,83 @@ func (s *SportDataSyncService) SetStatValueRepository(repo statvalue.Repository)
 	s.statRepo = repo
 }
 
+// SetLeagueRepository lets resync resolve leagues created by a season
+// rollover, whose provider season is stored on the league record.
+func (s *SportDataSyncService) SetLeagueRepository(repo league.Repository) {
+	s.leagueRepo = repo
+}
+
+// SetMasterDataRepository makes resync apply admin overrides on top of the
+// provider teams and players.
+func (s *SportDataSyncService) SetMasterDataRepository(repo masterdata.Repository) {
+	s.masterDataRepo = repo
+}
+
+// SetLeagueProvider routes one league to a provider other than the default
+// SportMonks client passed to NewSportDataSyncService.
+func (s *SportDataSyncService) SetLeagueProvider(leagueID, source string, provider SportDataSyncProvider) {
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" || provider == nil {
+		return
+	}
+	if s.leagueProviders == nil {
+		s.leagueProviders = make(map[string]leagueSportDataProvider)
+	}
+	s.leagueProviders[leagueID] = leagueSportDataProvider{
+		source:   normalizeSportDataSource(source),
+		provider: provider,
+	}
+}
+
+func (s *SportDataSyncService) providerForLeague(leagueID string) (SportDataSyncProvider, string) {
+	if item, ok := s.leagueProviders[s.configKey(leagueID)]; ok {
+		return item.provider, item.source
+	}
+	return s.provider, SportDataSourceSportMonks
+}
+
+// rememberLeague records the config key of a rolled-over league so helpers
+// that only receive the league id still resolve the origin league provider.
+func (s *SportDataSyncService) rememberLeague(lg league.League) {
+	leagueID := strings.TrimSpace(lg.ID)
+	key := lg.ConfigKey()
+	if leagueID == "" || key == leagueID {
+		return
+	}
+
+	s.leagueConfigMu.Lock()
+	defer s.leagueConfigMu.Unlock()
+	if s.leagueConfigKeys == nil {
+		s.leagueConfigKeys = make(map[string]string)
+	}
+	s.leagueConfigKeys[leagueID] = key
+}
+
+func (s *SportDataSyncService) configKey(leagueID string) string {
+	leagueID = strings.TrimSpace(leagueID)
+
+	s.leagueConfigMu.RLock()
+	defer s.leagueConfigMu.RUnlock()
+	if key, ok := s.leagueConfigKeys[leagueID]; ok {
+		return key
+	}
+	return leagueID
+}
+
+// seasonIDForLeague prefers the provider season stored on the league record,
+// which rollover sets for every new season, over the configured season map.
+func (s *SportDataSyncService) seasonIDForLeague(lg league.League) (int64, bool) {
+	if lg.SeasonRefID > 0 {
+		return lg.SeasonRefID, true
+	}
+	seasonID, ok := s.cfg.SeasonIDByLeague[lg.ConfigKey()]
+	return seasonID, ok && seasonID > 0
+}
+
+func (s *SportDataSyncService) hasProvider() bool {
+	return s.provider != nil || len(s.leagueProviders) > 0
+}
+
 func (s *SportDataSyncService) SyncSchedule(ctx context.Context, lg league.League) error {
 	ctx, span := startUsecaseSpan(ctx, "usecase.SportDataSyncService.SyncSchedule")
 	defer span.End()
@@ -247,11 +352,14 @@ func (s *SportDataSyncService) SyncSchedule(ctx context.Context, lg league.Leagu
 		s.logger.WarnContext(ctx, "skip schedule sync: sport data sync is disabled", "league_id", lg.ID)
 		return fmt.Errorf("%w: sport data sync is disabled (SPORTMONKS_ENABLED=false)", ErrDependencyUnavailable)
 	}
-	if s.provider == nil || s.ingestion == nil || s.teamRepo == nil || s.playerRepo == nil {
+	s.rememberLeague(lg)
+	provider, source := s.providerForLeague(lg.ID)
+	if provider == nil || s.ingestion == nil || s.teamRepo == nil || s.playerRepo == nil {
 		s.logger.WarnContext(ctx,
 			"skip schedule sync: sport data provider is not fully configured",
 			"league_id", lg.ID,
-			"provider_nil", s.provider == nil,
+			"source", source,
+			"provider_nil", provider == nil,
 			"ingestion_nil", s.ingestion == nil,
 			"team_repo_nil", s.teamRepo == nil,
 			"player_repo_nil", s.playerRepo == nil,
@@ -259,8 +367,8 @@ func (s *SportDataSyncService) SyncSchedule(ctx context.Context, lg league.Leagu
 		return fmt.Errorf("%w: sport data provider is not fully configured", ErrDependencyUnavailable)
 	}
 
-	seasonID, ok := s.cfg.SeasonIDByLeague[strings.TrimSpace(lg.ID)]
-	if !ok || seasonID <= 0 {
+	seasonID, ok := s.seasonIDForLeague(lg)
+	if !ok {
 		return fmt.Errorf("%w: SPORTMONKS_SEASON_ID_MAP missing league=%s", ErrDependencyUnavailable, lg.ID)
 	}
 
@@ -276,7 +384,7 @@ func (s *SportDataSyncService) SyncSchedule(ctx context.Context, lg league.Leagu
 		return err
 	}
 
-	standings, standingsPayloads, err := s.provider.FetchStandingsBySeason(ctx, seasonID)
+	standings, standingsPayloads, err := provider.FetchStandingsBySeason(ctx, seasonID)
 	if err != nil {
 		return fmt.Errorf("fetch standings from sport data provider season_id=%d league=%s: %w", seasonID, lg.ID, err)
 	}
@@ -296,7 +404,7 @@ func (s *SportDataSyncService) SyncSchedule(ctx context.Context, lg league.Leagu
 	}
 	if len(standingsPayloads) > 0 {
 		payloads := applyLeagueToPayloads(lg.ID, standingsPayloads)
-		if err := s.ingestion.UpsertRawPayloads(ctx, "sportmonks", payloads); err != nil {
+		if err := s.ingestion.UpsertRawPayloads(ctx, source, payloads); err != nil {
 			return fmt.Errorf("upsert season standings raw payloads league=%s: %w", lg.ID, err)
 		}
 	}
@@ -320,11 +428,14 @@ func (s *SportDataSyncService) SyncLive(ctx context.Context, lg league.League) e
 		s.logger.WarnContext(ctx, "skip live sync: sport data sync is disabled", "league_id", lg.ID)
 		return fmt.Errorf("%w: sport data sync is disabled (SPORTMONKS_ENABLED=false)", ErrDependencyUnavailable)
 	}
-	if s.provider == nil || s.ingestion == nil || s.teamRepo == nil || s.playerRepo == nil {
+	s.rememberLeague(lg)
+	provider, source := s.providerForLeague(lg.ID)
+	if provider == nil || s.ingestion == nil || s.teamRepo == nil || s.playerRepo == nil {
 		s.logger.WarnContext(ctx,
 			"skip live sync: sport data provider is not fully configured",
 			"league_id", lg.ID,
-			"provider_nil", s.provider == nil,
+			"source", source,
+			"provider_nil", provider == nil,
 			"ingestion_nil", s.ingestion == nil,
 			"team_repo_nil", s.teamRepo == nil,
 			"player_repo_nil", s.playerRepo == nil,
@@ -337,15 +448,17 @@ func (s *SportDataSyncService) SyncLive(ctx context.Context, lg league.League) e
 		return err
 	}
 
+	// leagues.external_league_id holds the SportMonks id, so other providers
+	// always resolve their league reference from config.
 	leagueRefID := lg.LeagueRefID
-	if leagueRefID <= 0 {
-		leagueRefID = s.cfg.LeagueIDByLeague[strings.TrimSpace(lg.ID)]
+	if leagueRefID <= 0 || source != SportDataSourceSportMonks {
+		leagueRefID = s.cfg.LeagueIDByLeague[lg.ConfigKey()]
 	}
 	if leagueRefID <= 0 {
 		return fmt.Errorf("%w: league reference id is missing for league=%s", ErrDependencyUnavailable, lg.ID)
 	}
 
-	liveStandings, livePayloads, err := s.provider.FetchLiveStandingsByLeague(ctx, leagueRefID)
+	liveStandings, livePayloads, err := provider.FetchLiveStandingsByLeague(ctx, leagueRefID)
 	if err != nil {
 		return fmt.Errorf("fetch live standings from sport data provider league_ref_id=%d league=%s: %w", leagueRefID, lg.ID, err)
 	}
@@ -366,13 +479,13 @@ func (s *SportDataSyncService) SyncLive(ctx context.Context, lg league.League) e
 	}
 	if len(livePayloads) > 0 {
 		payloads := applyLeagueToPayloads(lg.ID, livePayloads)
-		if err := s.ingestion.UpsertRawPayloads(ctx, "sportmonks", payloads); err != nil {
+		if err := s.ingestion.UpsertRawPayloads(ctx, source, payloads); err != nil {
 			return fmt.Errorf("upsert live standings raw payloads league=%s: %w", lg.ID, err)
 		}
 	}
 
-	seasonID, ok := s.cfg.SeasonIDByLeague[strings.TrimSpace(lg.ID)]
-	if !ok || seasonID <= 0 {
+	seasonID, ok := s.seasonIDForLeague(lg)
+	if !ok {
 		return nil
 	}
 
@@ -394,7 +507,11 @@ func (s *SportDataSyncService) syncFixtureBundle(
 	teamMappings teamMappings,
 	opts fixtureBundleSyncOptions,
 ) error {
-	bundle, err := s.provider.FetchFixtureBundleBySeason(ctx, seasonID)
+	provider, source := s.providerForLeague(leagueID)
+	if provider == nil {
+		return fmt.Errorf("%w: sport data provider is not configured for league=%s", ErrDependencyUnavailable, leagueID)
+	}
+	bundle, err := provider.FetchFixtureBundleBySeason(ctx, seasonID)
 	if err != nil {
 		return fmt.Errorf("fetch fixture bundle from sport data provider season_id=%d league=%s: %w", seasonID, leagueID, err)
 	}
@@ -435,7 +552,7 @@ func (s *SportDataSyncService) syncFixtureBundle(
 
 	if opts.upsertRawPayload && len(bundle.RawPayloads) > 0 {
 		payloads := applyLeagueToPayloads(leagueID, bundle.RawPayloads)
-		if err := s.ingestion.UpsertRawPayloads(ctx, "sportmonks", payloads); err != nil {
+		if err := s.ingestion.UpsertRawPayloads(ctx, source, payloads); err != nil {
 			return fmt.Errorf("upsert fixture raw payloads league=%s: %w", leagueID, err)
 		}
 	}
@@ -454,12 +571,14 @@ func (s *SportDataSyncService) SyncTopScorers(ctx context.Context, lg league.Lea
 	if !s.cfg.Enabled {
 		return nil
 	}
-	if s.provider == nil || s.topScore == nil {
+	s.rememberLeague(lg)
+	provider, _ := s.providerForLeague(leagueID)
+	if provider == nil || s.topScore == nil {
 		return fmt.Errorf("%w: top scorers sync dependencies are not configured", ErrDependencyUnavailable)
 	}
 
-	seasonID, ok := s.cfg.SeasonIDByLeague[leagueID]
-	if !ok || seasonID <= 0 {
+	seasonID, ok := s.seasonIDForLeague(lg)
+	if !ok {
 		return nil
 	}
 
@@ -490,7 +609,7 @@ func (s *SportDataSyncService) SyncTopScorers(ctx context.Context, lg league.Lea
 				break
 			}
 
-			dataTopScorers, hasMore, err := s.provider.FetchTopScorersBySeasonID(ctx, int(seasonID), page, typeID)
+			dataTopScorers, hasMore, err := provider.FetchTopScorersBySeasonID(ctx, int(seasonID), page, typeID)
 			if err != nil {
 				typeFailed = true
 				s.logger.WarnContext(ctx,
@@ -569,6 +688,7 @@ func mappingToModel(a []ExternalTopScorers, leagueID string) (scorers []topscore
 }
 
 type teamMappings struct {
+	source  string
 	byRefID map[int64]string
 	byName  map[string]string
 }
@@ -578,17 +698,58 @@ type playerMappings struct {
 	byRef   map[int64]player.Player
 }
 
+// masterDataOverrides holds the admin overrides of one league keyed by the
+// public team or player id.
+type masterDataOverrides struct {
+	teams   map[string]masterdata.TeamOverride
+	players map[string]masterdata.PlayerOverride
+}
+
+func (s *SportDataSyncService) loadOverrides(ctx context.Context, leagueID string) (masterDataOverrides, error) {
+	out := masterDataOverrides{
+		teams:   map[string]masterdata.TeamOverride{},
+		players: map[string]masterdata.PlayerOverride{},
+	}
+	if s.masterDataRepo == nil {
+		return out, nil
+	}
+
+	items, err := s.masterDataRepo.ListOverrides(ctx, leagueID)
+	if err != nil {
+		return masterDataOverrides{}, fmt.Errorf("list master data overrides league=%s: %w", leagueID, err)
+	}
+	for _, item := range items.Teams {
+		out.teams[item.TeamID] = item
+	}
+	for _, item := range items.Players {
+		out.players[item.PlayerID] = item
+	}
+	return out, nil
+}
+
 func (s *SportDataSyncService) loadTeamMappings(ctx context.Context, leagueID string) (teamMappings, error) {
 	teams, err := s.teamRepo.ListByLeague(ctx, leagueID)
 	if err != nil {
 		return teamMappings{}, fmt.Errorf("list teams for sport data sync league=%s: %w", leagueID, err)
 	}
 
+	_, source := s.providerForLeague(leagueID)
 	out := teamMappings{
+		source:  source,
 		byRefID: make(map[int64]string, len(teams)),
 		byName:  make(map[string]string, len(teams)*2),
 	}
 	for _, item := range teams {
+		// A league keeps the provider its data came from. Syncing another
+		// provider over it would leave the old teams, players and fixtures
+		// active next to the new ones.
+		if item.TeamRefID > 0 && normalizeSportDataSource(item.RefSource) != source {
+			return teamMappings{}, fmt.Errorf(
+				"%w: league=%s already holds %s data and cannot be synced from %s",
+				ErrConflict, leagueID, normalizeSportDataSource(item.RefSource), source,
+			)
+		}
+		// Reference ids are only meaningful inside the provider that issued them.
 		if item.TeamRefID > 0 {
 			out.byRefID[item.TeamRefID] = item.ID
 		}
@@ -611,12 +772,13 @@ func (s *SportDataSyncService) loadPlayerMappings(ctx context.Context, leagueID
 		return playerMappings{}, fmt.Errorf("list players for sport data sync league=%s: %w", leagueID, err)
 	}
 
+	_, source := s.providerForLeague(leagueID)
 	out := playerMappings{
 		byRefID: make(map[int64]string, len(items)),
 		byRef:   make(map[int64]player.Player, len(items)),
 	}
 	for _, item := range items {
-		if item.PlayerRefID > 0 {
+		if item.PlayerRefID > 0 && normalizeSportDataSource(item.RefSource) == source {
 			out.byRefID[item.PlayerRefID] = item.ID
 			out.byRef[item.PlayerRefID] = item
 		}
@@ -697,7 +859,7 @@ func mapExternalFixturesToDomain(leagueID string, items []ExternalFixture, mappi
 		}
 
 		out = append(out, fixture.Fixture{
-			ID:           buildFixturePublicID(leagueID, item.ExternalID),
+			ID:           buildFixturePublicID(mappings.source, leagueID, item.ExternalID),
 			LeagueID:     leagueID,
 			Gameweek:     gameweek,
 			HomeTeam:     strings.TrimSpace(item.HomeTeamName),
@@ -705,6 +867,7 @@ func mapExternalFixturesToDomain(leagueID string, items []ExternalFixture, mappi
 			HomeTeamID:   homeTeamID,
 			AwayTeamID:   awayTeamID,
 			FixtureRefID: item.ExternalID,
+			RefSource:    normalizeSportDataSource(mappings.source),
 			KickoffAt:    item.KickoffAt.UTC(),
 			Venue:        strings.TrimSpace(item.Venue),
 			HomeScore:    cloneIntPtr(item.HomeScore),
@@ -775,7 +938,7 @@ func mapExternalStandingsToDomain(leagueID string, items []ExternalStanding, map
 	return out
 }
 
-func mapExternalTeamsToDomain(leagueID string, items []ExternalTeam, mappings teamMappings) []team.Team {
+func mapExternalTeamsToDomain(leagueID string, items []ExternalTeam, mappings teamMappings, overrides masterDataOverrides) []team.Team {
 	if len(items) == 0 {
 		return []team.Team{}
 	}
@@ -793,18 +956,23 @@ func mapExternalTeamsToDomain(leagueID string, items []ExternalTeam, mappings te
 
 		teamID := strings.TrimSpace(mappings.byRefID[item.ExternalID])
 		if teamID == "" {
-			teamID = buildTeamPublicID(leagueID, item.ExternalID)
+			teamID = buildTeamPublicID(mappings.source, leagueID, item.ExternalID)
 		}
 		short := normalizeTeamShort(item.Short, name)
 
-		out = append(out, team.Team{
+		row := team.Team{
 			ID:        teamID,
 			LeagueID:  leagueID,
 			Name:      name,
 			Short:     short,
 			ImageURL:  strings.TrimSpace(item.ImageURL),
 			TeamRefID: item.ExternalID,
-		})
+			RefSource: normalizeSportDataSource(mappings.source),
+		}
+		if override, ok := overrides.teams[teamID]; ok {
+			row = override.Apply(row)
+		}
+		out = append(out, row)
 	}
 
 	sort.SliceStable(out, func(i, j int) bool {
@@ -822,6 +990,7 @@ func mapExternalPlayersToDomain(
 	items []ExternalPlayer,
 	teamMappings teamMappings,
 	playerMappings playerMappings,
+	overrides masterDataOverrides,
 ) []player.Player {
 	if len(items) == 0 {
 		return []player.Player{}
@@ -841,7 +1010,7 @@ func mapExternalPlayersToDomain(
 		existing := playerMappings.byRef[item.ExternalID]
 		playerID := strings.TrimSpace(playerMappings.byRefID[item.ExternalID])
 		if playerID == "" {
-			playerID = buildPlayerPublicID(leagueID, item.ExternalID)
+			playerID = buildPlayerPublicID(teamMappings.source, leagueID, item.ExternalID)
 		}
 
 		teamID := resolveTeamPublicID(teamMappings, item.TeamExternalID, "")
@@ -881,7 +1050,7 @@ func mapExternalPlayersToDomain(
 			imageURL = strings.TrimSpace(existing.ImageURL)
 		}
 
-		out = append(out, player.Player{
+		row := player.Player{
 			ID:          playerID,
 			LeagueID:    leagueID,
 			TeamID:      teamID,
@@ -890,7 +1059,12 @@ func mapExternalPlayersToDomain(
 			Price:       price,
 			ImageURL:    imageURL,
 			PlayerRefID: item.ExternalID,
-		})
+			RefSource:   normalizeSportDataSource(teamMappings.source),
+		}
+		if override, ok := overrides.players[playerID]; ok {
+			row = override.Apply(row)
+		}
+		out = append(out, row)
 	}
 
 	sort.SliceStable(out, func(i, j int) bool {
@@ -913,7 +1087,7 @@ func mapExternalTeamStatsByFixture(
 		if item.FixtureExternalID <= 0 {
 			continue
 		}
-		fixtureID := buildFixturePublicID(leagueID, item.FixtureExternalID)
+		fixtureID := buildFixturePublicID(teamMappings.source, leagueID, item.FixtureExternalID)
 		teamID := resolveTeamPublicID(teamMappings, item.TeamExternalID, item.TeamName)
 		if fixtureID == "" {
 			continue
@@ -950,7 +1124,7 @@ func mapExternalPlayerStatsByFixture(
 			continue
 		}
 
-		fixtureID := buildFixturePublicID(leagueID, item.FixtureExternalID)
+		fixtureID := buildFixturePublicID(teamMappings.source, leagueID, item.FixtureExternalID)
 		playerID := strings.TrimSpace(playerMappings.byRefID[item.PlayerExternalID])
 		teamID := resolveTeamPublicID(teamMappings, item.TeamExternalID, "")
 		if fixtureID == "" {
@@ -977,6 +1151,7 @@ func mapExternalPlayerStatsByFixture(
 			YellowCards:       maxInt(item.YellowCards, 0),
 			RedCards:          maxInt(item.RedCards, 0),
 			Saves:             maxInt(item.Saves, 0),
+			BonusPoints:       maxInt(item.BonusPoints, 0),
 			FantasyPoints:     maxInt(item.FantasyPoints, 0),
 			AdvancedStats:     copyMap(item.AdvancedStats),
 		})
@@ -996,7 +1171,7 @@ func mapExternalFixtureEventsByFixture(
 			continue
 		}
 
-		fixtureID := buildFixturePublicID(leagueID, item.FixtureExternalID)
+		fixtureID := buildFixturePublicID(teamMappings.source, leagueID, item.FixtureExternalID)
 		teamID := resolveTeamPublicID(teamMappings, item.TeamExternalID, "")
 		playerID := strings.TrimSpace(playerMappings.byRefID[item.PlayerExternalID])
 		assistID := strings.TrimSpace(playerMappings.byRefID[item.AssistPlayerExternalID])
@@ -1074,7 +1249,7 @@ func mapExternalTeamStatisticsToDomain(
 		teamID := resolveTeamPublicID(teamMappings, item.TeamExternalID, "")
 		fixtureID := ""
 		if item.FixtureExternalID > 0 {
-			fixtureID = buildFixturePublicID(leagueID, item.FixtureExternalID)
+			fixtureID = buildFixturePublicID(teamMappings.source, leagueID, item.FixtureExternalID)
 		}
 		statKey := strings.TrimSpace(item.StatKey)
 		if statKey == "" {
@@ -1123,7 +1298,7 @@ func mapExternalPlayerStatisticsToDomain(
 		teamID := resolveTeamPublicID(teamMappings, item.TeamExternalID, "")
 		fixtureID := ""
 		if item.FixtureExternalID > 0 {
-			fixtureID = buildFixturePublicID(leagueID, item.FixtureExternalID)
+			fixtureID = buildFixturePublicID(teamMappings.source, leagueID, item.FixtureExternalID)
 		}
 		statKey := strings.TrimSpace(item.StatKey)
 		if statKey == "" {
@@ -1315,16 +1490,32 @@ func normalizeExternalPlayerPosition(raw string) player.Position {
 	}
 }
 
-func buildTeamPublicID(leagueID string, teamRefID int64) string {
-	return "sm-" + sanitizePublicIDSegment(leagueID) + "-team-" + fmt.Sprintf("%d", teamRefID)
+func buildTeamPublicID(source, leagueID string, teamRefID int64) string {
+	return sportDataPublicIDPrefix(source) + "-" + sanitizePublicIDSegment(leagueID) + "-team-" + fmt.Sprintf("%d", teamRefID)
+}
+
+func buildPlayerPublicID(source, leagueID string, playerRefID int64) string {
+	return sportDataPublicIDPrefix(source) + "-" + sanitizePublicIDSegment(leagueID) + "-player-" + fmt.Sprintf("%d", playerRefID)
 }
 
-func buildPlayerPublicID(leagueID string, playerRefID int64) string {
-	return "sm-" + sanitizePublicIDSegment(leagueID) + "-player-" + fmt.Sprintf("%d", playerRefID)
+func buildFixturePublicID(source, leagueID string, fixtureRefID int64) string {
+	return sportDataPublicIDPrefix(source) + "-" + sanitizePublicIDSegment(leagueID) + "-fixture-" + fmt.Sprintf("%d", fixtureRefID)
 }
 
-func buildFixturePublicID(leagueID string, fixtureRefID int64) string {
-	return "sm-" + sanitizePublicIDSegment(leagueID) + "-fixture-" + fmt.Sprintf("%d", fixtureRefID)
+func sportDataPublicIDPrefix(source string) string {
+	source = normalizeSportDataSource(source)
+	if prefix, ok := sportDataPublicIDPrefixes[source]; ok {
+		return prefix
+	}
+	return sanitizePublicIDSegment(source)
+}
+
+func normalizeSportDataSource(source string) string {
+	source = strings.ToLower(strings.TrimSpace(source))
+	if source == "" {
+		return SportDataSourceSportMonks
+	}
+	return source
 }
 
 func sanitizePublicIDSegment(value string) string {
@@ -1412,7 +1603,7 @@ func copyMap(src map[string]any) map[string]any {
 	return out
 }
 
-func fixtureIDsFromExternalFixtures(leagueID string, fixtures []ExternalFixture) []string {
+func fixtureIDsFromExternalFixtures(source, leagueID string, fixtures []ExternalFixture) []string {
 	if len(fixtures) == 0 {
 		return nil
 	}
@@ -1423,7 +1614,7 @@ func fixtureIDsFromExternalFixtures(leagueID string, fixtures []ExternalFixture)
 		if item.ExternalID <= 0 {
 			continue
 		}
-		fixtureID := buildFixturePublicID(leagueID, item.ExternalID)
+		fixtureID := buildFixturePublicID(source, leagueID, item.ExternalID)
 		if fixtureID == "" {
 			continue
 		}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/riskibarqy/fantasy-league/internal/domain/auditlog"
 	"github.com/riskibarqy/fantasy-league/internal/domain/fantasy"
 	"github.com/riskibarqy/fantasy-league/internal/domain/league"
 	"github.com/riskibarqy/fantasy-league/internal/domain/player"
@@ -54,6 +55,7 @@ type SquadService struct {
 	idGen      idgen.Generator
 	logger     *logging.Logger
 	joiner     DefaultLeagueJoiner
+	audit      *AuditRecorder
 	now        func() time.Time
 }
 
@@ -88,6 +90,10 @@ func (s *SquadService) SetScoringUpdater(scorer leagueScoringUpdater) {
 	s.scorer = scorer
 }
 
+func (s *SquadService) SetAuditRecorder(audit *AuditRecorder) {
+	s.audit = audit
+}
+
 func (s *SquadService) UpsertSquad(ctx context.Context, input UpsertSquadInput) (fantasy.Squad, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.SquadService.UpsertSquad")
 	defer span.End()
@@ -194,6 +200,12 @@ func (s *SquadService) UpsertSquad(ctx context.Context, input UpsertSquadInput)
 		}
 	}
 
+	var before map[string]any
+	if exists {
+		before = squadAuditData(existingSquad)
+	}
+	s.audit.Record(ctx, "squad.upsert", auditlog.EntitySquad, squad.ID, squad.LeagueID, before, squadAuditData(squad))
+
 	s.logger.InfoContext(ctx, "squad upserted",
 		"user_id", input.UserID,
 		"league_id", input.LeagueID,
@@ -346,6 +358,12 @@ func (s *SquadService) AddPlayerToSquad(ctx context.Context, input AddPlayerToSq
 		}
 	}
 
+	var before map[string]any
+	if exists {
+		before = squadAuditData(existing)
+	}
+	s.audit.Record(ctx, "squad.add_player", auditlog.EntitySquad, squad.ID, squad.LeagueID, before, squadAuditData(squad))
+
 	s.logger.InfoContext(ctx, "player added to squad",
 		"user_id", input.UserID,
 		"league_id", input.LeagueID,
@@ -357,6 +375,17 @@ func (s *SquadService) AddPlayerToSquad(ctx context.Context, input AddPlayerToSq
 	return squad, nil
 }
 
+func squadAuditData(squad fantasy.Squad) map[string]any {
+	playerIDs := make([]string, 0, len(squad.Picks))
+	for _, pick := range squad.Picks {
+		playerIDs = append(playerIDs, pick.PlayerID)
+	}
+	return map[string]any{
+		"name":       squad.Name,
+		"player_ids": playerIDs,
+	}
+}
+
 func (s *SquadService) GetUserSquad(ctx context.Context, userID, leagueID string) (fantasy.Squad, error) {
 	ctx, span := startUsecaseSpan(ctx, "usecase.SquadService.GetUserSquad")
 	defer span.End()
@@ -379,13 +408,16 @@ func (s *SquadService) GetUserSquad(ctx context.Context, userID, leagueID string
 }
 
 func (s *SquadService) validateLeague(ctx context.Context, leagueID string) error {
-	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	item, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
 	if err != nil {
 		return fmt.Errorf("get league by id: %w", err)
 	}
 	if !exists {
 		return fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
 	}
+	if item.IsArchived() {
+		return fmt.Errorf("%w: league=%s season %s is archived", ErrInvalidInput, leagueID, item.Season)
+	}
 
 	return nil
 }
@@ -0,0 +1,361 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/fixture"
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/player"
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statcorrection"
+)
+
+const maxStatCorrectionReasonLength = 500
+
+// PlayerStatCorrection changes the non-nil stats of one player in a fixture.
+// Without FantasyPoints the provider points are adjusted by the difference
+// the changed stats make under the standard scoring rules.
+type PlayerStatCorrection struct {
+	PlayerID      string
+	MinutesPlayed *int
+	Goals         *int
+	Assists       *int
+	CleanSheet    *bool
+	YellowCards   *int
+	RedCards      *int
+	Saves         *int
+	BonusPoints   *int
+	FantasyPoints *int
+}
+
+type ApplyStatCorrectionInput struct {
+	ActorUserID string
+	LeagueID    string
+	FixtureID   string
+	Reason      string
+	Players     []PlayerStatCorrection
+}
+
+type gameweekRescorer interface {
+	RescoreGameweek(ctx context.Context, leagueID string, gameweek int) error
+}
+
+// StatCorrectionService applies manual fixture stat corrections and rescores
+// the affected gameweek. A correction is saved before the rescore, so one
+// whose rescore failed stays pending and is finished by the next Apply in the
+// league or by RescorePending.
+type StatCorrectionService struct {
+	leagueRepo      league.Repository
+	fixtureRepo     fixture.Repository
+	playerRepo      player.Repository
+	playerStatsRepo playerstats.Repository
+	scoringRepo     scoring.Repository
+	repo            statcorrection.Repository
+	rescorer        gameweekRescorer
+}
+
+func NewStatCorrectionService(
+	leagueRepo league.Repository,
+	fixtureRepo fixture.Repository,
+	playerRepo player.Repository,
+	playerStatsRepo playerstats.Repository,
+	scoringRepo scoring.Repository,
+	repo statcorrection.Repository,
+	rescorer gameweekRescorer,
+) *StatCorrectionService {
+	return &StatCorrectionService{
+		leagueRepo:      leagueRepo,
+		fixtureRepo:     fixtureRepo,
+		playerRepo:      playerRepo,
+		playerStatsRepo: playerStatsRepo,
+		scoringRepo:     scoringRepo,
+		repo:            repo,
+		rescorer:        rescorer,
+	}
+}
+
+func (s *StatCorrectionService) Apply(ctx context.Context, input ApplyStatCorrectionInput) (statcorrection.Correction, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.StatCorrectionService.Apply")
+	defer span.End()
+
+	input.Reason = strings.TrimSpace(input.Reason)
+	if input.Reason == "" {
+		return statcorrection.Correction{}, fmt.Errorf("%w: reason is required", ErrInvalidInput)
+	}
+	if len(input.Reason) > maxStatCorrectionReasonLength {
+		return statcorrection.Correction{}, fmt.Errorf("%w: reason must be at most %d characters", ErrInvalidInput, maxStatCorrectionReasonLength)
+	}
+	if len(input.Players) == 0 {
+		return statcorrection.Correction{}, fmt.Errorf("%w: at least one player correction is required", ErrInvalidInput)
+	}
+	playerIDs := make([]string, 0, len(input.Players))
+	seen := make(map[string]struct{}, len(input.Players))
+	for idx := range input.Players {
+		item := &input.Players[idx]
+		item.PlayerID = strings.TrimSpace(item.PlayerID)
+		if item.PlayerID == "" {
+			return statcorrection.Correction{}, fmt.Errorf("%w: player id is required", ErrInvalidInput)
+		}
+		if _, dup := seen[item.PlayerID]; dup {
+			return statcorrection.Correction{}, fmt.Errorf("%w: player %s is corrected twice", ErrInvalidInput, item.PlayerID)
+		}
+		seen[item.PlayerID] = struct{}{}
+		if err := item.validate(); err != nil {
+			return statcorrection.Correction{}, err
+		}
+		playerIDs = append(playerIDs, item.PlayerID)
+	}
+
+	fx, err := s.getFixture(ctx, input.LeagueID, input.FixtureID)
+	if err != nil {
+		return statcorrection.Correction{}, err
+	}
+	if fx.Gameweek <= 0 {
+		return statcorrection.Correction{}, fmt.Errorf("%w: fixture %s has no gameweek", ErrInvalidInput, fx.ID)
+	}
+	// Earlier corrections must be rescored first, otherwise their point
+	// changes would be credited to this one.
+	if _, err := s.rescorePending(ctx, fx.LeagueID); err != nil {
+		return statcorrection.Correction{}, err
+	}
+
+	players, err := s.playerRepo.GetByIDs(ctx, fx.LeagueID, playerIDs)
+	if err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("get players: %w", err)
+	}
+	playerByID := make(map[string]player.Player, len(players))
+	for _, item := range players {
+		playerByID[item.ID] = item
+	}
+
+	current, err := s.playerStatsRepo.ListFixtureStatsByLeagueAndFixture(ctx, fx.LeagueID, fx.ID)
+	if err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("list fixture stats: %w", err)
+	}
+	statByPlayer := make(map[string]playerstats.FixtureStat, len(current))
+	for _, item := range current {
+		statByPlayer[item.PlayerID] = item
+	}
+
+	correction := statcorrection.Correction{
+		LeagueID:  fx.LeagueID,
+		FixtureID: fx.ID,
+		Gameweek:  fx.Gameweek,
+		Reason:    input.Reason,
+		CreatedBy: input.ActorUserID,
+		Players:   make([]statcorrection.PlayerChange, 0, len(input.Players)),
+	}
+	correction.TraceID, _ = traceMetaFromContext(ctx)
+	for _, item := range input.Players {
+		pl, ok := playerByID[item.PlayerID]
+		if !ok {
+			return statcorrection.Correction{}, fmt.Errorf("%w: player=%s league=%s", ErrNotFound, item.PlayerID, fx.LeagueID)
+		}
+		if pl.TeamID != fx.HomeTeamID && pl.TeamID != fx.AwayTeamID {
+			return statcorrection.Correction{}, fmt.Errorf("%w: player %s does not play for a team in fixture %s", ErrInvalidInput, pl.ID, fx.ID)
+		}
+
+		before, ok := statByPlayer[pl.ID]
+		if !ok {
+			before = playerstats.FixtureStat{FixtureID: fx.ID, PlayerID: pl.ID, TeamID: pl.TeamID}
+		}
+		correction.Players = append(correction.Players, statcorrection.PlayerChange{
+			PlayerID: pl.ID,
+			Before:   before,
+			After:    item.apply(before, pl.Position),
+		})
+	}
+
+	correction.PointsBefore, err = s.gameweekPoints(ctx, fx.LeagueID, fx.Gameweek)
+	if err != nil {
+		return statcorrection.Correction{}, err
+	}
+
+	correction, err = s.repo.Save(ctx, correction)
+	if err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("save stat correction: %w", err)
+	}
+	return s.rescore(ctx, correction)
+}
+
+// RescorePending finishes the corrections of a league whose rescore failed
+// after they were saved and returns them.
+func (s *StatCorrectionService) RescorePending(ctx context.Context, leagueID string) ([]statcorrection.Correction, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.StatCorrectionService.RescorePending")
+	defer span.End()
+
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return nil, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+	return s.rescorePending(ctx, leagueID)
+}
+
+func (s *StatCorrectionService) rescorePending(ctx context.Context, leagueID string) ([]statcorrection.Correction, error) {
+	pending, err := s.repo.ListPending(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("list pending stat corrections: %w", err)
+	}
+	out := make([]statcorrection.Correction, 0, len(pending))
+	for _, item := range pending {
+		item, err = s.rescore(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// rescore recalculates the gameweek of a saved correction and records how the
+// points of each user moved against the points stored with it. Every step can
+// run again for the same correction.
+func (s *StatCorrectionService) rescore(ctx context.Context, correction statcorrection.Correction) (statcorrection.Correction, error) {
+	if err := s.rescorer.RescoreGameweek(ctx, correction.LeagueID, correction.Gameweek); err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("rescore gameweek=%d for stat correction id=%d: %w", correction.Gameweek, correction.ID, err)
+	}
+
+	pointsAfter, err := s.gameweekPoints(ctx, correction.LeagueID, correction.Gameweek)
+	if err != nil {
+		return statcorrection.Correction{}, err
+	}
+	correction.UserPoints = diffUserPoints(correction.PointsBefore, pointsAfter)
+	if err := s.repo.SaveUserPoints(ctx, correction.ID, correction.UserPoints); err != nil {
+		return statcorrection.Correction{}, fmt.Errorf("save stat correction user points id=%d: %w", correction.ID, err)
+	}
+	rescoredAt := time.Now().UTC()
+	correction.RescoredAt = &rescoredAt
+	return correction, nil
+}
+
+func (s *StatCorrectionService) ListByFixture(ctx context.Context, leagueID, fixtureID string) ([]statcorrection.Correction, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.StatCorrectionService.ListByFixture")
+	defer span.End()
+
+	fx, err := s.getFixture(ctx, leagueID, fixtureID)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.repo.ListByFixture(ctx, fx.LeagueID, fx.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list stat corrections: %w", err)
+	}
+	return out, nil
+}
+
+func (s *StatCorrectionService) getFixture(ctx context.Context, leagueID, fixtureID string) (fixture.Fixture, error) {
+	leagueID = strings.TrimSpace(leagueID)
+	fixtureID = strings.TrimSpace(fixtureID)
+	if leagueID == "" || fixtureID == "" {
+		return fixture.Fixture{}, fmt.Errorf("%w: league id and fixture id are required", ErrInvalidInput)
+	}
+	_, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return fixture.Fixture{}, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return fixture.Fixture{}, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+	fx, exists, err := s.fixtureRepo.GetByID(ctx, leagueID, fixtureID)
+	if err != nil {
+		return fixture.Fixture{}, fmt.Errorf("get fixture: %w", err)
+	}
+	if !exists {
+		return fixture.Fixture{}, fmt.Errorf("%w: fixture=%s league=%s", ErrNotFound, fixtureID, leagueID)
+	}
+	return fx, nil
+}
+
+func (s *StatCorrectionService) gameweekPoints(ctx context.Context, leagueID string, gameweek int) (map[string]int, error) {
+	rows, err := s.scoringRepo.ListUserGameweekPointsByLeague(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("list user gameweek points: %w", err)
+	}
+	out := make(map[string]int)
+	for _, row := range rows {
+		if row.Gameweek == gameweek {
+			out[row.UserID] = row.Points
+		}
+	}
+	return out, nil
+}
+
+func diffUserPoints(before, after map[string]int) []statcorrection.UserPointsChange {
+	out := make([]statcorrection.UserPointsChange, 0)
+	for userID, points := range after {
+		if prev, ok := before[userID]; !ok || prev != points {
+			out = append(out, statcorrection.UserPointsChange{UserID: userID, Before: before[userID], After: points})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].UserID < out[j].UserID })
+	return out
+}
+
+func (c PlayerStatCorrection) validate() error {
+	for _, value := range []*int{c.MinutesPlayed, c.Goals, c.Assists, c.YellowCards, c.RedCards, c.Saves, c.BonusPoints} {
+		if value != nil && *value < 0 {
+			return fmt.Errorf("%w: corrected stats of player %s must not be negative", ErrInvalidInput, c.PlayerID)
+		}
+	}
+	if c.MinutesPlayed != nil && *c.MinutesPlayed > 150 {
+		return fmt.Errorf("%w: minutes played of player %s must be at most 150", ErrInvalidInput, c.PlayerID)
+	}
+	if c.YellowCards != nil && *c.YellowCards > 2 {
+		return fmt.Errorf("%w: yellow cards of player %s must be at most 2", ErrInvalidInput, c.PlayerID)
+	}
+	if c.RedCards != nil && *c.RedCards > 1 {
+		return fmt.Errorf("%w: red cards of player %s must be at most 1", ErrInvalidInput, c.PlayerID)
+	}
+	return nil
+}
+
+func (c PlayerStatCorrection) apply(before playerstats.FixtureStat, position player.Position) playerstats.FixtureStat {
+	after := before
+	if c.MinutesPlayed != nil {
+		after.MinutesPlayed = *c.MinutesPlayed
+	}
+	if c.Goals != nil {
+		after.Goals = *c.Goals
+	}
+	if c.Assists != nil {
+		after.Assists = *c.Assists
+	}
+	if c.CleanSheet != nil {
+		after.CleanSheet = *c.CleanSheet
+	}
+	if c.YellowCards != nil {
+		after.YellowCards = *c.YellowCards
+	}
+	if c.RedCards != nil {
+		after.RedCards = *c.RedCards
+	}
+	if c.Saves != nil {
+		after.Saves = *c.Saves
+	}
+	if c.BonusPoints != nil {
+		after.BonusPoints = *c.BonusPoints
+	}
+
+	if c.FantasyPoints != nil {
+		after.FantasyPoints = *c.FantasyPoints
+		return after
+	}
+	// Only the difference is applied so points from stats the fixture row
+	// does not keep, such as goals conceded or penalties, stay as they were.
+	after.FantasyPoints = before.FantasyPoints +
+		EstimateFantasyPoints(fixtureStatScoringLine(after, position)) -
+		EstimateFantasyPoints(fixtureStatScoringLine(before, position))
+	return after
+}
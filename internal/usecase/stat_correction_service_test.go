@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/playerstats"
+	"github.com/riskibarqy/fantasy-league/internal/domain/scoring"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statcorrection"
+	"github.com/riskibarqy/fantasy-league/internal/infrastructure/repository/memory"
+)
+
+type stubCorrectionPlayerStatsRepository struct {
+	playerstats.Repository
+	stats []playerstats.FixtureStat
+}
+
+func (s *stubCorrectionPlayerStatsRepository) ListFixtureStatsByLeagueAndFixture(_ context.Context, _, _ string) ([]playerstats.FixtureStat, error) {
+	return append([]playerstats.FixtureStat(nil), s.stats...), nil
+}
+
+type stubCorrectionScoringRepository struct {
+	scoring.Repository
+	points []scoring.UserGameweekPoints
+}
+
+func (s *stubCorrectionScoringRepository) ListUserGameweekPointsByLeague(_ context.Context, _ string) ([]scoring.UserGameweekPoints, error) {
+	return append([]scoring.UserGameweekPoints(nil), s.points...), nil
+}
+
+type stubStatCorrectionRepository struct {
+	statcorrection.Repository
+	saved      []statcorrection.Correction
+	userPoints []statcorrection.UserPointsChange
+}
+
+func (s *stubStatCorrectionRepository) Save(_ context.Context, item statcorrection.Correction) (statcorrection.Correction, error) {
+	item.ID = int64(len(s.saved) + 1)
+	s.saved = append(s.saved, item)
+	return item, nil
+}
+
+func (s *stubStatCorrectionRepository) SaveUserPoints(_ context.Context, correctionID int64, items []statcorrection.UserPointsChange) error {
+	s.userPoints = append(s.userPoints, items...)
+	now := time.Now()
+	s.saved[correctionID-1].RescoredAt = &now
+	return nil
+}
+
+func (s *stubStatCorrectionRepository) ListPending(_ context.Context, _ string) ([]statcorrection.Correction, error) {
+	out := make([]statcorrection.Correction, 0)
+	for _, item := range s.saved {
+		if item.RescoredAt == nil {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+type stubGameweekRescorer struct {
+	scoringRepo *stubCorrectionScoringRepository
+	gameweeks   []int
+	err         error
+}
+
+func (s *stubGameweekRescorer) RescoreGameweek(_ context.Context, _ string, gameweek int) error {
+	s.gameweeks = append(s.gameweeks, gameweek)
+	if s.err != nil {
+		return s.err
+	}
+	s.scoringRepo.points = []scoring.UserGameweekPoints{
+		{LeagueID: memory.LeagueIDLiga1Indonesia, Gameweek: 1, UserID: "u-1", Points: 52},
+		{LeagueID: memory.LeagueIDLiga1Indonesia, Gameweek: 1, UserID: "u-2", Points: 40},
+	}
+	return nil
+}
+
+func TestStatCorrectionService_Apply(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	statsRepo := &stubCorrectionPlayerStatsRepository{stats: []playerstats.FixtureStat{
+		{FixtureID: "fx-idn-002", PlayerID: "idn-def-04", TeamID: "idn-baliutd", MinutesPlayed: 90, FantasyPoints: 4},
+	}}
+	scoringRepo := &stubCorrectionScoringRepository{points: []scoring.UserGameweekPoints{
+		{LeagueID: memory.LeagueIDLiga1Indonesia, Gameweek: 1, UserID: "u-1", Points: 45},
+		{LeagueID: memory.LeagueIDLiga1Indonesia, Gameweek: 1, UserID: "u-2", Points: 40},
+	}}
+	repo := &stubStatCorrectionRepository{}
+	rescorer := &stubGameweekRescorer{scoringRepo: scoringRepo}
+	svc := NewStatCorrectionService(
+		memory.NewLeagueRepository(memory.SeedLeagues()),
+		memory.NewFixtureRepository(memory.SeedFixtures()),
+		memory.NewPlayerRepository(memory.SeedPlayers()),
+		statsRepo,
+		scoringRepo,
+		repo,
+		rescorer,
+	)
+
+	goals := 1
+	cleanSheet := true
+	_, err := svc.Apply(ctx, ApplyStatCorrectionInput{
+		LeagueID:  memory.LeagueIDLiga1Indonesia,
+		FixtureID: "fx-idn-002",
+		Players:   []PlayerStatCorrection{{PlayerID: "idn-def-04", Goals: &goals}},
+	})
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected missing reason to be rejected, got %v", err)
+	}
+	_, err = svc.Apply(ctx, ApplyStatCorrectionInput{
+		LeagueID:  memory.LeagueIDLiga1Indonesia,
+		FixtureID: "fx-idn-002",
+		Reason:    "wrong scorer",
+		Players:   []PlayerStatCorrection{{PlayerID: "idn-gk-01", Goals: &goals}},
+	})
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected player outside fixture to be rejected, got %v", err)
+	}
+
+	item, err := svc.Apply(ctx, ApplyStatCorrectionInput{
+		ActorUserID: "admin-1",
+		LeagueID:    memory.LeagueIDLiga1Indonesia,
+		FixtureID:   "fx-idn-002",
+		Reason:      "goal credited to the wrong player",
+		Players:     []PlayerStatCorrection{{PlayerID: "idn-def-04", Goals: &goals, CleanSheet: &cleanSheet}},
+	})
+	if err != nil {
+		t.Fatalf("apply correction: %v", err)
+	}
+	if len(repo.saved) != 1 || item.Gameweek != 1 || item.CreatedBy != "admin-1" {
+		t.Fatalf("unexpected correction %+v", item)
+	}
+	change := item.Players[0]
+	if change.Before.FantasyPoints != 4 || change.After.Goals != 1 || change.After.FantasyPoints != 14 {
+		t.Fatalf("expected 4 -> 14 points, got %+v", change)
+	}
+	if len(rescorer.gameweeks) != 1 || rescorer.gameweeks[0] != 1 {
+		t.Fatalf("expected gameweek 1 rescored, got %v", rescorer.gameweeks)
+	}
+	if len(item.UserPoints) != 1 || item.UserPoints[0] != (statcorrection.UserPointsChange{UserID: "u-1", Before: 45, After: 52}) {
+		t.Fatalf("unexpected user points %+v", item.UserPoints)
+	}
+	if len(repo.userPoints) != 1 {
+		t.Fatalf("expected user points stored, got %+v", repo.userPoints)
+	}
+}
+
+func TestStatCorrectionService_RescorePendingAfterFailedRescore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	statsRepo := &stubCorrectionPlayerStatsRepository{stats: []playerstats.FixtureStat{
+		{FixtureID: "fx-idn-002", PlayerID: "idn-def-04", TeamID: "idn-baliutd", MinutesPlayed: 90, FantasyPoints: 4},
+	}}
+	scoringRepo := &stubCorrectionScoringRepository{points: []scoring.UserGameweekPoints{
+		{LeagueID: memory.LeagueIDLiga1Indonesia, Gameweek: 1, UserID: "u-1", Points: 45},
+		{LeagueID: memory.LeagueIDLiga1Indonesia, Gameweek: 1, UserID: "u-2", Points: 40},
+	}}
+	repo := &stubStatCorrectionRepository{}
+	rescorer := &stubGameweekRescorer{scoringRepo: scoringRepo, err: errors.New("database is down")}
+	svc := NewStatCorrectionService(
+		memory.NewLeagueRepository(memory.SeedLeagues()),
+		memory.NewFixtureRepository(memory.SeedFixtures()),
+		memory.NewPlayerRepository(memory.SeedPlayers()),
+		statsRepo,
+		scoringRepo,
+		repo,
+		rescorer,
+	)
+
+	goals := 1
+	_, err := svc.Apply(ctx, ApplyStatCorrectionInput{
+		LeagueID:  memory.LeagueIDLiga1Indonesia,
+		FixtureID: "fx-idn-002",
+		Reason:    "goal credited to the wrong player",
+		Players:   []PlayerStatCorrection{{PlayerID: "idn-def-04", Goals: &goals}},
+	})
+	if err == nil {
+		t.Fatalf("expected failed rescore to be reported")
+	}
+	if len(repo.saved) != 1 || repo.saved[0].RescoredAt != nil || repo.saved[0].PointsBefore["u-1"] != 45 {
+		t.Fatalf("expected correction saved as pending with its starting points, got %+v", repo.saved)
+	}
+
+	rescorer.err = nil
+	items, err := svc.RescorePending(ctx, memory.LeagueIDLiga1Indonesia)
+	if err != nil {
+		t.Fatalf("rescore pending: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != 1 || items[0].RescoredAt == nil {
+		t.Fatalf("expected the pending correction finished, got %+v", items)
+	}
+	if len(items[0].UserPoints) != 1 || items[0].UserPoints[0] != (statcorrection.UserPointsChange{UserID: "u-1", Before: 45, After: 52}) {
+		t.Fatalf("expected points measured against the saved starting points, got %+v", items[0].UserPoints)
+	}
+	if len(repo.saved) != 1 {
+		t.Fatalf("expected the correction not to be saved again, got %d", len(repo.saved))
+	}
+
+	items, err = svc.RescorePending(ctx, memory.LeagueIDLiga1Indonesia)
+	if err != nil || len(items) != 0 {
+		t.Fatalf("expected nothing left to rescore, got %+v err=%v", items, err)
+	}
+}
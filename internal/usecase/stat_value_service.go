@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statvalue"
+)
+
+const (
+	defaultStatLeadersLimit = 20
+	maxStatLeadersLimit     = 100
+	defaultStatScope        = "total"
+)
+
+// StatValueService reads the provider season statistics synced into
+// stat_types, player_stat_values and team_stat_values.
+type StatValueService struct {
+	leagueRepo    league.Repository
+	statValueRepo statvalue.Repository
+}
+
+// StatTypeGroup is one StatGroup of the stat type catalog. Types without a
+// group are listed under "other".
+type StatTypeGroup struct {
+	Group string
+	Types []statvalue.Type
+}
+
+// StatLeadersQuery ranks a league by one stat key. A zero SeasonRefID uses
+// the season of the league and an empty Scope means "total".
+type StatLeadersQuery struct {
+	LeagueID    string
+	StatKey     string
+	Scope       string
+	SeasonRefID int64
+	Ascending   bool
+	Limit       int
+}
+
+func NewStatValueService(leagueRepo league.Repository, statValueRepo statvalue.Repository) *StatValueService {
+	return &StatValueService{
+		leagueRepo:    leagueRepo,
+		statValueRepo: statValueRepo,
+	}
+}
+
+// ListTypeGroups returns the stat type catalog grouped by StatGroup, with
+// groups and types in name order. A non-empty modelType keeps only the types
+// of that model, such as "player" or "team".
+func (s *StatValueService) ListTypeGroups(ctx context.Context, modelType string) ([]StatTypeGroup, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.StatValueService.ListTypeGroups")
+	defer span.End()
+
+	items, err := s.statValueRepo.ListTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list stat types: %w", err)
+	}
+
+	modelType = strings.TrimSpace(modelType)
+	byGroup := make(map[string][]statvalue.Type)
+	for _, item := range items {
+		if modelType != "" && !strings.EqualFold(item.ModelType, modelType) {
+			continue
+		}
+		group := strings.TrimSpace(item.StatGroup)
+		if group == "" {
+			group = "other"
+		}
+		byGroup[group] = append(byGroup[group], item)
+	}
+
+	out := make([]StatTypeGroup, 0, len(byGroup))
+	for group, types := range byGroup {
+		out = append(out, StatTypeGroup{Group: group, Types: types})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Group < out[j].Group
+	})
+	return out, nil
+}
+
+// ListPlayerValues returns the season stat values of one player. An empty
+// scope returns every scope.
+func (s *StatValueService) ListPlayerValues(ctx context.Context, leagueID, playerID string, seasonRefID int64, scope string) ([]statvalue.PlayerValue, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.StatValueService.ListPlayerValues")
+	defer span.End()
+
+	playerID = strings.TrimSpace(playerID)
+	if playerID == "" {
+		return nil, fmt.Errorf("%w: player id is required", ErrInvalidInput)
+	}
+	leagueID, seasonRefID, err := s.resolveSeason(ctx, leagueID, seasonRefID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.statValueRepo.ListPlayerValues(ctx, statvalue.PlayerValueFilter{
+		LeagueID:    leagueID,
+		SeasonRefID: seasonRefID,
+		PlayerIDs:   []string{playerID},
+		Scope:       strings.TrimSpace(scope),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list player stat values: %w", err)
+	}
+	return items, nil
+}
+
+// ListTeamValues returns the season stat values of one team. An empty scope
+// returns every scope.
+func (s *StatValueService) ListTeamValues(ctx context.Context, leagueID, teamID string, seasonRefID int64, scope string) ([]statvalue.TeamValue, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.StatValueService.ListTeamValues")
+	defer span.End()
+
+	teamID = strings.TrimSpace(teamID)
+	if teamID == "" {
+		return nil, fmt.Errorf("%w: team id is required", ErrInvalidInput)
+	}
+	leagueID, seasonRefID, err := s.resolveSeason(ctx, leagueID, seasonRefID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.statValueRepo.ListTeamValues(ctx, statvalue.TeamValueFilter{
+		LeagueID:    leagueID,
+		SeasonRefID: seasonRefID,
+		TeamIDs:     []string{teamID},
+		Scope:       strings.TrimSpace(scope),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list team stat values: %w", err)
+	}
+	return items, nil
+}
+
+// RankPlayers lists the league players with the highest value of a stat key.
+func (s *StatValueService) RankPlayers(ctx context.Context, query StatLeadersQuery) ([]statvalue.PlayerValue, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.StatValueService.RankPlayers")
+	defer span.End()
+
+	rank, err := s.rankQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.statValueRepo.RankPlayerValues(ctx, rank)
+	if err != nil {
+		return nil, fmt.Errorf("rank player stat values: %w", err)
+	}
+	return items, nil
+}
+
+// RankTeams lists the league teams with the highest value of a stat key.
+func (s *StatValueService) RankTeams(ctx context.Context, query StatLeadersQuery) ([]statvalue.TeamValue, error) {
+	ctx, span := startUsecaseSpan(ctx, "usecase.StatValueService.RankTeams")
+	defer span.End()
+
+	rank, err := s.rankQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.statValueRepo.RankTeamValues(ctx, rank)
+	if err != nil {
+		return nil, fmt.Errorf("rank team stat values: %w", err)
+	}
+	return items, nil
+}
+
+func (s *StatValueService) rankQuery(ctx context.Context, query StatLeadersQuery) (statvalue.RankQuery, error) {
+	statKey := strings.TrimSpace(query.StatKey)
+	if statKey == "" {
+		return statvalue.RankQuery{}, fmt.Errorf("%w: stat key is required", ErrInvalidInput)
+	}
+	if query.Limit < 0 {
+		return statvalue.RankQuery{}, fmt.Errorf("%w: limit must be positive", ErrInvalidInput)
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultStatLeadersLimit
+	}
+	if limit > maxStatLeadersLimit {
+		limit = maxStatLeadersLimit
+	}
+	scope := strings.TrimSpace(query.Scope)
+	if scope == "" {
+		scope = defaultStatScope
+	}
+
+	leagueID, seasonRefID, err := s.resolveSeason(ctx, query.LeagueID, query.SeasonRefID)
+	if err != nil {
+		return statvalue.RankQuery{}, err
+	}
+	return statvalue.RankQuery{
+		LeagueID:    leagueID,
+		SeasonRefID: seasonRefID,
+		StatKey:     statKey,
+		Scope:       scope,
+		Ascending:   query.Ascending,
+		Limit:       limit,
+	}, nil
+}
+
+// resolveSeason checks the league exists and falls back to its synced
+// provider season when seasonRefID is zero.
+func (s *StatValueService) resolveSeason(ctx context.Context, leagueID string, seasonRefID int64) (string, int64, error) {
+	leagueID = strings.TrimSpace(leagueID)
+	if leagueID == "" {
+		return "", 0, fmt.Errorf("%w: league id is required", ErrInvalidInput)
+	}
+	if seasonRefID < 0 {
+		return "", 0, fmt.Errorf("%w: season ref id must be positive", ErrInvalidInput)
+	}
+
+	lg, exists, err := s.leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return "", 0, fmt.Errorf("get league: %w", err)
+	}
+	if !exists {
+		return "", 0, fmt.Errorf("%w: league=%s", ErrNotFound, leagueID)
+	}
+	if seasonRefID == 0 {
+		seasonRefID = lg.SeasonRefID
+	}
+	return leagueID, seasonRefID, nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/league"
+	"github.com/riskibarqy/fantasy-league/internal/domain/statvalue"
+)
+
+type recordingStatValueReadRepository struct {
+	statvalue.Repository
+	types       []statvalue.Type
+	playerQuery statvalue.PlayerValueFilter
+	rankQuery   statvalue.RankQuery
+}
+
+func (r *recordingStatValueReadRepository) ListTypes(_ context.Context) ([]statvalue.Type, error) {
+	return r.types, nil
+}
+
+func (r *recordingStatValueReadRepository) ListPlayerValues(_ context.Context, filter statvalue.PlayerValueFilter) ([]statvalue.PlayerValue, error) {
+	r.playerQuery = filter
+	return nil, nil
+}
+
+func (r *recordingStatValueReadRepository) RankTeamValues(_ context.Context, query statvalue.RankQuery) ([]statvalue.TeamValue, error) {
+	r.rankQuery = query
+	return nil, nil
+}
+
+func TestStatValueService_ListTypeGroups(t *testing.T) {
+	t.Parallel()
+
+	repo := &recordingStatValueReadRepository{types: []statvalue.Type{
+		{ExternalTypeID: 1, Name: "Tackles", ModelType: "player", StatGroup: "defensive"},
+		{ExternalTypeID: 2, Name: "Expected Goals", ModelType: "player", StatGroup: "attacking"},
+		{ExternalTypeID: 3, Name: "Corners", ModelType: "team", StatGroup: "attacking"},
+		{ExternalTypeID: 4, Name: "Rating", ModelType: "Player"},
+	}}
+	svc := NewStatValueService(&stubLeagueRepository{}, repo)
+
+	groups, err := svc.ListTypeGroups(context.Background(), "player")
+	if err != nil {
+		t.Fatalf("list type groups: %v", err)
+	}
+	if len(groups) != 3 {
+		t.Fatalf("expected three groups of player types, got %+v", groups)
+	}
+	if groups[0].Group != "attacking" || len(groups[0].Types) != 1 || groups[0].Types[0].ExternalTypeID != 2 {
+		t.Fatalf("unexpected attacking group: %+v", groups[0])
+	}
+	if groups[2].Group != "other" || groups[2].Types[0].ExternalTypeID != 4 {
+		t.Fatalf("expected ungrouped types under other, got %+v", groups[2])
+	}
+}
+
+func TestStatValueService_DefaultsToLeagueSeason(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leagueID := "idn-liga-1-2025"
+	leagues := &stubLeagueRepository{byID: map[string]league.League{
+		leagueID: {ID: leagueID, Name: "Liga 1 Indonesia", SeasonRefID: 23614},
+	}}
+	repo := &recordingStatValueReadRepository{}
+	svc := NewStatValueService(leagues, repo)
+
+	if _, err := svc.ListPlayerValues(ctx, leagueID, "p-1", 0, ""); err != nil {
+		t.Fatalf("list player values: %v", err)
+	}
+	if repo.playerQuery.SeasonRefID != 23614 || repo.playerQuery.Scope != "" || repo.playerQuery.PlayerIDs[0] != "p-1" {
+		t.Fatalf("unexpected player filter: %+v", repo.playerQuery)
+	}
+
+	if _, err := svc.RankTeams(ctx, StatLeadersQuery{LeagueID: leagueID, StatKey: "corners", Limit: 500}); err != nil {
+		t.Fatalf("rank teams: %v", err)
+	}
+	want := statvalue.RankQuery{LeagueID: leagueID, SeasonRefID: 23614, StatKey: "corners", Scope: defaultStatScope, Limit: maxStatLeadersLimit}
+	if repo.rankQuery != want {
+		t.Fatalf("expected %+v, got %+v", want, repo.rankQuery)
+	}
+
+	if _, err := svc.RankTeams(ctx, StatLeadersQuery{LeagueID: leagueID}); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("expected invalid input without stat key, got %v", err)
+	}
+	if _, err := svc.ListPlayerValues(ctx, "unknown", "p-1", 0, ""); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for unknown league, got %v", err)
+	}
+}
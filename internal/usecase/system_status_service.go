@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/jobscheduler"
+	"github.com/riskibarqy/fantasy-league/internal/domain/systemstatus"
+	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
+)
+
+const defaultStatusCheckTimeout = 1500 * time.Millisecond
+
+// Dependency check results.
+const (
+	DependencyOK       = "ok"
+	DependencyDegraded = "degraded"
+	DependencyDown     = "down"
+)
+
+// statusSyncJobs are the jobs whose last success is reported per league.
+var statusSyncJobs = []string{"sync-schedule", "sync-live"}
+
+// CircuitReporter is implemented by external clients guarded by a
+// resilience.CircuitBreaker.
+type CircuitReporter interface {
+	CircuitState() resilience.CircuitState
+}
+
+// CacheSizer reports how many live entries a cache holds.
+type CacheSizer interface {
+	Len() int
+}
+
+// SystemStatusService checks the dependencies of the API for readiness
+// probes and the internal status endpoint.
+type SystemStatusService struct {
+	statusRepo   systemstatus.Repository
+	dispatchRepo jobscheduler.Repository
+	circuits     []namedCircuit
+	cache        CacheSizer
+	checkTimeout time.Duration
+	now          func() time.Time
+}
+
+type namedCircuit struct {
+	name     string
+	reporter CircuitReporter
+}
+
+type DependencyStatus struct {
+	Name     string
+	Status   string
+	Critical bool
+	Detail   string
+}
+
+// ReadinessReport is Ready when every critical dependency is up. Degraded
+// dependencies are reported but keep the instance in rotation.
+type ReadinessReport struct {
+	Ready        bool
+	CheckedAt    time.Time
+	Dependencies []DependencyStatus
+}
+
+type CircuitStatus struct {
+	Name  string
+	State resilience.CircuitState
+}
+
+type SystemStatus struct {
+	ReadinessReport
+	// Database is nil when the database could not be reached.
+	Database     *systemstatus.Database
+	Circuits     []CircuitStatus
+	LastSyncs    []jobscheduler.LastCompletion
+	CacheEnabled bool
+	CacheEntries int
+}
+
+func NewSystemStatusService(statusRepo systemstatus.Repository, dispatchRepo jobscheduler.Repository) *SystemStatusService {
+	return &SystemStatusService{
+		statusRepo:   statusRepo,
+		dispatchRepo: dispatchRepo,
+		checkTimeout: defaultStatusCheckTimeout,
+		now:          time.Now,
+	}
+}
+
+// AddCircuit reports the breaker of an external client under name.
+func (s *SystemStatusService) AddCircuit(name string, reporter CircuitReporter) {
+	if reporter == nil {
+		return
+	}
+	s.circuits = append(s.circuits, namedCircuit{name: name, reporter: reporter})
+}
+
+// SetCache reports the entry count of the repository cache.
+func (s *SystemStatusService) SetCache(cache CacheSizer) {
+	s.cache = cache
+}
+
+// Readiness checks the database and the circuit breakers. Only the database
+// is critical: every route needs it, while an open breaker affects only the
+// routes of that dependency and closes again on its own.
+func (s *SystemStatusService) Readiness(ctx context.Context) ReadinessReport {
+	ctx, span := startUsecaseSpan(ctx, "usecase.SystemStatusService.Readiness")
+	defer span.End()
+
+	report, _ := s.readiness(ctx)
+	return report
+}
+
+func (s *SystemStatusService) Status(ctx context.Context) SystemStatus {
+	ctx, span := startUsecaseSpan(ctx, "usecase.SystemStatusService.Status")
+	defer span.End()
+
+	report, database := s.readiness(ctx)
+	out := SystemStatus{
+		ReadinessReport: report,
+		Database:        database,
+		Circuits:        s.circuitStates(),
+		CacheEnabled:    s.cache != nil,
+	}
+	if s.cache != nil {
+		out.CacheEntries = s.cache.Len()
+	}
+
+	if database != nil && s.dispatchRepo != nil {
+		checkCtx, cancel := context.WithTimeout(ctx, s.checkTimeout)
+		lastSyncs, err := s.dispatchRepo.ListLastCompletions(checkCtx, statusSyncJobs)
+		cancel()
+		if err != nil {
+			out.Dependencies = append(out.Dependencies, DependencyStatus{
+				Name:   "job_dispatches",
+				Status: DependencyDegraded,
+				Detail: err.Error(),
+			})
+		}
+		out.LastSyncs = lastSyncs
+	}
+	return out
+}
+
+func (s *SystemStatusService) readiness(ctx context.Context) (ReadinessReport, *systemstatus.Database) {
+	report := ReadinessReport{Ready: true, CheckedAt: s.now().UTC()}
+
+	checkCtx, cancel := context.WithTimeout(ctx, s.checkTimeout)
+	database, err := s.statusRepo.DatabaseStatus(checkCtx)
+	cancel()
+	dbStatus := DependencyStatus{Name: "postgres", Status: DependencyOK, Critical: true}
+	migrationStatus := DependencyStatus{Name: "migrations", Status: DependencyOK, Critical: true}
+	var databaseOut *systemstatus.Database
+	switch {
+	case err != nil:
+		dbStatus.Status = DependencyDown
+		dbStatus.Detail = err.Error()
+		migrationStatus.Status = DependencyDown
+		migrationStatus.Detail = "database is unreachable"
+	default:
+		databaseOut = &database
+		dbStatus.Detail = fmt.Sprintf("ping %s, %d/%d connections in use", database.PingLatency.Round(time.Millisecond), database.InUse, database.OpenConnections)
+		switch {
+		case database.MigrationVersion == 0:
+			migrationStatus.Status = DependencyDown
+			migrationStatus.Detail = "no migration applied"
+		case database.MigrationDirty:
+			migrationStatus.Status = DependencyDown
+			migrationStatus.Detail = fmt.Sprintf("migration %d is dirty", database.MigrationVersion)
+		default:
+			migrationStatus.Detail = fmt.Sprintf("version %d", database.MigrationVersion)
+		}
+	}
+	report.Dependencies = append(report.Dependencies, dbStatus, migrationStatus)
+
+	for _, item := range s.circuitStates() {
+		status := DependencyStatus{Name: item.Name, Status: DependencyOK, Detail: "circuit " + string(item.State)}
+		if item.State == resilience.CircuitStateOpen || item.State == resilience.CircuitStateHalfOpen {
+			status.Status = DependencyDegraded
+		}
+		report.Dependencies = append(report.Dependencies, status)
+	}
+
+	for _, item := range report.Dependencies {
+		if item.Critical && item.Status == DependencyDown {
+			report.Ready = false
+		}
+	}
+	return report, databaseOut
+}
+
+func (s *SystemStatusService) circuitStates() []CircuitStatus {
+	out := make([]CircuitStatus, 0, len(s.circuits))
+	for _, item := range s.circuits {
+		out = append(out, CircuitStatus{Name: item.name, State: item.reporter.CircuitState()})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riskibarqy/fantasy-league/internal/domain/jobscheduler"
+	"github.com/riskibarqy/fantasy-league/internal/domain/systemstatus"
+	"github.com/riskibarqy/fantasy-league/internal/platform/resilience"
+)
+
+type stubSystemStatusRepository struct {
+	database systemstatus.Database
+	err      error
+}
+
+func (s *stubSystemStatusRepository) DatabaseStatus(_ context.Context) (systemstatus.Database, error) {
+	return s.database, s.err
+}
+
+func (s *stubSystemStatusRepository) CountActivity(_ context.Context) (systemstatus.Activity, error) {
+	return systemstatus.Activity{}, nil
+}
+
+type stubLastCompletionRepository struct {
+	jobscheduler.Repository
+	jobNames    []string
+	completions []jobscheduler.LastCompletion
+}
+
+func (s *stubLastCompletionRepository) ListLastCompletions(_ context.Context, jobNames []string) ([]jobscheduler.LastCompletion, error) {
+	s.jobNames = jobNames
+	return s.completions, nil
+}
+
+type stubCircuitReporter struct {
+	state resilience.CircuitState
+}
+
+func (s stubCircuitReporter) CircuitState() resilience.CircuitState {
+	return s.state
+}
+
+type stubCacheSizer int
+
+func (s stubCacheSizer) Len() int {
+	return int(s)
+}
+
+func TestSystemStatusService_Readiness(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := &stubSystemStatusRepository{database: systemstatus.Database{MigrationVersion: 1772283017}}
+	svc := NewSystemStatusService(repo, nil)
+	svc.AddCircuit("anubis", stubCircuitReporter{state: resilience.CircuitStateOpen})
+
+	report := svc.Readiness(ctx)
+	if !report.Ready {
+		t.Fatalf("expected an open circuit to keep the instance ready, got %+v", report)
+	}
+	if got := dependencyByName(report, "anubis"); got.Status != DependencyDegraded || got.Critical {
+		t.Fatalf("expected anubis degraded and not critical, got %+v", got)
+	}
+
+	repo.database.MigrationDirty = true
+	report = svc.Readiness(ctx)
+	if report.Ready || dependencyByName(report, "migrations").Status != DependencyDown {
+		t.Fatalf("expected dirty migration to fail readiness, got %+v", report)
+	}
+
+	repo.err = errors.New("connection refused")
+	report = svc.Readiness(ctx)
+	if report.Ready || dependencyByName(report, "postgres").Status != DependencyDown {
+		t.Fatalf("expected unreachable database to fail readiness, got %+v", report)
+	}
+}
+
+func TestSystemStatusService_Status(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+	dispatches := &stubLastCompletionRepository{completions: []jobscheduler.LastCompletion{
+		{JobName: "sync-live", LeagueID: "idn-liga-1-2025", CompletedAt: completedAt},
+	}}
+	svc := NewSystemStatusService(&stubSystemStatusRepository{database: systemstatus.Database{MigrationVersion: 7, OpenConnections: 2}}, dispatches)
+	svc.AddCircuit("sportmonks", stubCircuitReporter{state: resilience.CircuitStateClosed})
+	svc.AddCircuit("anubis", stubCircuitReporter{state: resilience.CircuitStateDisabled})
+	svc.SetCache(stubCacheSizer(42))
+
+	status := svc.Status(context.Background())
+	if !status.Ready || status.Database == nil || status.Database.OpenConnections != 2 {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+	if len(status.Circuits) != 2 || status.Circuits[0].Name != "anubis" || status.Circuits[1].State != resilience.CircuitStateClosed {
+		t.Fatalf("expected circuits sorted by name, got %+v", status.Circuits)
+	}
+	if !status.CacheEnabled || status.CacheEntries != 42 {
+		t.Fatalf("expected 42 cache entries, got %+v", status)
+	}
+	if len(dispatches.jobNames) != 2 || len(status.LastSyncs) != 1 || !status.LastSyncs[0].CompletedAt.Equal(completedAt) {
+		t.Fatalf("unexpected last syncs %+v for jobs %v", status.LastSyncs, dispatches.jobNames)
+	}
+}
+
+func dependencyByName(report ReadinessReport, name string) DependencyStatus {
+	for _, item := range report.Dependencies {
+		if item.Name == name {
+			return item
+		}
+	}
+	return DependencyStatus{}
+}